@@ -8,6 +8,7 @@ import (
 	"github.com/grannnsacker/job-finder-back/internal/config"
 	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
 	"github.com/grannnsacker/job-finder-back/internal/esearch"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
 	zerolog "github.com/rs/zerolog/log"
 	rabbitmq "github.com/streadway/amqp"
 	"log"
@@ -28,6 +29,14 @@ func main() {
 
 	store := db.NewStore(conn)
 
+	// === admin ===
+	if cfg.AdminEmail != "" && cfg.AdminPassword != "" {
+		err = createAdmin(context.Background(), store, cfg.AdminEmail, cfg.AdminPassword)
+		if err != nil {
+			zerolog.Fatal().Err(err).Msg("cannot create admin")
+		}
+	}
+
 	// === loading test data ===
 	loadDataFlag := flag.Bool("load_test_data", false, "If set, the application will load test data into db")
 	flag.Parse()
@@ -83,12 +92,39 @@ func main() {
 	runHTTPServer(cfg, store, client, ch, q)
 }
 
+// createAdmin creates the admin account from the config if it does not exist yet,
+// admins cannot be registered through the API
+func createAdmin(ctx context.Context, store db.Store, email string, password string) error {
+	_, err := store.GetAdminByEmail(ctx, email)
+	if err == nil {
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.CreateAdmin(ctx, db.CreateAdminParams{
+		FullName:       "Admin",
+		Email:          email,
+		HashedPassword: hashedPassword,
+	})
+	return err
+}
+
 func runHTTPServer(cfg config.Config, store db.Store, client esearch.ESearchClient, ch *rabbitmq.Channel, q rabbitmq.Queue) {
 	server, err := api.NewServer(cfg, store, client, ch, q)
 	if err != nil {
 		zerolog.Fatal().Err(err).Msg("cannot create server")
 	}
 
+	// publishing and expiring the scheduled jobs
+	server.StartJobScheduler(context.Background())
+
 	// @contact.name aalug
 	// @contact.url https://github.com/aalug
 	// @contact.email a.a.gulczynski@gmail.com
@@ -0,0 +1,1111 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// actions written to the admin audit log
+const (
+	adminActionSearch    = "search"
+	adminActionSuspend   = "suspend"
+	adminActionRestore   = "restore"
+	adminActionUnpublish = "unpublish"
+	adminActionView      = "view"
+	adminActionUpdate    = "update"
+)
+
+// types of targets of admin actions
+const (
+	adminTargetUser           = "user"
+	adminTargetEmployer       = "employer"
+	adminTargetCompany        = "company"
+	adminTargetJob            = "job"
+	adminTargetJobApplication = "job_application"
+	adminTargetExchangeRate   = "exchange_rate"
+)
+
+var (
+	onlyAdminsAccessError      = errors.New("only admins can access this endpoint")
+	accountSuspendedError      = errors.New("account has been suspended")
+	companySuspendedError      = errors.New("company of this employer has been suspended")
+	jobAlreadyUnpublishedError = errors.New("job has already been unpublished")
+)
+
+// adminTargetNotFoundError return the target of the admin action does not exist error
+func adminTargetNotFoundError(targetType string) error {
+	return fmt.Errorf("%s does not exist", targetType)
+}
+
+// alreadySuspendedError return the target is already suspended error
+func alreadySuspendedError(targetType string) error {
+	return fmt.Errorf("%s is already suspended", targetType)
+}
+
+// notSuspendedError return the target is not suspended error
+func notSuspendedError(targetType string) error {
+	return fmt.Errorf("%s is not suspended", targetType)
+}
+
+// requireAdmin creates a gin middleware that rejects requests not made by admins
+func requireAdmin() gin.HandlerFunc {
+	return requireRole(onlyAdminsAccessError, token.RoleAdmin)
+}
+
+// adminAction executes the action of the authenticated admin and writes it to the audit log,
+// if it fails it responds with an error and returns false
+func (server *Server) adminAction(ctx *gin.Context, action string, targetType string, targetID int64, details string, fn func(q db.Querier) error) bool {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	_, err := server.store.AdminActionTx(ctx, db.AdminActionTxParams{
+		CreateAdminAuditLogParams: db.CreateAdminAuditLogParams{
+			AdminID:    authPayload.SubjectID,
+			Action:     action,
+			TargetType: targetType,
+			TargetID: sql.NullInt64{
+				Int64: targetID,
+				Valid: targetID != 0,
+			},
+			Details: details,
+		},
+		Action: fn,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(adminTargetNotFoundError(targetType)))
+			return false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	return true
+}
+
+// revokeAccountTokens revokes all access tokens of the account issued before issuedBefore,
+// if it fails it responds with an error and returns false
+func (server *Server) revokeAccountTokens(ctx *gin.Context, role string, subjectID int32, issuedBefore time.Time) bool {
+	subject := revocationSubject(role, subjectID)
+	err := server.revocationStore.RevokeSubject(ctx, subject, issuedBefore, server.config.AccessTokenDuration)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	return true
+}
+
+// removeJobFromSearch removes the job from the elasticsearch index,
+// the ID of the document is the ID of the job
+func (server *Server) removeJobFromSearch(jobID int32) error {
+	return server.esDetails.client.DeleteJobDocument(strconv.Itoa(int(jobID)))
+}
+
+// removeCompanyJobsFromSearch removes jobs of the company from the elasticsearch index
+func (server *Server) removeCompanyJobsFromSearch(ctx *gin.Context, companyID int32) error {
+	jobs, err := server.store.ListCompanyJobsForES(ctx, companyID)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		err = server.removeJobFromSearch(job.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexCompanyJobs adds jobs of the company (except unpublished ones) to the elasticsearch index
+func (server *Server) indexCompanyJobs(ctx *gin.Context, companyID int32) error {
+	jobs, err := server.store.ListCompanyJobsForES(ctx, companyID)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		err = server.indexJob(ctx, job)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type adminResponse struct {
+	ID        int32     `json:"id"`
+	FullName  string    `json:"full_name"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type loginAdminRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+type loginAdminResponse struct {
+	SessionID             uuid.UUID     `json:"session_id"`
+	AccessToken           string        `json:"access_token"`
+	AccessTokenExpiresAt  time.Time     `json:"access_token_expires_at"`
+	RefreshToken          string        `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time     `json:"refresh_token_expires_at"`
+	Admin                 adminResponse `json:"admin"`
+}
+
+// @Schemes
+// @Summary Login admin
+// @Description Login a platform admin. Admins cannot register, the account is created from the ADMIN_EMAIL and ADMIN_PASSWORD config.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @param LoginAdminRequest body loginAdminRequest true "Admin credentials"
+// @Success 200 {object} loginAdminResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Incorrect email or password"
+// @Failure 429 {object} ErrorResponse "Too many failed login attempts, see the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Router /admin/login [post]
+// loginAdmin handles login of an admin
+func (server *Server) loginAdmin(ctx *gin.Context) {
+	var request loginAdminRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	accountKey := loginAccountKey(token.RoleAdmin, request.Email)
+	if !server.checkLoginAttempts(ctx, accountKey) {
+		return
+	}
+
+	admin, err := server.store.GetAdminByEmail(ctx, request.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			checkPasswordOfMissingAccount(request.Password)
+			server.failLogin(ctx, accountKey, invalidCredentialsError)
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = utils.CheckPassword(request.Password, admin.HashedPassword)
+	if err != nil {
+		server.failLogin(ctx, accountKey, invalidCredentialsError)
+		return
+	}
+
+	err = server.loginAccountLimiter.Reset(ctx, accountKey)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(admin.Email, token.RoleAdmin, admin.ID, server.config.AccessTokenDuration)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	session, refreshToken, refreshPayload, err := server.createSession(ctx, admin.Email, token.RoleAdmin, admin.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := loginAdminResponse{
+		SessionID:             session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshPayload.ExpiredAt,
+		Admin: adminResponse{
+			ID:        admin.ID,
+			FullName:  admin.FullName,
+			Email:     admin.Email,
+			CreatedAt: admin.CreatedAt,
+		},
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type adminSearchRequest struct {
+	Search   string `form:"search"`
+	Page     int32  `form:"page" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=15"`
+}
+
+type adminTargetRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+type moderationRequest struct {
+	// Reason is written to the audit log
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// nullTimePointer converts sql.NullTime to *time.Time, so it is null in the JSON response
+func nullTimePointer(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+type adminUserResponse struct {
+	ID              int32      `json:"id"`
+	FullName        string     `json:"full_name"`
+	Email           string     `json:"email"`
+	IsEmailVerified bool       `json:"is_email_verified"`
+	Location        string     `json:"location"`
+	CreatedAt       time.Time  `json:"created_at"`
+	SuspendedAt     *time.Time `json:"suspended_at"`
+}
+
+// newAdminUserResponse converts db.User to adminUserResponse
+func newAdminUserResponse(user db.User) adminUserResponse {
+	return adminUserResponse{
+		ID:              user.ID,
+		FullName:        user.FullName,
+		Email:           user.Email,
+		IsEmailVerified: user.IsEmailVerified,
+		Location:        user.Location,
+		CreatedAt:       user.CreatedAt,
+		SuspendedAt:     nullTimePointer(user.SuspendedAt),
+	}
+}
+
+// @Schemes
+// @Summary Search users
+// @Description Search users by email or full name (matches partially). The search is written to the audit log.
+// @Tags admin
+// @Produce json
+// @Param search query string false "Part of the email or full name"
+// @Param page query integer true "Page number"
+// @Param page_size query integer true "Page size"
+// @Success 200 {array} adminUserResponse
+// @Failure 400 {object} ErrorResponse "Invalid query"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/users [get]
+// searchUsersAsAdmin handles searching users by an admin
+func (server *Server) searchUsersAsAdmin(ctx *gin.Context) {
+	var request adminSearchRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var users []db.User
+	ok := server.adminAction(ctx, adminActionSearch, adminTargetUser, 0, request.Search, func(q db.Querier) error {
+		var err error
+		users, err = q.SearchUsers(ctx, db.SearchUsersParams{
+			Search: request.Search,
+			Limit:  request.PageSize,
+			Offset: (request.Page - 1) * request.PageSize,
+		})
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	res := make([]adminUserResponse, 0, len(users))
+	for _, user := range users {
+		res = append(res, newAdminUserResponse(user))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Schemes
+// @Summary Suspend user
+// @Description Suspend the user. The user cannot log in and all sessions and access tokens of the user are revoked.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "User ID"
+// @param ModerationRequest body moderationRequest true "Reason written to the audit log"
+// @Success 200 {object} adminUserResponse
+// @Failure 400 {object} ErrorResponse "Invalid user ID or request body"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 404 {object} ErrorResponse "User not found"
+// @Failure 409 {object} ErrorResponse "User is already suspended"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/users/{id}/suspend [post]
+// suspendUser handles suspending a user by an admin
+func (server *Server) suspendUser(ctx *gin.Context) {
+	var uriRequest adminTargetRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request moderationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	user, err := server.store.GetUserByID(ctx, uriRequest.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(adminTargetNotFoundError(adminTargetUser)))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if user.SuspendedAt.Valid {
+		ctx.JSON(http.StatusConflict, errorResponse(alreadySuspendedError(adminTargetUser)))
+		return
+	}
+
+	issuedBefore := time.Now()
+	ok := server.adminAction(ctx, adminActionSuspend, adminTargetUser, int64(user.ID), request.Reason, func(q db.Querier) error {
+		var err error
+		user, err = q.SuspendUser(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		// refresh tokens are blocked together with the sessions
+		return q.BlockSessionsBySubject(ctx, db.BlockSessionsBySubjectParams{
+			Role:      token.RoleUser,
+			SubjectID: user.ID,
+		})
+	})
+	if !ok {
+		return
+	}
+
+	// access tokens issued before the suspension have to be revoked too
+	if !server.revokeAccountTokens(ctx, token.RoleUser, user.ID, issuedBefore) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAdminUserResponse(user))
+}
+
+// @Schemes
+// @Summary Restore user
+// @Description Restore the suspended user, so the user can log in again
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "User ID"
+// @param ModerationRequest body moderationRequest true "Reason written to the audit log"
+// @Success 200 {object} adminUserResponse
+// @Failure 400 {object} ErrorResponse "Invalid user ID or request body"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 404 {object} ErrorResponse "User not found"
+// @Failure 409 {object} ErrorResponse "User is not suspended"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/users/{id}/restore [post]
+// restoreUser handles restoring a suspended user by an admin
+func (server *Server) restoreUser(ctx *gin.Context) {
+	var uriRequest adminTargetRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request moderationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	user, err := server.store.GetUserByID(ctx, uriRequest.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(adminTargetNotFoundError(adminTargetUser)))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !user.SuspendedAt.Valid {
+		ctx.JSON(http.StatusConflict, errorResponse(notSuspendedError(adminTargetUser)))
+		return
+	}
+
+	ok := server.adminAction(ctx, adminActionRestore, adminTargetUser, int64(user.ID), request.Reason, func(q db.Querier) error {
+		var err error
+		user, err = q.RestoreUser(ctx, user.ID)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAdminUserResponse(user))
+}
+
+type adminEmployerResponse struct {
+	ID              int32           `json:"id"`
+	CompanyID       int32           `json:"company_id"`
+	FullName        string          `json:"full_name"`
+	Email           string          `json:"email"`
+	Role            db.EmployerRole `json:"role"`
+	IsEmailVerified bool            `json:"is_email_verified"`
+	CreatedAt       time.Time       `json:"created_at"`
+	SuspendedAt     *time.Time      `json:"suspended_at"`
+}
+
+// newAdminEmployerResponse converts db.Employer to adminEmployerResponse
+func newAdminEmployerResponse(employer db.Employer) adminEmployerResponse {
+	return adminEmployerResponse{
+		ID:              employer.ID,
+		CompanyID:       employer.CompanyID,
+		FullName:        employer.FullName,
+		Email:           employer.Email,
+		Role:            employer.Role,
+		IsEmailVerified: employer.IsEmailVerified,
+		CreatedAt:       employer.CreatedAt,
+		SuspendedAt:     nullTimePointer(employer.SuspendedAt),
+	}
+}
+
+// @Schemes
+// @Summary Search employers
+// @Description Search employers by email or full name (matches partially). The search is written to the audit log.
+// @Tags admin
+// @Produce json
+// @Param search query string false "Part of the email or full name"
+// @Param page query integer true "Page number"
+// @Param page_size query integer true "Page size"
+// @Success 200 {array} adminEmployerResponse
+// @Failure 400 {object} ErrorResponse "Invalid query"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/employers [get]
+// searchEmployersAsAdmin handles searching employers by an admin
+func (server *Server) searchEmployersAsAdmin(ctx *gin.Context) {
+	var request adminSearchRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var employers []db.Employer
+	ok := server.adminAction(ctx, adminActionSearch, adminTargetEmployer, 0, request.Search, func(q db.Querier) error {
+		var err error
+		employers, err = q.SearchEmployers(ctx, db.SearchEmployersParams{
+			Search: request.Search,
+			Limit:  request.PageSize,
+			Offset: (request.Page - 1) * request.PageSize,
+		})
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	res := make([]adminEmployerResponse, 0, len(employers))
+	for _, employer := range employers {
+		res = append(res, newAdminEmployerResponse(employer))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Schemes
+// @Summary Suspend employer
+// @Description Suspend the employer. The employer cannot log in or use API keys, all sessions and access tokens of the employer are revoked.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "Employer ID"
+// @param ModerationRequest body moderationRequest true "Reason written to the audit log"
+// @Success 200 {object} adminEmployerResponse
+// @Failure 400 {object} ErrorResponse "Invalid employer ID or request body"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 404 {object} ErrorResponse "Employer not found"
+// @Failure 409 {object} ErrorResponse "Employer is already suspended"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/employers/{id}/suspend [post]
+// suspendEmployer handles suspending an employer by an admin
+func (server *Server) suspendEmployer(ctx *gin.Context) {
+	var uriRequest adminTargetRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request moderationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	employer, err := server.store.GetEmployerByID(ctx, uriRequest.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(adminTargetNotFoundError(adminTargetEmployer)))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if employer.SuspendedAt.Valid {
+		ctx.JSON(http.StatusConflict, errorResponse(alreadySuspendedError(adminTargetEmployer)))
+		return
+	}
+
+	issuedBefore := time.Now()
+	ok := server.adminAction(ctx, adminActionSuspend, adminTargetEmployer, int64(employer.ID), request.Reason, func(q db.Querier) error {
+		var err error
+		employer, err = q.SuspendEmployer(ctx, employer.ID)
+		if err != nil {
+			return err
+		}
+
+		// refresh tokens are blocked together with the sessions
+		return q.BlockSessionsBySubject(ctx, db.BlockSessionsBySubjectParams{
+			Role:      token.RoleEmployer,
+			SubjectID: employer.ID,
+		})
+	})
+	if !ok {
+		return
+	}
+
+	// access tokens issued before the suspension have to be revoked too
+	if !server.revokeAccountTokens(ctx, token.RoleEmployer, employer.ID, issuedBefore) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAdminEmployerResponse(employer))
+}
+
+// @Schemes
+// @Summary Restore employer
+// @Description Restore the suspended employer, so the employer can log in and use API keys again
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "Employer ID"
+// @param ModerationRequest body moderationRequest true "Reason written to the audit log"
+// @Success 200 {object} adminEmployerResponse
+// @Failure 400 {object} ErrorResponse "Invalid employer ID or request body"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 404 {object} ErrorResponse "Employer not found"
+// @Failure 409 {object} ErrorResponse "Employer is not suspended"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/employers/{id}/restore [post]
+// restoreEmployer handles restoring a suspended employer by an admin
+func (server *Server) restoreEmployer(ctx *gin.Context) {
+	var uriRequest adminTargetRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request moderationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	employer, err := server.store.GetEmployerByID(ctx, uriRequest.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(adminTargetNotFoundError(adminTargetEmployer)))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !employer.SuspendedAt.Valid {
+		ctx.JSON(http.StatusConflict, errorResponse(notSuspendedError(adminTargetEmployer)))
+		return
+	}
+
+	ok := server.adminAction(ctx, adminActionRestore, adminTargetEmployer, int64(employer.ID), request.Reason, func(q db.Querier) error {
+		var err error
+		employer, err = q.RestoreEmployer(ctx, employer.ID)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAdminEmployerResponse(employer))
+}
+
+type adminCompanyResponse struct {
+	ID          int32      `json:"id"`
+	Name        string     `json:"name"`
+	Industry    string     `json:"industry"`
+	Location    string     `json:"location"`
+	SuspendedAt *time.Time `json:"suspended_at"`
+}
+
+// newAdminCompanyResponse converts db.Company to adminCompanyResponse
+func newAdminCompanyResponse(company db.Company) adminCompanyResponse {
+	return adminCompanyResponse{
+		ID:          company.ID,
+		Name:        company.Name,
+		Industry:    company.Industry,
+		Location:    company.Location,
+		SuspendedAt: nullTimePointer(company.SuspendedAt),
+	}
+}
+
+// @Schemes
+// @Summary Search companies
+// @Description Search companies by name (matches partially). The search is written to the audit log.
+// @Tags admin
+// @Produce json
+// @Param search query string false "Part of the company name"
+// @Param page query integer true "Page number"
+// @Param page_size query integer true "Page size"
+// @Success 200 {array} adminCompanyResponse
+// @Failure 400 {object} ErrorResponse "Invalid query"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/companies [get]
+// searchCompaniesAsAdmin handles searching companies by an admin
+func (server *Server) searchCompaniesAsAdmin(ctx *gin.Context) {
+	var request adminSearchRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var companies []db.Company
+	ok := server.adminAction(ctx, adminActionSearch, adminTargetCompany, 0, request.Search, func(q db.Querier) error {
+		var err error
+		companies, err = q.SearchCompanies(ctx, db.SearchCompaniesParams{
+			Search: request.Search,
+			Limit:  request.PageSize,
+			Offset: (request.Page - 1) * request.PageSize,
+		})
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	res := make([]adminCompanyResponse, 0, len(companies))
+	for _, company := range companies {
+		res = append(res, newAdminCompanyResponse(company))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// @Schemes
+// @Summary Suspend company
+// @Description Suspend the company. Jobs of the company are no longer listed, employers of the company cannot log in or use API keys and their sessions and access tokens are revoked.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "Company ID"
+// @param ModerationRequest body moderationRequest true "Reason written to the audit log"
+// @Success 200 {object} adminCompanyResponse
+// @Failure 400 {object} ErrorResponse "Invalid company ID or request body"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 404 {object} ErrorResponse "Company not found"
+// @Failure 409 {object} ErrorResponse "Company is already suspended"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/companies/{id}/suspend [post]
+// suspendCompany handles suspending a company by an admin
+func (server *Server) suspendCompany(ctx *gin.Context) {
+	var uriRequest adminTargetRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request moderationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	company, err := server.store.GetCompanyByID(ctx, uriRequest.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(adminTargetNotFoundError(adminTargetCompany)))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if company.SuspendedAt.Valid {
+		ctx.JSON(http.StatusConflict, errorResponse(alreadySuspendedError(adminTargetCompany)))
+		return
+	}
+
+	issuedBefore := time.Now()
+	var employers []db.Employer
+	ok := server.adminAction(ctx, adminActionSuspend, adminTargetCompany, int64(company.ID), request.Reason, func(q db.Querier) error {
+		var err error
+		company, err = q.SuspendCompany(ctx, company.ID)
+		if err != nil {
+			return err
+		}
+
+		employers, err = q.ListCompanyEmployers(ctx, company.ID)
+		if err != nil {
+			return err
+		}
+
+		// refresh tokens are blocked together with the sessions
+		for _, employer := range employers {
+			err = q.BlockSessionsBySubject(ctx, db.BlockSessionsBySubjectParams{
+				Role:      token.RoleEmployer,
+				SubjectID: employer.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if !ok {
+		return
+	}
+
+	// access tokens issued before the suspension have to be revoked too
+	for _, employer := range employers {
+		if !server.revokeAccountTokens(ctx, token.RoleEmployer, employer.ID, issuedBefore) {
+			return
+		}
+	}
+
+	err = server.removeCompanyJobsFromSearch(ctx, company.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAdminCompanyResponse(company))
+}
+
+// @Schemes
+// @Summary Restore company
+// @Description Restore the suspended company. Jobs of the company (except unpublished ones) are listed again and its employers can log in, unless they are suspended themselves.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "Company ID"
+// @param ModerationRequest body moderationRequest true "Reason written to the audit log"
+// @Success 200 {object} adminCompanyResponse
+// @Failure 400 {object} ErrorResponse "Invalid company ID or request body"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 404 {object} ErrorResponse "Company not found"
+// @Failure 409 {object} ErrorResponse "Company is not suspended"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/companies/{id}/restore [post]
+// restoreCompany handles restoring a suspended company by an admin
+func (server *Server) restoreCompany(ctx *gin.Context) {
+	var uriRequest adminTargetRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request moderationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	company, err := server.store.GetCompanyByID(ctx, uriRequest.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(adminTargetNotFoundError(adminTargetCompany)))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !company.SuspendedAt.Valid {
+		ctx.JSON(http.StatusConflict, errorResponse(notSuspendedError(adminTargetCompany)))
+		return
+	}
+
+	ok := server.adminAction(ctx, adminActionRestore, adminTargetCompany, int64(company.ID), request.Reason, func(q db.Querier) error {
+		var err error
+		company, err = q.RestoreCompany(ctx, company.ID)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	err = server.indexCompanyJobs(ctx, company.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newAdminCompanyResponse(company))
+}
+
+type adminJobResponse struct {
+	ID            int32      `json:"id"`
+	Title         string     `json:"title"`
+	CompanyID     int32      `json:"company_id"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UnpublishedAt *time.Time `json:"unpublished_at"`
+}
+
+// @Schemes
+// @Summary Unpublish job
+// @Description Take down the job. It is no longer listed or searchable, but the employer can still see it.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path integer true "Job ID"
+// @param ModerationRequest body moderationRequest true "Reason written to the audit log"
+// @Success 200 {object} adminJobResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID or request body"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Failure 409 {object} ErrorResponse "Job has already been unpublished"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/jobs/{id}/unpublish [post]
+// unpublishJob handles taking down a job by an admin
+func (server *Server) unpublishJob(ctx *gin.Context) {
+	var uriRequest adminTargetRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request moderationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	job, err := server.store.GetJob(ctx, uriRequest.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(adminTargetNotFoundError(adminTargetJob)))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if job.UnpublishedAt.Valid {
+		ctx.JSON(http.StatusConflict, errorResponse(jobAlreadyUnpublishedError))
+		return
+	}
+
+	// jobs of suspended companies have already been removed from the index
+	company, err := server.store.GetCompanyByID(ctx, job.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ok := server.adminAction(ctx, adminActionUnpublish, adminTargetJob, int64(job.ID), request.Reason, func(q db.Querier) error {
+		var err error
+		job, err = q.UnpublishJob(ctx, job.ID)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	// only published jobs of companies that are not suspended are in the elasticsearch index
+	if !company.SuspendedAt.Valid && job.Status == db.JobStatusPublished {
+		err = server.removeJobFromSearch(job.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	res := adminJobResponse{
+		ID:            job.ID,
+		Title:         job.Title,
+		CompanyID:     job.CompanyID,
+		CreatedAt:     job.CreatedAt,
+		UnpublishedAt: nullTimePointer(job.UnpublishedAt),
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type adminJobApplicationResponse struct {
+	ID           int32                `json:"id"`
+	UserID       int32                `json:"user_id"`
+	JobID        int32                `json:"job_id"`
+	Message      *string              `json:"message"`
+	Cv           []byte               `json:"cv"`
+	Status       db.ApplicationStatus `json:"status"`
+	AppliedAt    time.Time            `json:"applied_at"`
+	Notification bool                 `json:"notification"`
+}
+
+// @Schemes
+// @Summary Get job application
+// @Description Get the raw record of the job application, including the CV (base64 encoded). The access is written to the audit log.
+// @Tags admin
+// @Produce json
+// @Param id path integer true "Job application ID"
+// @Success 200 {object} adminJobApplicationResponse
+// @Failure 400 {object} ErrorResponse "Invalid job application ID"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 404 {object} ErrorResponse "Job application not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/job-applications/{id} [get]
+// getJobApplicationAsAdmin handles getting the raw job application record by an admin
+func (server *Server) getJobApplicationAsAdmin(ctx *gin.Context) {
+	var request adminTargetRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var jobApplication db.JobApplication
+	ok := server.adminAction(ctx, adminActionView, adminTargetJobApplication, int64(request.ID), "", func(q db.Querier) error {
+		var err error
+		jobApplication, err = q.GetJobApplication(ctx, request.ID)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	res := adminJobApplicationResponse{
+		ID:           jobApplication.ID,
+		UserID:       jobApplication.UserID,
+		JobID:        jobApplication.JobID,
+		Cv:           jobApplication.Cv,
+		Status:       jobApplication.Status,
+		AppliedAt:    jobApplication.AppliedAt,
+		Notification: jobApplication.Notification,
+	}
+	if jobApplication.Message.Valid {
+		res.Message = &jobApplication.Message.String
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type listAdminAuditLogsRequest struct {
+	AdminID    int32  `form:"admin_id" binding:"omitempty,min=1"`
+	TargetType string `form:"target_type" binding:"omitempty,oneof=user employer company job job_application"`
+	TargetID   int64  `form:"target_id" binding:"omitempty,min=1"`
+	Page       int32  `form:"page" binding:"required,min=1"`
+	PageSize   int32  `form:"page_size" binding:"required,min=5,max=15"`
+}
+
+type adminAuditLogResponse struct {
+	ID         int64     `json:"id"`
+	AdminID    int32     `json:"admin_id"`
+	Action     string    `json:"action"`
+	TargetType string    `json:"target_type"`
+	TargetID   *int64    `json:"target_id"`
+	Details    string    `json:"details"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// @Schemes
+// @Summary List audit logs
+// @Description List actions of admins, the newest first
+// @Tags admin
+// @Produce json
+// @Param admin_id query integer false "ID of the admin"
+// @Param target_type query string false "Type of the target: user, employer, company, job or job_application"
+// @Param target_id query integer false "ID of the target"
+// @Param page query integer true "Page number"
+// @Param page_size query integer true "Page size"
+// @Success 200 {array} adminAuditLogResponse
+// @Failure 400 {object} ErrorResponse "Invalid query"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/audit-logs [get]
+// listAdminAuditLogs handles listing the admin audit log
+func (server *Server) listAdminAuditLogs(ctx *gin.Context) {
+	var request listAdminAuditLogsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	auditLogs, err := server.store.ListAdminAuditLogs(ctx, db.ListAdminAuditLogsParams{
+		FilterAdminID:    request.AdminID != 0,
+		AdminID:          request.AdminID,
+		FilterTargetType: request.TargetType != "",
+		TargetType:       request.TargetType,
+		FilterTargetID:   request.TargetID != 0,
+		TargetID: sql.NullInt64{
+			Int64: request.TargetID,
+			Valid: request.TargetID != 0,
+		},
+		Limit:  request.PageSize,
+		Offset: (request.Page - 1) * request.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]adminAuditLogResponse, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		logResponse := adminAuditLogResponse{
+			ID:         auditLog.ID,
+			AdminID:    auditLog.AdminID,
+			Action:     auditLog.Action,
+			TargetType: auditLog.TargetType,
+			Details:    auditLog.Details,
+			CreatedAt:  auditLog.CreatedAt,
+		}
+		if auditLog.TargetID.Valid {
+			logResponse.TargetID = &auditLog.TargetID.Int64
+		}
+		res = append(res, logResponse)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
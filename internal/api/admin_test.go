@@ -0,0 +1,1345 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	mockesearch "github.com/grannnsacker/job-finder-back/internal/esearch/mock"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLoginAdminAPI(t *testing.T) {
+	admin, password := generateRandomAdmin(t)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"email":    admin.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAdminByEmail(gomock.Any(), gomock.Eq(admin.Email)).
+					Times(1).
+					Return(admin, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res loginAdminResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.AccessToken)
+				require.Equal(t, admin.ID, res.Admin.ID)
+				require.Equal(t, admin.Email, res.Admin.Email)
+			},
+		},
+		{
+			name: "Incorrect Password",
+			body: gin.H{
+				"email":    admin.Email,
+				"password": "incorrect",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAdminByEmail(gomock.Any(), gomock.Eq(admin.Email)).
+					Times(1).
+					Return(admin, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				requireInvalidCredentials(t, recorder)
+			},
+		},
+		{
+			name: "Admin Not Found",
+			body: gin.H{
+				"email":    admin.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAdminByEmail(gomock.Any(), gomock.Eq(admin.Email)).
+					Times(1).
+					Return(db.Admin{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				requireInvalidCredentials(t, recorder)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: gin.H{
+				"email":    admin.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAdminByEmail(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Admin{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Email",
+			body: gin.H{
+				"email":    "invalid",
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAdminByEmail(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := BaseUrl + "/admin/login"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestSearchUsersAsAdminAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	user, _ := generateRandomUser(t)
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("search=%s&page=1&page_size=10", user.Email),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionSearch,
+						TargetType: adminTargetUser,
+						Details:    user.Email,
+					}))
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Eq(db.SearchUsersParams{
+						Search: user.Email,
+						Limit:  10,
+						Offset: 0,
+					})).
+					Times(1).
+					Return([]db.User{user}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res []adminUserResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				require.Equal(t, user.ID, res[0].ID)
+				require.Equal(t, user.Email, res[0].Email)
+				require.Nil(t, res[0].SuspendedAt)
+			},
+		},
+		{
+			name:  "Not Admin",
+			query: "page=1&page_size=10",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Page Size",
+			query: "page=1&page_size=100",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/admin/users?" + tc.query
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestSuspendUserAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	user, _ := generateRandomUser(t)
+	reason := utils.RandomString(10)
+
+	suspendedUser := user
+	suspendedUser.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		userID        int32
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder, server *Server)
+	}{
+		{
+			name:   "OK",
+			userID: user.ID,
+			body:   gin.H{"reason": reason},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionSuspend,
+						TargetType: adminTargetUser,
+						TargetID:   sql.NullInt64{Int64: int64(user.ID), Valid: true},
+						Details:    reason,
+					}))
+				store.EXPECT().
+					SuspendUser(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(suspendedUser, nil)
+				store.EXPECT().
+					BlockSessionsBySubject(gomock.Any(), gomock.Eq(db.BlockSessionsBySubjectParams{
+						Role:      token.RoleUser,
+						SubjectID: user.ID,
+					})).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res adminUserResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, user.ID, res.ID)
+				require.NotNil(t, res.SuspendedAt)
+
+				// access tokens issued before the suspension are revoked
+				subject := revocationSubject(token.RoleUser, user.ID)
+				revoked, err := server.revocationStore.IsSubjectRevoked(
+					&gin.Context{}, subject, time.Now().Add(-time.Minute),
+				)
+				require.NoError(t, err)
+				require.True(t, revoked)
+			},
+		},
+		{
+			name:   "Not Admin",
+			userID: user.ID,
+			body:   gin.H{"reason": reason},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, token.RoleEmployer, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:   "No Authorization",
+			userID: user.ID,
+			body:   gin.H{"reason": reason},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:   "User Not Found",
+			userID: user.ID,
+			body:   gin.H{"reason": reason},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:   "Already Suspended",
+			userID: user.ID,
+			body:   gin.H{"reason": reason},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(suspendedUser, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "Internal Server Error AdminActionTx",
+			userID: user.ID,
+			body:   gin.H{"reason": reason},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AdminActionTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:   "Missing Reason",
+			userID: user.ID,
+			body:   gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "Invalid ID",
+			userID: 0,
+			body:   gin.H{"reason": reason},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/users/%d/suspend", BaseUrl, tc.userID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder, server)
+		})
+	}
+}
+
+func TestRestoreUserAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	user, _ := generateRandomUser(t)
+	reason := utils.RandomString(10)
+
+	suspendedUser := user
+	suspendedUser.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(suspendedUser, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionRestore,
+						TargetType: adminTargetUser,
+						TargetID:   sql.NullInt64{Int64: int64(user.ID), Valid: true},
+						Details:    reason,
+					}))
+				store.EXPECT().
+					RestoreUser(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res adminUserResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, user.ID, res.ID)
+				require.Nil(t, res.SuspendedAt)
+			},
+		},
+		{
+			name: "Not Suspended",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"reason": reason})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/users/%d/restore", BaseUrl, user.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestSuspendEmployerAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	reason := utils.RandomString(10)
+
+	suspendedEmployer := employer
+	suspendedEmployer.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionSuspend,
+						TargetType: adminTargetEmployer,
+						TargetID:   sql.NullInt64{Int64: int64(employer.ID), Valid: true},
+						Details:    reason,
+					}))
+				store.EXPECT().
+					SuspendEmployer(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(suspendedEmployer, nil)
+				store.EXPECT().
+					BlockSessionsBySubject(gomock.Any(), gomock.Eq(db.BlockSessionsBySubjectParams{
+						Role:      token.RoleEmployer,
+						SubjectID: employer.ID,
+					})).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res adminEmployerResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, employer.ID, res.ID)
+				require.NotNil(t, res.SuspendedAt)
+			},
+		},
+		{
+			name: "Employer Not Found",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Already Suspended",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(suspendedEmployer, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"reason": reason})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/employers/%d/suspend", BaseUrl, employer.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestSuspendCompanyAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	employer, _, company := generateRandomEmployerAndCompany(t)
+	reason := utils.RandomString(10)
+
+	suspendedCompany := company
+	suspendedCompany.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	job := generateRandomJob()
+	job.CompanyID = company.ID
+	companyJobs := []db.Job{job}
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionSuspend,
+						TargetType: adminTargetCompany,
+						TargetID:   sql.NullInt64{Int64: int64(company.ID), Valid: true},
+						Details:    reason,
+					}))
+				store.EXPECT().
+					SuspendCompany(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(suspendedCompany, nil)
+				store.EXPECT().
+					ListCompanyEmployers(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return([]db.Employer{employer}, nil)
+				store.EXPECT().
+					BlockSessionsBySubject(gomock.Any(), gomock.Eq(db.BlockSessionsBySubjectParams{
+						Role:      token.RoleEmployer,
+						SubjectID: employer.ID,
+					})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					ListCompanyJobsForES(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(companyJobs, nil)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Eq(strconv.Itoa(int(job.ID)))).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res adminCompanyResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, company.ID, res.ID)
+				require.NotNil(t, res.SuspendedAt)
+			},
+		},
+		{
+			name: "Company Not Found",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(db.Company{}, sql.ErrNoRows)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Already Suspended",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(suspendedCompany, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error DeleteJobDocument",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AdminActionTxResult{}, nil)
+				store.EXPECT().
+					ListCompanyJobsForES(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(companyJobs, nil)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Eq(strconv.Itoa(int(job.ID)))).
+					Times(1).
+					Return(fmt.Errorf("elasticsearch error"))
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"reason": reason})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/companies/%d/suspend", BaseUrl, company.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestRestoreCompanyAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	_, _, company := generateRandomEmployerAndCompany(t)
+	reason := utils.RandomString(10)
+
+	suspendedCompany := company
+	suspendedCompany.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	job := generateRandomJob()
+	job.CompanyID = company.ID
+	job.Status = db.JobStatusPublished
+	companyJobs := []db.Job{job}
+	skills := []string{utils.RandomString(4), utils.RandomString(4)}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(suspendedCompany, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionRestore,
+						TargetType: adminTargetCompany,
+						TargetID:   sql.NullInt64{Int64: int64(company.ID), Valid: true},
+						Details:    reason,
+					}))
+				store.EXPECT().
+					RestoreCompany(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company, nil)
+				store.EXPECT().
+					ListCompanyJobsForES(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(companyJobs, nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(skills, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Eq(int(job.ID)), gomock.Eq(newESJob(job, company.Name, skills))).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res adminCompanyResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, company.ID, res.ID)
+				require.Nil(t, res.SuspendedAt)
+			},
+		},
+		{
+			name: "Not Suspended",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"reason": reason})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/companies/%d/restore", BaseUrl, company.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestUnpublishJobAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	_, _, company := generateRandomEmployerAndCompany(t)
+	reason := utils.RandomString(10)
+
+	job := generateRandomJob()
+	job.CompanyID = company.ID
+	unpublishedJob := job
+	unpublishedJob.UnpublishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	suspendedCompany := company
+	suspendedCompany.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionUnpublish,
+						TargetType: adminTargetJob,
+						TargetID:   sql.NullInt64{Int64: int64(job.ID), Valid: true},
+						Details:    reason,
+					}))
+				store.EXPECT().
+					UnpublishJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(unpublishedJob, nil)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Eq(strconv.Itoa(int(job.ID)))).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res adminJobResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, job.ID, res.ID)
+				require.NotNil(t, res.UnpublishedAt)
+			},
+		},
+		{
+			name: "OK Company Suspended",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(suspendedCompany, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AdminActionTxResult{}, nil)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Job Not Found",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(db.Job{}, sql.ErrNoRows)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Already Unpublished",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(unpublishedJob, nil)
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"reason": reason})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/jobs/%d/unpublish", BaseUrl, job.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestGetJobApplicationAsAdminAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	jobApplication := db.JobApplication{
+		ID:        utils.RandomInt(1, 1000),
+		UserID:    utils.RandomInt(1, 1000),
+		JobID:     utils.RandomInt(1, 1000),
+		Message:   sql.NullString{String: utils.RandomString(10), Valid: true},
+		Cv:        []byte(utils.RandomString(20)),
+		Status:    db.ApplicationStatusApplied,
+		AppliedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionView,
+						TargetType: adminTargetJobApplication,
+						TargetID:   sql.NullInt64{Int64: int64(jobApplication.ID), Valid: true},
+					}))
+				store.EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Eq(jobApplication.ID)).
+					Times(1).
+					Return(jobApplication, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res adminJobApplicationResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, jobApplication.ID, res.ID)
+				require.Equal(t, jobApplication.UserID, res.UserID)
+				require.Equal(t, jobApplication.Cv, res.Cv)
+				require.NotNil(t, res.Message)
+				require.Equal(t, jobApplication.Message.String, *res.Message)
+			},
+		},
+		{
+			name: "Not Found",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionView,
+						TargetType: adminTargetJobApplication,
+						TargetID:   sql.NullInt64{Int64: int64(jobApplication.ID), Valid: true},
+					}))
+				store.EXPECT().
+					GetJobApplication(gomock.Any(), gomock.Eq(jobApplication.ID)).
+					Times(1).
+					Return(db.JobApplication{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/admin/job-applications/%d", BaseUrl, jobApplication.ID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListAdminAuditLogsAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	auditLog := db.AdminAuditLog{
+		ID:         int64(utils.RandomInt(1, 1000)),
+		AdminID:    admin.ID,
+		Action:     adminActionSuspend,
+		TargetType: adminTargetUser,
+		TargetID:   sql.NullInt64{Int64: 5, Valid: true},
+		Details:    utils.RandomString(10),
+		CreatedAt:  time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "target_type=user&target_id=5&page=1&page_size=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAdminAuditLogs(gomock.Any(), gomock.Eq(db.ListAdminAuditLogsParams{
+						FilterTargetType: true,
+						TargetType:       adminTargetUser,
+						FilterTargetID:   true,
+						TargetID:         sql.NullInt64{Int64: 5, Valid: true},
+						Limit:            10,
+						Offset:           0,
+					})).
+					Times(1).
+					Return([]db.AdminAuditLog{auditLog}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res []adminAuditLogResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+				require.Equal(t, auditLog.ID, res[0].ID)
+				require.Equal(t, auditLog.Action, res[0].Action)
+				require.NotNil(t, res[0].TargetID)
+				require.Equal(t, auditLog.TargetID.Int64, *res[0].TargetID)
+			},
+		},
+		{
+			name:  "Invalid Target Type",
+			query: "target_type=session&page=1&page_size=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAdminAuditLogs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			query: "page=1&page_size=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAdminAuditLogs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/admin/audit-logs?" + tc.query
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// runAdminAction returns a stub of AdminActionTx that checks the audit log
+// and runs the action with the mock store, as if it was in the transaction
+func runAdminAction(t *testing.T, store *mockdb.MockStore, auditLog db.CreateAdminAuditLogParams) func(_ interface{}, arg db.AdminActionTxParams) (db.AdminActionTxResult, error) {
+	return func(_ interface{}, arg db.AdminActionTxParams) (db.AdminActionTxResult, error) {
+		require.Equal(t, auditLog, arg.CreateAdminAuditLogParams)
+
+		err := arg.Action(store)
+		if err != nil {
+			return db.AdminActionTxResult{}, err
+		}
+
+		return db.AdminActionTxResult{
+			AdminAuditLog: db.AdminAuditLog{
+				AdminID:    auditLog.AdminID,
+				Action:     auditLog.Action,
+				TargetType: auditLog.TargetType,
+				TargetID:   auditLog.TargetID,
+				Details:    auditLog.Details,
+				CreatedAt:  time.Now(),
+			},
+		}, nil
+	}
+}
+
+func generateRandomAdmin(t *testing.T) (db.Admin, string) {
+	password := utils.RandomString(6)
+	hashedPassword, err := utils.HashPassword(password)
+	require.NoError(t, err)
+
+	admin := db.Admin{
+		ID:             utils.RandomInt(1, 100),
+		FullName:       utils.RandomString(6),
+		Email:          utils.RandomEmail(),
+		HashedPassword: hashedPassword,
+		CreatedAt:      time.Now(),
+	}
+
+	return admin, password
+}
@@ -0,0 +1,269 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"net/http"
+	"time"
+)
+
+// apiKeyPrefix is the prefix of all company API keys,
+// it is used to tell them apart from access tokens
+const apiKeyPrefix = "jfk_"
+
+const (
+	apiKeySecretLength = 40
+	// apiKeyDisplayLength is the number of characters of the key
+	// (including apiKeyPrefix) that is stored to recognize the key later
+	apiKeyDisplayLength = 12
+)
+
+// scopes that can be granted to company API keys
+const (
+	apiKeyScopeJobsRead          = "jobs:read"
+	apiKeyScopeJobsWrite         = "jobs:write"
+	apiKeyScopeApplicationsRead  = "applications:read"
+	apiKeyScopeApplicationsWrite = "applications:write"
+)
+
+var (
+	invalidAPIKeyError    = errors.New("API key is invalid or has been revoked")
+	apiKeyNotAllowedError = errors.New("API keys cannot be used for this endpoint")
+	apiKeyNotFoundError   = errors.New("API key does not exist")
+	apiKeyOwnershipError  = errors.New("API key does not belong to the company of this employer")
+)
+
+// missingAPIKeyScopeError return API key does not have the scope error
+func missingAPIKeyScopeError(scope string) error {
+	return fmt.Errorf("API key does not have the %s scope", scope)
+}
+
+// verifyAPIKey finds the API key, records its use and creates a payload for the employer that created it,
+// if the key cannot be used the request is aborted
+func verifyAPIKey(ctx *gin.Context, store db.Store, key string) (*token.Payload, bool) {
+	apiKey, err := store.UseCompanyAPIKey(ctx, utils.HashSecret(key))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(invalidAPIKeyError))
+			return nil, false
+		}
+
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+		return nil, false
+	}
+
+	payload := &token.Payload{
+		Email:     apiKey.EmployerEmail,
+		Role:      token.RoleEmployer,
+		SubjectID: apiKey.EmployerID,
+		IssuedAt:  time.Now(),
+		APIKeyID:  apiKey.ID,
+		Scopes:    apiKey.Scopes,
+	}
+
+	return payload, true
+}
+
+type apiKeyResponse struct {
+	ID         int64      `json:"id"`
+	CompanyID  int32      `json:"company_id"`
+	EmployerID int32      `json:"employer_id"`
+	Name       string     `json:"name"`
+	KeyPrefix  string     `json:"key_prefix"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// newAPIKeyResponse converts db.CompanyApiKey to apiKeyResponse,
+// the hash of the key is never returned
+func newAPIKeyResponse(apiKey db.CompanyApiKey) apiKeyResponse {
+	res := apiKeyResponse{
+		ID:         apiKey.ID,
+		CompanyID:  apiKey.CompanyID,
+		EmployerID: apiKey.EmployerID,
+		Name:       apiKey.Name,
+		KeyPrefix:  apiKey.KeyPrefix,
+		Scopes:     apiKey.Scopes,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+	if apiKey.LastUsedAt.Valid {
+		res.LastUsedAt = &apiKey.LastUsedAt.Time
+	}
+	if apiKey.RevokedAt.Valid {
+		res.RevokedAt = &apiKey.RevokedAt.Time
+	}
+
+	return res
+}
+
+type createAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required,max=100"`
+	Scopes []string `json:"scopes" binding:"required,min=1,unique,dive,oneof=jobs:read jobs:write applications:read applications:write"`
+}
+
+type createAPIKeyResponse struct {
+	// Key is returned only once, it cannot be retrieved later
+	Key    string         `json:"key"`
+	APIKey apiKeyResponse `json:"api_key"`
+}
+
+// @Schemes
+// @Summary Create API key
+// @Description Create a new API key for the company of the logged-in employer. The key can be used in place of the access token (as a Bearer token) by integrations, e.g. an ATS, but only for endpoints allowed by its scopes (jobs:read, jobs:write, applications:read, applications:write). The key is returned only once. Only owners of the company can create API keys.
+// @Tags api keys
+// @Accept json
+// @Produce json
+// @param CreateAPIKeyRequest body createAPIKeyRequest true "API key name and scopes"
+// @Success 201 {object} createAPIKeyResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Employer is not an owner of the company"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/api-keys [post]
+// createAPIKey handles creating a company API key
+func (server *Server) createAPIKey(ctx *gin.Context) {
+	var request createAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// the keys give integrations access to the whole company
+	authEmployer, ok := server.getCompanyOwner(ctx, "create API keys")
+	if !ok {
+		return
+	}
+
+	secret, err := utils.RandomSecret(apiKeySecretLength)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	key := apiKeyPrefix + secret
+
+	apiKey, err := server.store.CreateCompanyAPIKey(ctx, db.CreateCompanyAPIKeyParams{
+		CompanyID:  authEmployer.CompanyID,
+		EmployerID: authEmployer.ID,
+		Name:       request.Name,
+		KeyPrefix:  key[:apiKeyDisplayLength],
+		HashedKey:  utils.HashSecret(key),
+		Scopes:     request.Scopes,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := createAPIKeyResponse{
+		Key:    key,
+		APIKey: newAPIKeyResponse(apiKey),
+	}
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// @Schemes
+// @Summary List API keys
+// @Description List all API keys (including revoked ones) of the company of the logged-in employer
+// @Tags api keys
+// @Produce json
+// @Success 200 {array} apiKeyResponse
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/api-keys [get]
+// listAPIKeys handles listing API keys of the company of the employer
+func (server *Server) listAPIKeys(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	apiKeys, err := server.store.ListCompanyAPIKeys(ctx, authEmployer.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]apiKeyResponse, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		res = append(res, newAPIKeyResponse(apiKey))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type revokeAPIKeyRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// @Schemes
+// @Summary Revoke API key
+// @Description Revoke an API key of the company of the logged-in employer. The key can no longer be used. Only owners of the company can revoke API keys.
+// @Tags api keys
+// @param id path integer true "API key ID"
+// @Success 204 {null} null
+// @Failure 400 {object} ErrorResponse "Invalid API key ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Employer is not an owner of the company or API key does not belong to the company of the employer"
+// @Failure 404 {object} ErrorResponse "API key not found or already revoked"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/api-keys/{id} [delete]
+// revokeAPIKey handles revoking a company API key
+func (server *Server) revokeAPIKey(ctx *gin.Context) {
+	var request revokeAPIKeyRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authEmployer, ok := server.getCompanyOwner(ctx, "revoke API keys")
+	if !ok {
+		return
+	}
+
+	apiKey, err := server.store.GetCompanyAPIKey(ctx, request.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(apiKeyNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if apiKey.CompanyID != authEmployer.CompanyID {
+		ctx.JSON(http.StatusForbidden, errorResponse(apiKeyOwnershipError))
+		return
+	}
+
+	_, err = server.store.RevokeCompanyAPIKey(ctx, apiKey.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(apiKeyNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
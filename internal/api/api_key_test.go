@@ -0,0 +1,683 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateAPIKeyAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	scopes := []string{apiKeyScopeJobsWrite, apiKeyScopeApplicationsRead}
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"name":   "ATS",
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					CreateCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateCompanyAPIKeyParams) (db.CompanyApiKey, error) {
+						require.Equal(t, employer.CompanyID, arg.CompanyID)
+						require.Equal(t, employer.ID, arg.EmployerID)
+						require.Equal(t, "ATS", arg.Name)
+						require.Equal(t, scopes, arg.Scopes)
+						require.True(t, strings.HasPrefix(arg.KeyPrefix, apiKeyPrefix))
+						require.Len(t, arg.KeyPrefix, apiKeyDisplayLength)
+						return db.CompanyApiKey{
+							ID:         1,
+							CompanyID:  arg.CompanyID,
+							EmployerID: arg.EmployerID,
+							Name:       arg.Name,
+							KeyPrefix:  arg.KeyPrefix,
+							HashedKey:  arg.HashedKey,
+							Scopes:     arg.Scopes,
+							CreatedAt:  time.Now(),
+						}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res createAPIKeyResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(res.Key, res.APIKey.KeyPrefix))
+				require.Len(t, res.Key, len(apiKeyPrefix)+apiKeySecretLength)
+				require.Equal(t, scopes, res.APIKey.Scopes)
+				require.Nil(t, res.APIKey.LastUsedAt)
+				require.Nil(t, res.APIKey.RevokedAt)
+				require.NotContains(t, recorder.Body.String(), "hashed_key")
+			},
+		},
+		{
+			name: "Invalid Scope",
+			body: gin.H{
+				"name":   "ATS",
+				"scopes": []string{"users:write"},
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "No Scopes",
+			body: gin.H{
+				"name":   "ATS",
+				"scopes": []string{},
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Unauthorized",
+			body: gin.H{
+				"name":   "ATS",
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Forbidden Viewer",
+			body: gin.H{
+				"name":   "ATS",
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, viewer.Email, token.RoleEmployer, viewer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(viewer.ID)).
+					Times(1).
+					Return(viewer, nil)
+				store.EXPECT().
+					CreateCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Employer Not Found",
+			body: gin.H{
+				"name":   "ATS",
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: gin.H{
+				"name":   "ATS",
+				"scopes": scopes,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					CreateCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CompanyApiKey{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := BaseUrl + "/employers/api-keys"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListAPIKeysAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	apiKeys := []db.CompanyApiKey{
+		generateRandomAPIKey(employer),
+		generateRandomAPIKey(employer),
+	}
+	apiKeys[1].LastUsedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	apiKeys[1].RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					ListCompanyAPIKeys(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(apiKeys, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res []apiKeyResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Len(t, res, len(apiKeys))
+				for i, apiKey := range apiKeys {
+					require.Equal(t, apiKey.ID, res[i].ID)
+					require.Equal(t, apiKey.KeyPrefix, res[i].KeyPrefix)
+					require.Equal(t, apiKey.Scopes, res[i].Scopes)
+				}
+				require.Nil(t, res[0].LastUsedAt)
+				require.NotNil(t, res[1].LastUsedAt)
+				require.NotNil(t, res[1].RevokedAt)
+			},
+		},
+		{
+			name: "API Key Not Allowed",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				r.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, apiKeyPrefix+utils.RandomString(apiKeySecretLength)))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UseCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ListCompanyAPIKeys(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					ListCompanyAPIKeys(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.CompanyApiKey{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/employers/api-keys"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestRevokeAPIKeyAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	apiKey := generateRandomAPIKey(employer)
+	otherEmployer, _, _ := generateRandomEmployerAndCompany(t)
+	otherAPIKey := generateRandomAPIKey(otherEmployer)
+	otherAPIKey.CompanyID = employer.CompanyID + 1
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
+	recruiter := employer
+	recruiter.Role = db.EmployerRoleRecruiter
+
+	testCases := []struct {
+		name          string
+		apiKeyID      int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			apiKeyID: apiKey.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyAPIKey(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(apiKey, nil)
+				store.EXPECT().
+					RevokeCompanyAPIKey(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(apiKey, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid ID",
+			apiKeyID: 0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Not Found",
+			apiKeyID: apiKey.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyAPIKey(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.CompanyApiKey{}, sql.ErrNoRows)
+				store.EXPECT().
+					RevokeCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Other Company",
+			apiKeyID: otherAPIKey.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyAPIKey(gomock.Any(), gomock.Eq(otherAPIKey.ID)).
+					Times(1).
+					Return(otherAPIKey, nil)
+				store.EXPECT().
+					RevokeCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Forbidden Viewer",
+			apiKeyID: apiKey.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(viewer, nil)
+				store.EXPECT().
+					GetCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					RevokeCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Forbidden Recruiter",
+			apiKeyID: apiKey.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(recruiter, nil)
+				store.EXPECT().
+					RevokeCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Already Revoked",
+			apiKeyID: apiKey.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyAPIKey(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(apiKey, nil)
+				store.EXPECT().
+					RevokeCompanyAPIKey(gomock.Any(), gomock.Eq(apiKey.ID)).
+					Times(1).
+					Return(db.CompanyApiKey{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/employers/api-keys/%d", BaseUrl, tc.apiKeyID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestAPIKeyAuthMiddleware(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	key := apiKeyPrefix + utils.RandomString(apiKeySecretLength)
+	usedAPIKey := db.UseCompanyAPIKeyRow{
+		ID:            1,
+		CompanyID:     employer.CompanyID,
+		EmployerID:    employer.ID,
+		Scopes:        []string{apiKeyScopeJobsWrite},
+		EmployerEmail: employer.Email,
+	}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK API Key",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				r.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, key))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UseCompanyAPIKey(gomock.Any(), gomock.Eq(utils.HashSecret(key))).
+					Times(1).
+					Return(usedAPIKey, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var payload token.Payload
+				err := json.NewDecoder(recorder.Body).Decode(&payload)
+				require.NoError(t, err)
+				require.Equal(t, token.RoleEmployer, payload.Role)
+				require.Equal(t, employer.ID, payload.SubjectID)
+				require.Equal(t, employer.Email, payload.Email)
+				require.Equal(t, usedAPIKey.ID, payload.APIKeyID)
+			},
+		},
+		{
+			name: "OK Access Token",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UseCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Scope",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				r.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, key))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				readOnlyAPIKey := usedAPIKey
+				readOnlyAPIKey.Scopes = []string{apiKeyScopeJobsRead}
+
+				store.EXPECT().
+					UseCompanyAPIKey(gomock.Any(), gomock.Eq(utils.HashSecret(key))).
+					Times(1).
+					Return(readOnlyAPIKey, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Or Revoked API Key",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				r.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, key))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UseCompanyAPIKey(gomock.Any(), gomock.Eq(utils.HashSecret(key))).
+					Times(1).
+					Return(db.UseCompanyAPIKeyRow{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				r.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, key))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UseCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UseCompanyAPIKeyRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "User Token",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", token.RoleUser, 1, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UseCompanyAPIKey(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			authPath := "/auth"
+			server.router.GET(
+				authPath,
+				apiKeyAuthMiddleware(server.tokenMaker, server.revocationStore, server.store),
+				requireEmployerScope(apiKeyScopeJobsWrite),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, ctx.MustGet(authorizationPayloadKey))
+				},
+			)
+
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func generateRandomAPIKey(employer db.Employer) db.CompanyApiKey {
+	key := apiKeyPrefix + utils.RandomString(apiKeySecretLength)
+	return db.CompanyApiKey{
+		ID:         int64(utils.RandomInt(1, 1000)),
+		CompanyID:  employer.CompanyID,
+		EmployerID: employer.ID,
+		Name:       utils.RandomString(6),
+		KeyPrefix:  key[:apiKeyDisplayLength],
+		HashedKey:  utils.HashSecret(key),
+		Scopes:     []string{apiKeyScopeJobsRead, apiKeyScopeJobsWrite},
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,451 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/lib/pq"
+	"net/http"
+	"time"
+)
+
+const invitationCodeLength = 32
+
+var (
+	invalidInvitationError     = errors.New("invitation code is invalid, already used or expired")
+	invitationNotFoundError    = errors.New("invitation does not exist")
+	invitationOwnershipError   = errors.New("invitation does not belong to the company of this employer")
+	employerAlreadyExistsError = errors.New("employer with this email already exists")
+	memberNotFoundError        = errors.New("employer is not a member of this company")
+	lastCompanyOwnerError      = errors.New("company must have at least one owner, transfer the ownership first")
+)
+
+// roleNotAllowedError return the role of the employer does not allow the action error
+func roleNotAllowedError(role db.EmployerRole, action string) error {
+	return fmt.Errorf("employer with role %s cannot %s", role, action)
+}
+
+// canManageJobs checks if the role allows creating, updating and deleting jobs of the company
+func canManageJobs(role db.EmployerRole) bool {
+	return role == db.EmployerRoleOwner || role == db.EmployerRoleRecruiter
+}
+
+// canManageApplications checks if the role allows changing statuses of job applications
+func canManageApplications(role db.EmployerRole) bool {
+	return role == db.EmployerRoleOwner || role == db.EmployerRoleRecruiter
+}
+
+// canManageCompany checks if the role allows changing the company details,
+// inviting employers and changing their roles
+func canManageCompany(role db.EmployerRole) bool {
+	return role == db.EmployerRoleOwner
+}
+
+// getCompanyOwner gets the authenticated employer and checks if they are an owner of the company,
+// if not, the request is aborted
+func (server *Server) getCompanyOwner(ctx *gin.Context, action string) (db.Employer, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return db.Employer{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Employer{}, false
+	}
+
+	if !canManageCompany(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, action)))
+		return db.Employer{}, false
+	}
+
+	return authEmployer, true
+}
+
+// joinCompany creates an employer in an existing company using the invitation code
+func (server *Server) joinCompany(ctx *gin.Context, request createEmployerRequest) {
+	hashedPassword, err := utils.HashPassword(request.Password)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	txResult, err := server.store.JoinCompanyTx(ctx, db.JoinCompanyTxParams{
+		HashedCode:     utils.HashSecret(request.InvitationCode),
+		Email:          request.Email,
+		FullName:       request.FullName,
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(invalidInvitationError))
+			return
+		}
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				ctx.JSON(http.StatusForbidden, errorResponse(employerAlreadyExistsError))
+				return
+			}
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	company, err := server.store.GetCompanyByID(ctx, txResult.Employer.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newEmployerResponse(txResult.Employer, company))
+}
+
+// sendInvitationEmail sends an email with the code that lets the invited employer join the company
+func (server *Server) sendInvitationEmail(inviter db.Employer, company db.Company, invitation db.CompanyInvitation, code string) error {
+	subject := fmt.Sprintf("Join %s on Job Finder", company.Name)
+	content := fmt.Sprintf(`Hello,<br/>
+	%s has invited you to join %s as %s.<br/>
+	Your invitation code is <b>%s</b>, it is valid for 7 days.<br/>
+	Use it when registering as an employer to join the company.<br/>
+	`, inviter.FullName, company.Name, invitation.Role, code)
+
+	err := server.emailSender.SendEmail(subject, content, []string{invitation.Email})
+	if err != nil {
+		return fmt.Errorf("cannot send invitation email: %w", err)
+	}
+
+	return nil
+}
+
+type invitationResponse struct {
+	ID        int64           `json:"id"`
+	CompanyID int32           `json:"company_id"`
+	InvitedBy int32           `json:"invited_by"`
+	Email     string          `json:"email"`
+	Role      db.EmployerRole `json:"role"`
+	CreatedAt time.Time       `json:"created_at"`
+	ExpiredAt time.Time       `json:"expired_at"`
+}
+
+// newInvitationResponse converts db.CompanyInvitation to invitationResponse,
+// the hash of the code is never returned
+func newInvitationResponse(invitation db.CompanyInvitation) invitationResponse {
+	return invitationResponse{
+		ID:        invitation.ID,
+		CompanyID: invitation.CompanyID,
+		InvitedBy: invitation.InvitedBy,
+		Email:     invitation.Email,
+		Role:      invitation.Role,
+		CreatedAt: invitation.CreatedAt,
+		ExpiredAt: invitation.ExpiredAt,
+	}
+}
+
+type inviteEmployerRequest struct {
+	Email string          `json:"email" binding:"required,email"`
+	Role  db.EmployerRole `json:"role" binding:"required,oneof=owner recruiter viewer"`
+}
+
+// @Schemes
+// @Summary Invite employer
+// @Description Invite an employer to join the company of the logged-in employer. The invitation code is sent by email and has to be provided when registering at POST /employers. Only owners of the company can invite employers.
+// @Tags company members
+// @Accept json
+// @Produce json
+// @param InviteEmployerRequest body inviteEmployerRequest true "Email and role of the invited employer"
+// @Success 201 {object} invitationResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Employer is not an owner of the company or employer with given email already exists"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/invitations [post]
+// inviteEmployer handles inviting employers to the company
+func (server *Server) inviteEmployer(ctx *gin.Context) {
+	var request inviteEmployerRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authEmployer, ok := server.getCompanyOwner(ctx, "invite employers")
+	if !ok {
+		return
+	}
+
+	// an employer belongs to exactly one company
+	_, err := server.store.GetEmployerByEmail(ctx, request.Email)
+	if err == nil {
+		ctx.JSON(http.StatusForbidden, errorResponse(employerAlreadyExistsError))
+		return
+	}
+	if err != sql.ErrNoRows {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	company, err := server.store.GetCompanyByID(ctx, authEmployer.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	code, err := utils.RandomSecret(invitationCodeLength)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	txResult, err := server.store.CreateCompanyInvitationTx(ctx, db.CreateCompanyInvitationTxParams{
+		CreateCompanyInvitationParams: db.CreateCompanyInvitationParams{
+			CompanyID:  authEmployer.CompanyID,
+			InvitedBy:  authEmployer.ID,
+			Email:      request.Email,
+			Role:       request.Role,
+			HashedCode: utils.HashSecret(code),
+		},
+		AfterCreate: func(invitation db.CompanyInvitation) error {
+			return server.sendInvitationEmail(authEmployer, company, invitation, code)
+		},
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newInvitationResponse(txResult.CompanyInvitation))
+}
+
+// @Schemes
+// @Summary List invitations
+// @Description List pending invitations to the company of the logged-in employer. Only owners of the company can access this endpoint.
+// @Tags company members
+// @Produce json
+// @Success 200 {array} invitationResponse
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Employer is not an owner of the company"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/invitations [get]
+// listInvitations handles listing pending invitations to the company
+func (server *Server) listInvitations(ctx *gin.Context) {
+	authEmployer, ok := server.getCompanyOwner(ctx, "list invitations")
+	if !ok {
+		return
+	}
+
+	invitations, err := server.store.ListCompanyInvitations(ctx, authEmployer.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]invitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		res = append(res, newInvitationResponse(invitation))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type deleteInvitationRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// @Schemes
+// @Summary Delete invitation
+// @Description Delete an invitation to the company of the logged-in employer, its code can no longer be used. Only owners of the company can access this endpoint.
+// @Tags company members
+// @param id path integer true "Invitation ID"
+// @Success 204 {null} null
+// @Failure 400 {object} ErrorResponse "Invalid invitation ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Employer is not an owner of the company or the invitation is to another company"
+// @Failure 404 {object} ErrorResponse "Invitation not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/invitations/{id} [delete]
+// deleteInvitation handles deleting an invitation to the company
+func (server *Server) deleteInvitation(ctx *gin.Context) {
+	var request deleteInvitationRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authEmployer, ok := server.getCompanyOwner(ctx, "delete invitations")
+	if !ok {
+		return
+	}
+
+	invitation, err := server.store.GetCompanyInvitation(ctx, request.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(invitationNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if invitation.CompanyID != authEmployer.CompanyID {
+		ctx.JSON(http.StatusForbidden, errorResponse(invitationOwnershipError))
+		return
+	}
+
+	err = server.store.DeleteCompanyInvitation(ctx, invitation.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type companyMemberResponse struct {
+	EmployerID int32           `json:"employer_id"`
+	FullName   string          `json:"full_name"`
+	Email      string          `json:"email"`
+	Role       db.EmployerRole `json:"role"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// newCompanyMemberResponse converts db.Employer to companyMemberResponse
+func newCompanyMemberResponse(employer db.Employer) companyMemberResponse {
+	return companyMemberResponse{
+		EmployerID: employer.ID,
+		FullName:   employer.FullName,
+		Email:      employer.Email,
+		Role:       employer.Role,
+		CreatedAt:  employer.CreatedAt,
+	}
+}
+
+// @Schemes
+// @Summary List company members
+// @Description List employers of the company of the logged-in employer together with their roles
+// @Tags company members
+// @Produce json
+// @Success 200 {array} companyMemberResponse
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/members [get]
+// listCompanyMembers handles listing employers of the company
+func (server *Server) listCompanyMembers(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	employers, err := server.store.ListCompanyEmployers(ctx, authEmployer.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]companyMemberResponse, 0, len(employers))
+	for _, employer := range employers {
+		res = append(res, newCompanyMemberResponse(employer))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type updateCompanyMemberRoleUriRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+type updateCompanyMemberRoleRequest struct {
+	Role db.EmployerRole `json:"role" binding:"required,oneof=owner recruiter viewer"`
+}
+
+// @Schemes
+// @Summary Change role of company member
+// @Description Change the role of an employer of the company of the logged-in employer. Only owners of the company can change roles and the company must always have at least one owner.
+// @Tags company members
+// @Accept json
+// @Produce json
+// @param id path integer true "Employer ID"
+// @param UpdateCompanyMemberRoleRequest body updateCompanyMemberRoleRequest true "New role"
+// @Success 200 {object} companyMemberResponse
+// @Failure 400 {object} ErrorResponse "Invalid employer ID or role"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Employer is not an owner of the company or it is the last owner"
+// @Failure 404 {object} ErrorResponse "Employer is not a member of the company"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/members/{id}/role [patch]
+// updateCompanyMemberRole handles changing the role of an employer in the company
+func (server *Server) updateCompanyMemberRole(ctx *gin.Context) {
+	var uriRequest updateCompanyMemberRoleUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request updateCompanyMemberRoleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authEmployer, ok := server.getCompanyOwner(ctx, "change roles")
+	if !ok {
+		return
+	}
+
+	member, err := server.store.GetEmployerByID(ctx, uriRequest.ID)
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err == sql.ErrNoRows || member.CompanyID != authEmployer.CompanyID {
+		ctx.JSON(http.StatusNotFound, errorResponse(memberNotFoundError))
+		return
+	}
+
+	// the company cannot be left without an owner
+	if member.Role == db.EmployerRoleOwner && request.Role != db.EmployerRoleOwner {
+		owners, err := server.store.CountCompanyEmployersByRole(ctx, db.CountCompanyEmployersByRoleParams{
+			CompanyID: member.CompanyID,
+			Role:      db.EmployerRoleOwner,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if owners <= 1 {
+			ctx.JSON(http.StatusForbidden, errorResponse(lastCompanyOwnerError))
+			return
+		}
+	}
+
+	member, err = server.store.UpdateEmployerRole(ctx, db.UpdateEmployerRoleParams{
+		ID:   member.ID,
+		Role: request.Role,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCompanyMemberResponse(member))
+}
@@ -0,0 +1,673 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateEmployerWithInvitationAPI(t *testing.T) {
+	member, password, company := generateRandomEmployerAndCompany(t)
+	member.Role = db.EmployerRoleRecruiter
+	member.IsEmailVerified = true
+	code := utils.RandomString(invitationCodeLength)
+
+	requestBody := gin.H{
+		"email":           member.Email,
+		"full_name":       member.FullName,
+		"password":        password,
+		"invitation_code": code,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: requestBody,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCompany(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					JoinCompanyTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.JoinCompanyTxParams) (db.JoinCompanyTxResult, error) {
+						require.Equal(t, utils.HashSecret(code), arg.HashedCode)
+						require.Equal(t, member.Email, arg.Email)
+						require.Equal(t, member.FullName, arg.FullName)
+						require.NoError(t, utils.CheckPassword(password, arg.HashedPassword))
+						return db.JoinCompanyTxResult{Employer: member}, nil
+					})
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res employerResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, member.ID, res.EmployerID)
+				require.Equal(t, company.ID, res.CompanyID)
+				require.Equal(t, db.EmployerRoleRecruiter, res.Role)
+				require.True(t, res.IsEmailVerified)
+			},
+		},
+		{
+			name: "Invalid Invitation",
+			body: requestBody,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					JoinCompanyTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JoinCompanyTxResult{}, sql.ErrNoRows)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Employer Already Exists",
+			body: requestBody,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					JoinCompanyTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JoinCompanyTxResult{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Company Details",
+			body: gin.H{
+				"email":     member.Email,
+				"full_name": member.FullName,
+				"password":  password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateCompany(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					JoinCompanyTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := BaseUrl + "/employers"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestInviteEmployerAPI(t *testing.T) {
+	owner, _, company := generateRandomEmployerAndCompany(t)
+	recruiter := owner
+	recruiter.Role = db.EmployerRoleRecruiter
+	invitedEmail := utils.RandomEmail()
+
+	requestBody := gin.H{
+		"email": invitedEmail,
+		"role":  db.EmployerRoleRecruiter,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: requestBody,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(invitedEmail)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company, nil)
+				store.EXPECT().
+					CreateCompanyInvitationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateCompanyInvitationTxParams) (db.CreateCompanyInvitationTxResult, error) {
+						require.Equal(t, company.ID, arg.CompanyID)
+						require.Equal(t, owner.ID, arg.InvitedBy)
+						require.Equal(t, invitedEmail, arg.Email)
+						require.Equal(t, db.EmployerRoleRecruiter, arg.Role)
+						require.NotEmpty(t, arg.HashedCode)
+
+						invitation := db.CompanyInvitation{
+							ID:         1,
+							CompanyID:  arg.CompanyID,
+							InvitedBy:  arg.InvitedBy,
+							Email:      arg.Email,
+							Role:       arg.Role,
+							HashedCode: arg.HashedCode,
+							CreatedAt:  time.Now(),
+							ExpiredAt:  time.Now().Add(7 * 24 * time.Hour),
+						}
+						require.NoError(t, arg.AfterCreate(invitation))
+
+						return db.CreateCompanyInvitationTxResult{CompanyInvitation: invitation}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res invitationResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, invitedEmail, res.Email)
+				require.Equal(t, db.EmployerRoleRecruiter, res.Role)
+				require.NotContains(t, recorder.Body.String(), "hashed_code")
+			},
+		},
+		{
+			name: "Invalid Role",
+			body: gin.H{
+				"email": invitedEmail,
+				"role":  "admin",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateCompanyInvitationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Recruiter Not Allowed",
+			body: requestBody,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(recruiter, nil)
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateCompanyInvitationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Employer Already Exists",
+			body: requestBody,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(invitedEmail)).
+					Times(1).
+					Return(db.Employer{Email: invitedEmail}, nil)
+				store.EXPECT().
+					CreateCompanyInvitationTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: requestBody,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(invitedEmail)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company, nil)
+				store.EXPECT().
+					CreateCompanyInvitationTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateCompanyInvitationTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := BaseUrl + "/employers/invitations"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, owner.Email, token.RoleEmployer, owner.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestDeleteInvitationAPI(t *testing.T) {
+	owner, _, _ := generateRandomEmployerAndCompany(t)
+	invitation := generateRandomInvitation(owner)
+	otherInvitation := generateRandomInvitation(owner)
+	otherInvitation.CompanyID = owner.CompanyID + 1
+
+	testCases := []struct {
+		name          string
+		invitationID  int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:         "OK",
+			invitationID: invitation.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					GetCompanyInvitation(gomock.Any(), gomock.Eq(invitation.ID)).
+					Times(1).
+					Return(invitation, nil)
+				store.EXPECT().
+					DeleteCompanyInvitation(gomock.Any(), gomock.Eq(invitation.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:         "Not Found",
+			invitationID: invitation.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					GetCompanyInvitation(gomock.Any(), gomock.Eq(invitation.ID)).
+					Times(1).
+					Return(db.CompanyInvitation{}, sql.ErrNoRows)
+				store.EXPECT().
+					DeleteCompanyInvitation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:         "Other Company",
+			invitationID: otherInvitation.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					GetCompanyInvitation(gomock.Any(), gomock.Eq(otherInvitation.ID)).
+					Times(1).
+					Return(otherInvitation, nil)
+				store.EXPECT().
+					DeleteCompanyInvitation(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/employers/invitations/%d", BaseUrl, tc.invitationID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, owner.Email, token.RoleEmployer, owner.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListCompanyMembersAPI(t *testing.T) {
+	viewer, _, _ := generateRandomEmployerAndCompany(t)
+	viewer.Role = db.EmployerRoleViewer
+	owner, _, _ := generateRandomEmployerAndCompany(t)
+	owner.CompanyID = viewer.CompanyID
+	members := []db.Employer{owner, viewer}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetEmployerByID(gomock.Any(), gomock.Eq(viewer.ID)).
+		Times(1).
+		Return(viewer, nil)
+	store.EXPECT().
+		ListCompanyEmployers(gomock.Any(), gomock.Eq(viewer.CompanyID)).
+		Times(1).
+		Return(members, nil)
+
+	server := newTestServer(t, store, nil)
+	recorder := httptest.NewRecorder()
+
+	req, err := http.NewRequest(http.MethodGet, BaseUrl+"/employers/members", nil)
+	require.NoError(t, err)
+
+	addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, viewer.Email, token.RoleEmployer, viewer.ID, time.Minute)
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res []companyMemberResponse
+	err = json.NewDecoder(recorder.Body).Decode(&res)
+	require.NoError(t, err)
+	require.Len(t, res, len(members))
+	for i, member := range members {
+		require.Equal(t, member.ID, res[i].EmployerID)
+		require.Equal(t, member.Email, res[i].Email)
+		require.Equal(t, member.Role, res[i].Role)
+	}
+	require.NotContains(t, recorder.Body.String(), "hashed_password")
+}
+
+func TestUpdateCompanyMemberRoleAPI(t *testing.T) {
+	owner, _, _ := generateRandomEmployerAndCompany(t)
+	member, _, _ := generateRandomEmployerAndCompany(t)
+	member.ID = owner.ID + 1
+	member.CompanyID = owner.CompanyID
+	member.Role = db.EmployerRoleRecruiter
+	otherEmployer := member
+	otherEmployer.CompanyID = owner.CompanyID + 1
+	recruiter := owner
+	recruiter.Role = db.EmployerRoleRecruiter
+
+	countOwnersParams := db.CountCompanyEmployersByRoleParams{
+		CompanyID: owner.CompanyID,
+		Role:      db.EmployerRoleOwner,
+	}
+
+	testCases := []struct {
+		name          string
+		memberID      int32
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			memberID: member.ID,
+			body:     gin.H{"role": db.EmployerRoleViewer},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(member.ID)).
+					Times(1).
+					Return(member, nil)
+				store.EXPECT().
+					CountCompanyEmployersByRole(gomock.Any(), gomock.Any()).
+					Times(0)
+				updatedMember := member
+				updatedMember.Role = db.EmployerRoleViewer
+				store.EXPECT().
+					UpdateEmployerRole(gomock.Any(), gomock.Eq(db.UpdateEmployerRoleParams{
+						ID:   member.ID,
+						Role: db.EmployerRoleViewer,
+					})).
+					Times(1).
+					Return(updatedMember, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res companyMemberResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, member.ID, res.EmployerID)
+				require.Equal(t, db.EmployerRoleViewer, res.Role)
+			},
+		},
+		{
+			name:     "OK Demote Owner",
+			memberID: owner.ID,
+			body:     gin.H{"role": db.EmployerRoleRecruiter},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(2).
+					Return(owner, nil)
+				store.EXPECT().
+					CountCompanyEmployersByRole(gomock.Any(), gomock.Eq(countOwnersParams)).
+					Times(1).
+					Return(int64(2), nil)
+				store.EXPECT().
+					UpdateEmployerRole(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(recruiter, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Last Owner",
+			memberID: owner.ID,
+			body:     gin.H{"role": db.EmployerRoleViewer},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(2).
+					Return(owner, nil)
+				store.EXPECT().
+					CountCompanyEmployersByRole(gomock.Any(), gomock.Eq(countOwnersParams)).
+					Times(1).
+					Return(int64(1), nil)
+				store.EXPECT().
+					UpdateEmployerRole(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Not Owner",
+			memberID: member.ID,
+			body:     gin.H{"role": db.EmployerRoleOwner},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(recruiter, nil)
+				store.EXPECT().
+					UpdateEmployerRole(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Other Company",
+			memberID: otherEmployer.ID,
+			body:     gin.H{"role": db.EmployerRoleViewer},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(owner.ID)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(otherEmployer.ID)).
+					Times(1).
+					Return(otherEmployer, nil)
+				store.EXPECT().
+					UpdateEmployerRole(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid Role",
+			memberID: member.ID,
+			body:     gin.H{"role": "admin"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/employers/members/%d/role", BaseUrl, tc.memberID)
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, owner.Email, token.RoleEmployer, owner.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func generateRandomInvitation(inviter db.Employer) db.CompanyInvitation {
+	return db.CompanyInvitation{
+		ID:         int64(utils.RandomInt(1, 1000)),
+		CompanyID:  inviter.CompanyID,
+		InvitedBy:  inviter.ID,
+		Email:      utils.RandomEmail(),
+		Role:       db.EmployerRoleRecruiter,
+		HashedCode: utils.HashSecret(utils.RandomString(invitationCodeLength)),
+		CreatedAt:  time.Now(),
+		ExpiredAt:  time.Now().Add(7 * 24 * time.Hour),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
 	"github.com/grannnsacker/job-finder-back/pkg/token"
 	"github.com/grannnsacker/job-finder-back/pkg/utils"
@@ -18,20 +19,24 @@ type createEmployerRequest struct {
 	FullName        string `json:"full_name" binding:"required"`
 	Email           string `json:"email" binding:"required,email"`
 	Password        string `json:"password" binding:"required,min=6"`
-	CompanyName     string `json:"company_name" binding:"required"`
-	CompanyIndustry string `json:"company_industry" binding:"required"`
-	CompanyLocation string `json:"company_location" binding:"required"`
+	CompanyName     string `json:"company_name" binding:"required_without=InvitationCode"`
+	CompanyIndustry string `json:"company_industry" binding:"required_without=InvitationCode"`
+	CompanyLocation string `json:"company_location" binding:"required_without=InvitationCode"`
+	// InvitationCode is used to join an existing company instead of creating a new one
+	InvitationCode string `json:"invitation_code" binding:"omitempty,len=32"`
 }
 
 type employerResponse struct {
-	EmployerID        int32     `json:"employer_id"`
-	FullName          string    `json:"full_name"`
-	Email             string    `json:"email"`
-	EmployerCreatedAt time.Time `json:"employer_created_at"`
-	CompanyID         int32     `json:"company_id"`
-	CompanyName       string    `json:"company_name"`
-	CompanyIndustry   string    `json:"company_industry"`
-	CompanyLocation   string    `json:"company_location"`
+	EmployerID        int32           `json:"employer_id"`
+	FullName          string          `json:"full_name"`
+	Email             string          `json:"email"`
+	IsEmailVerified   bool            `json:"is_email_verified"`
+	Role              db.EmployerRole `json:"role"`
+	EmployerCreatedAt time.Time       `json:"employer_created_at"`
+	CompanyID         int32           `json:"company_id"`
+	CompanyName       string          `json:"company_name"`
+	CompanyIndustry   string          `json:"company_industry"`
+	CompanyLocation   string          `json:"company_location"`
 }
 
 // newEmployerResponse creates a new employer response from a db.Employer and db.Company
@@ -40,6 +45,8 @@ func newEmployerResponse(employer db.Employer, company db.Company) employerRespo
 		EmployerID:        employer.ID,
 		FullName:          employer.FullName,
 		Email:             employer.Email,
+		IsEmailVerified:   employer.IsEmailVerified,
+		Role:              employer.Role,
 		EmployerCreatedAt: employer.CreatedAt,
 		CompanyID:         company.ID,
 		CompanyName:       company.Name,
@@ -50,7 +57,7 @@ func newEmployerResponse(employer db.Employer, company db.Company) employerRespo
 
 // @Schemes
 // @Summary Create employer
-// @Description Create a new employer
+// @Description Create a new employer together with a new company, the employer becomes its owner. With the invitation code, the employer joins the company they were invited to instead.
 // @Tags employers
 // @Accept json
 // @Produce json
@@ -58,6 +65,7 @@ func newEmployerResponse(employer db.Employer, company db.Company) employerRespo
 // @Success 201 {object} employerResponse
 // @Failure 400 {object} ErrorResponse "Invalid request body"
 // @Failure 403 {object} ErrorResponse "Company with given name or employer with given email already exists"
+// @Failure 404 {object} ErrorResponse "Invalid, already used or expired invitation code"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Router /employers [post]
 // createEmployer handles creating a new employer
@@ -68,6 +76,11 @@ func (server *Server) createEmployer(ctx *gin.Context) {
 		return
 	}
 
+	if request.InvitationCode != "" {
+		server.joinCompany(ctx, request)
+		return
+	}
+
 	// create a company
 	companyParams := db.CreateCompanyParams{
 		Name:     request.CompanyName,
@@ -103,9 +116,10 @@ func (server *Server) createEmployer(ctx *gin.Context) {
 			FullName:       request.FullName,
 			Email:          request.Email,
 			HashedPassword: hashedPassword,
+			Role:           db.EmployerRoleOwner,
 		},
-		AfterCreate: func(employer db.Employer) error {
-			return nil
+		AfterCreate: func(employer db.Employer, verifyEmail db.VerifyEmail) error {
+			return server.sendVerifyEmail(employer.FullName, verifyEmail)
 		},
 	}
 
@@ -133,22 +147,28 @@ type loginEmployerRequest struct {
 }
 
 type loginEmployerResponse struct {
-	AccessToken string           `json:"access_token"`
-	Employer    employerResponse `json:"employer"`
+	SessionID             uuid.UUID        `json:"session_id"`
+	AccessToken           string           `json:"access_token"`
+	AccessTokenExpiresAt  time.Time        `json:"access_token_expires_at"`
+	RefreshToken          string           `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time        `json:"refresh_token_expires_at"`
+	Employer              employerResponse `json:"employer"`
 }
 
 // @Schemes
 // @Summary Login employer
-// @Description Login an employer
+// @Description Login an employer. If the employer has enabled two-factor authentication, a challenge token is returned instead of the access token.
 // @Tags employers
 // @Accept json
 // @Produce json
 // @param LoginEmployerRequest body loginEmployerRequest true "Employer credentials"
 // @Success 200 {object} loginEmployerResponse
+// @Success 202 {object} twoFactorChallengeResponse "Two-factor authentication is enabled, the code has to be verified at /employers/login/2fa"
 // @Failure 400 {object} ErrorResponse "Invalid request body"
-// @Failure 401 {object} ErrorResponse "Incorrect password"
-// @Failure 403 {object} ErrorResponse "Email not verified"
-// @Failure 404 {object} ErrorResponse "Employer with given email or company with given id does not exist"
+// @Failure 401 {object} ErrorResponse "Incorrect email or password"
+// @Failure 403 {object} ErrorResponse "Account or company of the employer has been suspended"
+// @Failure 404 {object} ErrorResponse "Company with given id does not exist"
+// @Failure 429 {object} ErrorResponse "Too many failed login attempts, see the Retry-After header"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Router /employers/login [post]
 // loginEmployer handles login of an employer
@@ -159,12 +179,17 @@ func (server *Server) loginEmployer(ctx *gin.Context) {
 		return
 	}
 
+	accountKey := loginAccountKey(token.RoleEmployer, request.Email)
+	if !server.checkLoginAttempts(ctx, accountKey) {
+		return
+	}
+
 	// get the employer
 	employer, err := server.store.GetEmployerByEmail(ctx, request.Email)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			err = fmt.Errorf("employer with this email does not exist")
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			checkPasswordOfMissingAccount(request.Password)
+			server.failLogin(ctx, accountKey, invalidCredentialsError)
 			return
 		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -174,18 +199,39 @@ func (server *Server) loginEmployer(ctx *gin.Context) {
 	// check password
 	err = utils.CheckPassword(request.Password, employer.HashedPassword)
 	if err != nil {
-		err = fmt.Errorf("incorrect password")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		server.failLogin(ctx, accountKey, invalidCredentialsError)
 		return
 	}
 
-	// create access token
-	accessToken, err := server.tokenMaker.CreateToken(employer.Email, server.config.AccessTokenDuration)
+	if employer.SuspendedAt.Valid {
+		ctx.JSON(http.StatusForbidden, errorResponse(accountSuspendedError))
+		return
+	}
+
+	// employers with two-factor authentication have to verify the code first,
+	// failed logins are reset only after that
+	employerTotp, err := server.store.GetEmployerTOTP(ctx, employer.ID)
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err == nil && employerTotp.IsEnabled {
+		server.createTwoFactorChallenge(ctx, employer)
+		return
+	}
+
+	err = server.loginAccountLimiter.Reset(ctx, accountKey)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	server.completeEmployerLogin(ctx, employer)
+}
+
+// completeEmployerLogin creates the access token and the session of the employer
+// whose credentials (and second factor) were verified
+func (server *Server) completeEmployerLogin(ctx *gin.Context, employer db.Employer) {
 	// get employers company
 	company, err := server.store.GetCompanyByID(ctx, employer.CompanyID)
 	if err != nil {
@@ -199,9 +245,32 @@ func (server *Server) loginEmployer(ctx *gin.Context) {
 		return
 	}
 
+	if company.SuspendedAt.Valid {
+		ctx.JSON(http.StatusForbidden, errorResponse(companySuspendedError))
+		return
+	}
+
+	// create access token
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(employer.Email, token.RoleEmployer, employer.ID, server.config.AccessTokenDuration)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// create refresh token and session
+	session, refreshToken, refreshPayload, err := server.createSession(ctx, employer.Email, token.RoleEmployer, employer.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
 	res := loginEmployerResponse{
-		AccessToken: accessToken,
-		Employer:    newEmployerResponse(employer, company),
+		SessionID:             session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshPayload.ExpiredAt,
+		Employer:              newEmployerResponse(employer, company),
 	}
 
 	ctx.JSON(http.StatusOK, res)
@@ -220,12 +289,10 @@ func (server *Server) loginEmployer(ctx *gin.Context) {
 // getEmployer get details of the authenticated employer
 func (server *Server) getEmployer(ctx *gin.Context) {
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -260,6 +327,7 @@ type updateEmployerRequest struct {
 // @Success 200 {object} employerResponse
 // @Failure 400 {object} ErrorResponse "Invalid request body"
 // @Failure 401 {object} ErrorResponse "Only employers can access this endpoint."
+// @Failure 403 {object} ErrorResponse "Only owners of the company can change the company details"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
 // @Router /employers [patch]
@@ -280,12 +348,10 @@ func (server *Server) updateEmployer(ctx *gin.Context) {
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -293,6 +359,13 @@ func (server *Server) updateEmployer(ctx *gin.Context) {
 		return
 	}
 
+	// only owners can change the company details
+	changesCompany := request.CompanyName != "" || request.CompanyIndustry != "" || request.CompanyLocation != ""
+	if changesCompany && !canManageCompany(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "change the company details")))
+		return
+	}
+
 	company, err := server.store.GetCompanyByID(ctx, authEmployer.CompanyID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -390,12 +463,10 @@ func (server *Server) updateEmployerPassword(ctx *gin.Context) {
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -432,22 +503,21 @@ func (server *Server) updateEmployerPassword(ctx *gin.Context) {
 
 // @Schemes
 // @Summary Delete employer
-// @Description Delete the logged-in employer
+// @Description Delete the logged-in employer. The company is deleted as well if the employer is its only member.
 // @Tags employers
 // @Success 204 {null} null
 // @Failure 401 {object} ErrorResponse "Only employers can access this endpoint."
+// @Failure 403 {object} ErrorResponse "Employer is the last owner of the company that has other members"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
 // @Router /employers [delete]
 // deleteEmployer handles deleting employer
 func (server *Server) deleteEmployer(ctx *gin.Context) {
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -455,6 +525,40 @@ func (server *Server) deleteEmployer(ctx *gin.Context) {
 		return
 	}
 
+	members, err := server.store.CountCompanyEmployers(ctx, authEmployer.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// other employers keep the company, but it cannot be left without an owner
+	if members > 1 {
+		if authEmployer.Role == db.EmployerRoleOwner {
+			owners, err := server.store.CountCompanyEmployersByRole(ctx, db.CountCompanyEmployersByRoleParams{
+				CompanyID: authEmployer.CompanyID,
+				Role:      db.EmployerRoleOwner,
+			})
+			if err != nil {
+				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+				return
+			}
+
+			if owners <= 1 {
+				ctx.JSON(http.StatusForbidden, errorResponse(lastCompanyOwnerError))
+				return
+			}
+		}
+
+		err = server.store.DeleteEmployer(ctx, authEmployer.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusNoContent, nil)
+		return
+	}
+
 	// delete the company
 	err = server.store.DeleteCompany(ctx, authEmployer.CompanyID)
 	if err != nil {
@@ -472,6 +576,24 @@ func (server *Server) deleteEmployer(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, nil)
 }
 
+// @Schemes
+// @Summary Logout employer
+// @Description Logout the employer - block the session of the refresh token and revoke the access token used to make this request
+// @Tags employers
+// @Accept json
+// @param LogoutRequest body logoutRequest true "Refresh token received at login"
+// @Success 204 {null} null
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Only employers can access this endpoint or the refresh token is invalid."
+// @Failure 403 {object} ErrorResponse "Refresh token belongs to another account"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/logout [post]
+// logoutEmployer handles logging out employers
+func (server *Server) logoutEmployer(ctx *gin.Context) {
+	server.logout(ctx)
+}
+
 type getUserAsEmployerRequest struct {
 	Email string `uri:"email" binding:"required,email"`
 }
@@ -495,21 +617,6 @@ func (server *Server) getUserAsEmployer(ctx *gin.Context) {
 		return
 	}
 
-	// authenticate the employer
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	_, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
-			return
-		}
-
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
-
 	user, userSkills, err := server.store.GetUserDetailsByEmail(ctx, request.Email)
 	if err != nil {
 		if err == sql.ErrNoRows {
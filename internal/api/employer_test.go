@@ -43,7 +43,11 @@ func (e eqCreateEmployerTxParamsMatcher) Matches(x interface{}) bool {
 		return false
 	}
 
-	err = actualArg.AfterCreate(e.employer)
+	err = actualArg.AfterCreate(e.employer, db.VerifyEmail{
+		ID:         int64(utils.RandomInt(1, 1000)),
+		Email:      e.employer.Email,
+		SecretCode: utils.RandomString(32),
+	})
 	return err == nil
 }
 
@@ -92,6 +96,7 @@ func TestCreateEmployerAPI(t *testing.T) {
 						FullName:       employer.FullName,
 						Email:          employer.Email,
 						HashedPassword: employer.HashedPassword,
+						Role:           db.EmployerRoleOwner,
 					},
 				}
 				store.EXPECT().
@@ -240,6 +245,13 @@ func TestLoginEmployerAPI(t *testing.T) {
 					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
 					Times(1).
 					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
 					Times(1).
@@ -249,6 +261,59 @@ func TestLoginEmployerAPI(t *testing.T) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 			},
 		},
+		{
+			name: "Employer Suspended",
+			body: gin.H{
+				"email":    employer.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				suspendedEmployer := employer
+				suspendedEmployer.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					Times(1).
+					Return(suspendedEmployer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Company Suspended",
+			body: gin.H{
+				"email":    employer.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				suspendedCompany := company
+				suspendedCompany.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(suspendedCompany, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name: "Employer Not Found",
 			body: gin.H{
@@ -260,12 +325,15 @@ func TestLoginEmployerAPI(t *testing.T) {
 					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
 					Times(1).
 					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireInvalidCredentials(t, recorder)
 			},
 		},
 		{
@@ -279,6 +347,9 @@ func TestLoginEmployerAPI(t *testing.T) {
 					GetEmployerByEmail(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.Employer{}, sql.ErrConnDone)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -298,6 +369,13 @@ func TestLoginEmployerAPI(t *testing.T) {
 					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
 					Times(1).
 					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -318,6 +396,13 @@ func TestLoginEmployerAPI(t *testing.T) {
 					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
 					Times(1).
 					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -327,6 +412,34 @@ func TestLoginEmployerAPI(t *testing.T) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
+		{
+			name: "Internal Server Error CreateSession",
+			body: gin.H{
+				"email":    employer.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{}, sql.ErrConnDone)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(company, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
 		{
 			name: "Invalid Email",
 			body: gin.H{
@@ -337,6 +450,9 @@ func TestLoginEmployerAPI(t *testing.T) {
 				store.EXPECT().
 					GetEmployerByEmail(gomock.Any(), gomock.Any()).
 					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -356,12 +472,18 @@ func TestLoginEmployerAPI(t *testing.T) {
 					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
 					Times(1).
 					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				requireInvalidCredentials(t, recorder)
 			},
 		},
 		{
@@ -374,6 +496,9 @@ func TestLoginEmployerAPI(t *testing.T) {
 				store.EXPECT().
 					GetEmployerByEmail(gomock.Any(), gomock.Any()).
 					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -423,11 +548,11 @@ func TestGetEmployerAPI(t *testing.T) {
 		{
 			name: "OK",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -443,13 +568,12 @@ func TestGetEmployerAPI(t *testing.T) {
 		{
 			name: "Unauthorized Only Employer Access",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(user.Email)).
-					Times(1).
-					Return(db.Employer{}, sql.ErrNoRows)
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -459,13 +583,13 @@ func TestGetEmployerAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetEmployerByEmail",
+			name: "Internal Server Error GetEmployerByID",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(db.Employer{}, sql.ErrConnDone)
 				store.EXPECT().
@@ -479,11 +603,11 @@ func TestGetEmployerAPI(t *testing.T) {
 		{
 			name: "Internal Server Error GetCompanyByID",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -544,11 +668,11 @@ func TestUpdateEmployerAPI(t *testing.T) {
 				"company_location": newCompany.Location,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -589,13 +713,12 @@ func TestUpdateEmployerAPI(t *testing.T) {
 				"company_location": newCompany.Location,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(user.Email)).
-					Times(1).
-					Return(db.Employer{}, sql.ErrNoRows)
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -611,18 +734,18 @@ func TestUpdateEmployerAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetEmployerByEmail",
+			name: "Internal Server Error GetEmployerByID",
 			body: gin.H{
 				"email":            newEmployer.Email,
 				"company_industry": newCompany.Industry,
 				"company_location": newCompany.Location,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(db.Employer{}, sql.ErrConnDone)
 				store.EXPECT().
@@ -646,11 +769,11 @@ func TestUpdateEmployerAPI(t *testing.T) {
 				"company_location": newCompany.Location,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -675,11 +798,11 @@ func TestUpdateEmployerAPI(t *testing.T) {
 				"company_name": newCompany.Name,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -705,11 +828,11 @@ func TestUpdateEmployerAPI(t *testing.T) {
 				"full_name":    newEmployer.FullName,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -735,11 +858,11 @@ func TestUpdateEmployerAPI(t *testing.T) {
 				"company_name": 123,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
@@ -761,11 +884,11 @@ func TestUpdateEmployerAPI(t *testing.T) {
 				"email": "invalid",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					GetCompanyByID(gomock.Any(), gomock.Any()).
@@ -830,11 +953,11 @@ func TestUpdateEmployerPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -853,11 +976,11 @@ func TestUpdateEmployerPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					UpdateEmployerPassword(gomock.Any(), gomock.Any()).
@@ -874,11 +997,11 @@ func TestUpdateEmployerPasswordAPI(t *testing.T) {
 				"new_password": "123",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					UpdateEmployerPassword(gomock.Any(), gomock.Any()).
@@ -895,11 +1018,11 @@ func TestUpdateEmployerPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -917,11 +1040,11 @@ func TestUpdateEmployerPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -940,13 +1063,12 @@ func TestUpdateEmployerPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(user.Email)).
-					Times(1).
-					Return(db.Employer{}, sql.ErrNoRows)
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					UpdateEmployerPassword(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -956,17 +1078,17 @@ func TestUpdateEmployerPasswordAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetEmployerByEmail",
+			name: "Internal Server Error GetEmployerByID",
 			body: gin.H{
 				"old_password": password,
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.Employer{}, sql.ErrConnDone)
 				store.EXPECT().
@@ -1020,13 +1142,17 @@ func TestDeleteEmployerAPI(t *testing.T) {
 		{
 			name: "OK",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
+				store.EXPECT().
+					CountCompanyEmployers(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(int64(1), nil)
 				store.EXPECT().
 					DeleteCompany(gomock.Any(), gomock.Eq(company.ID)).
 					Times(1).
@@ -1041,15 +1167,76 @@ func TestDeleteEmployerAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Unauthorized Only Employer Access",
+			name: "OK Other Members",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
-					Return(db.Employer{}, sql.ErrNoRows)
+					Return(employer, nil)
+				store.EXPECT().
+					CountCompanyEmployers(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(int64(3), nil)
+				store.EXPECT().
+					CountCompanyEmployersByRole(gomock.Any(), gomock.Eq(db.CountCompanyEmployersByRoleParams{
+						CompanyID: company.ID,
+						Role:      db.EmployerRoleOwner,
+					})).
+					Times(1).
+					Return(int64(2), nil)
+				store.EXPECT().
+					DeleteCompany(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					DeleteEmployer(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Last Owner",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					CountCompanyEmployers(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(int64(2), nil)
+				store.EXPECT().
+					CountCompanyEmployersByRole(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				store.EXPECT().
+					DeleteCompany(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					DeleteEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Unauthorized Only Employer Access",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					DeleteCompany(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1062,13 +1249,13 @@ func TestDeleteEmployerAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetEmployerByEmail",
+			name: "Internal Server Error GetEmployerByID",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.Employer{}, sql.ErrConnDone)
 				store.EXPECT().
@@ -1085,13 +1272,17 @@ func TestDeleteEmployerAPI(t *testing.T) {
 		{
 			name: "Internal Server Error DeleteCompany",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(employer, nil)
+				store.EXPECT().
+					CountCompanyEmployers(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(int64(1), nil)
 				store.EXPECT().
 					DeleteCompany(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -1107,13 +1298,17 @@ func TestDeleteEmployerAPI(t *testing.T) {
 		{
 			name: "Internal Server Error DeleteEmployer",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(employer, nil)
+				store.EXPECT().
+					CountCompanyEmployers(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(int64(1), nil)
 				store.EXPECT().
 					DeleteCompany(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -1154,6 +1349,130 @@ func TestDeleteEmployerAPI(t *testing.T) {
 	}
 }
 
+func TestLogoutEmployerAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	user, _ := generateRandomUser(t)
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		body          func(refreshToken string) gin.H
+		buildStubs    func(store *mockdb.MockStore, refreshPayload *token.Payload)
+		checkResponse func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+
+				// the same token cannot be used anymore
+				req, err := http.NewRequest(http.MethodGet, BaseUrl+"/employers", nil)
+				require.NoError(t, err)
+				req.Header.Set(authorizationHeaderKey, r.Header.Get(authorizationHeaderKey))
+
+				recorder = httptest.NewRecorder()
+				server.router.ServeHTTP(recorder, req)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Refresh Token",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			body: func(refreshToken string) gin.H {
+				return gin.H{}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Unauthorized Only Employer Access",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "No Authorization",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {},
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			server := newTestServer(t, store, nil)
+
+			refreshToken, refreshPayload, err := server.tokenMaker.CreateRefreshToken(employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			require.NoError(t, err)
+
+			tc.buildStubs(store, refreshPayload)
+
+			data, err := json.Marshal(tc.body(refreshToken))
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			url := BaseUrl + "/employers/logout"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder, server, req)
+		})
+	}
+}
+
 func TestGetUserAsEmployerAPI(t *testing.T) {
 	employer, _, _ := generateRandomEmployerAndCompany(t)
 	user, _ := generateRandomUser(t)
@@ -1178,13 +1497,9 @@ func TestGetUserAsEmployerAPI(t *testing.T) {
 			name:      "OK",
 			userEmail: user.Email,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(employer, nil)
 				store.EXPECT().
 					GetUserDetailsByEmail(gomock.Any(), gomock.Eq(user.Email)).
 					Times(1).
@@ -1199,12 +1514,9 @@ func TestGetUserAsEmployerAPI(t *testing.T) {
 			name:      "Invalid Email",
 			userEmail: "invalid",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					GetUserDetailsByEmail(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1217,13 +1529,9 @@ func TestGetUserAsEmployerAPI(t *testing.T) {
 			name:      "Unauthorized Only Employer Access",
 			userEmail: user.Email,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(user.Email)).
-					Times(1).
-					Return(db.Employer{}, sql.ErrNoRows)
 				store.EXPECT().
 					GetUserDetailsByEmail(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1232,55 +1540,13 @@ func TestGetUserAsEmployerAPI(t *testing.T) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
-		{
-			name:      "Internal Server Error GetEmployerByEmail",
-			userEmail: user.Email,
-			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
-			},
-			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(db.Employer{}, sql.ErrConnDone)
-				store.EXPECT().
-					GetUserDetailsByEmail(gomock.Any(), gomock.Any()).
-					Times(0)
-			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
-			},
-		},
-		{
-			name:      "Internal Server Error GetEmployerByEmail",
-			userEmail: user.Email,
-			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
-			},
-			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(db.Employer{}, sql.ErrConnDone)
-				store.EXPECT().
-					GetUserDetailsByEmail(gomock.Any(), gomock.Any()).
-					Times(0)
-			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
-			},
-		},
 		{
 			name:      "User Not Found",
 			userEmail: user.Email,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(employer, nil)
 				store.EXPECT().
 					GetUserDetailsByEmail(gomock.Any(), gomock.Eq(user.Email)).
 					Times(1).
@@ -1294,13 +1560,9 @@ func TestGetUserAsEmployerAPI(t *testing.T) {
 			name:      "Internal Server Error GetUserDetailsByEmail",
 			userEmail: user.Email,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(employer, nil)
 				store.EXPECT().
 					GetUserDetailsByEmail(gomock.Any(), gomock.Eq(user.Email)).
 					Times(1).
@@ -1543,6 +1805,7 @@ func generateRandomEmployerAndCompany(t *testing.T) (db.Employer, string, db.Com
 		FullName:       utils.RandomString(5),
 		Email:          utils.RandomEmail(),
 		HashedPassword: hashedPassword,
+		Role:           db.EmployerRoleOwner,
 		CreatedAt:      time.Now(),
 	}
 
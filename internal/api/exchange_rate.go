@@ -0,0 +1,138 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/lib/pq"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// salaries without a currency or a period are treated as USD per month
+const (
+	defaultSalaryCurrency = "USD"
+	defaultSalaryPeriod   = db.SalaryPeriodMonthly
+)
+
+var (
+	unsupportedCurrencyError = errors.New("currency is not supported, there is no exchange rate for it")
+	invalidSalaryPeriodError = errors.New("salary period must be one of hourly, monthly or yearly")
+)
+
+// isSalaryPeriod checks if the period is one of the salary periods
+func isSalaryPeriod(period db.SalaryPeriod) bool {
+	switch period {
+	case db.SalaryPeriodHourly, db.SalaryPeriodMonthly, db.SalaryPeriodYearly:
+		return true
+	}
+	return false
+}
+
+// isUnsupportedCurrencyError checks if the error is caused by a salary currency
+// that is not in the exchange_rates table
+func isUnsupportedCurrencyError(err error) bool {
+	if pqErr, ok := err.(*pq.Error); ok {
+		return pqErr.Code.Name() == "foreign_key_violation" && strings.Contains(pqErr.Constraint, "currency")
+	}
+	return false
+}
+
+type exchangeRateResponse struct {
+	Currency  string    `json:"currency"`
+	RateToUSD float64   `json:"rate_to_usd"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// newExchangeRateResponse converts db.ExchangeRate to exchangeRateResponse
+func newExchangeRateResponse(rate db.ExchangeRate) exchangeRateResponse {
+	// rate_to_usd is a NUMERIC column, it is always a valid number
+	rateToUSD, _ := strconv.ParseFloat(rate.RateToUsd, 64)
+	return exchangeRateResponse{
+		Currency:  rate.Currency,
+		RateToUSD: rateToUSD,
+		UpdatedAt: rate.UpdatedAt,
+	}
+}
+
+// @Schemes
+// @Summary List exchange rates
+// @Description List the exchange rates used to compare salaries in different currencies. The rate is the value of one unit of the currency in USD.
+// @Tags exchange rates
+// @Produce json
+// @Success 200 {array} exchangeRateResponse
+// @Failure 500 {object} ErrorResponse "Any error"
+// @Router /exchange-rates [get]
+// listExchangeRates handles listing all exchange rates
+func (server *Server) listExchangeRates(ctx *gin.Context) {
+	rates, err := server.store.ListExchangeRates(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]exchangeRateResponse, 0, len(rates))
+	for _, rate := range rates {
+		res = append(res, newExchangeRateResponse(rate))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type setExchangeRateUriRequest struct {
+	Currency string `uri:"currency" binding:"required,iso4217"`
+}
+
+type setExchangeRateRequest struct {
+	RateToUSD float64 `json:"rate_to_usd" binding:"required,gt=0"`
+}
+
+// @Schemes
+// @Summary Set exchange rate
+// @Description Add a currency or update its exchange rate. The rate is the value of one unit of the currency in USD. The change is written to the audit log.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @param currency path string true "ISO 4217 currency code"
+// @param SetExchangeRateRequest body setExchangeRateRequest true "Value of one unit of the currency in USD"
+// @Success 200 {object} exchangeRateResponse
+// @Failure 400 {object} ErrorResponse "Invalid currency or request body"
+// @Failure 401 {object} ErrorResponse "Only admins can access this endpoint"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /admin/exchange-rates/{currency} [put]
+// setExchangeRate handles adding or updating an exchange rate by an admin
+func (server *Server) setExchangeRate(ctx *gin.Context) {
+	var uriRequest setExchangeRateUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request setExchangeRateRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	params := db.UpsertExchangeRateParams{
+		Currency:  uriRequest.Currency,
+		RateToUsd: strconv.FormatFloat(request.RateToUSD, 'f', -1, 64),
+	}
+
+	var rate db.ExchangeRate
+	details := fmt.Sprintf("%s = %s USD", params.Currency, params.RateToUsd)
+	ok := server.adminAction(ctx, adminActionUpdate, adminTargetExchangeRate, 0, details, func(q db.Querier) error {
+		var err error
+		rate, err = q.UpsertExchangeRate(ctx, params)
+		return err
+	})
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newExchangeRateResponse(rate))
+}
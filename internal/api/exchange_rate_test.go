@@ -0,0 +1,243 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListExchangeRatesAPI(t *testing.T) {
+	rates := []db.ExchangeRate{
+		{
+			Currency:  "EUR",
+			RateToUsd: "1.0800000000",
+			UpdatedAt: time.Now(),
+		},
+		{
+			Currency:  "USD",
+			RateToUsd: "1.0000000000",
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListExchangeRates(gomock.Any()).
+					Times(1).
+					Return(rates, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res []exchangeRateResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Len(t, res, len(rates))
+				require.Equal(t, "EUR", res[0].Currency)
+				require.Equal(t, 1.08, res[0].RateToUSD)
+				require.Equal(t, 1.0, res[1].RateToUSD)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListExchangeRates(gomock.Any()).
+					Times(1).
+					Return([]db.ExchangeRate{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, BaseUrl+"/exchange-rates", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestSetExchangeRateAPI(t *testing.T) {
+	admin, _ := generateRandomAdmin(t)
+	user, _ := generateRandomUser(t)
+
+	rate := db.ExchangeRate{
+		Currency:  "PLN",
+		RateToUsd: "0.26",
+		UpdatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		currency      string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			currency: rate.Currency,
+			body:     gin.H{"rate_to_usd": 0.26},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(runAdminAction(t, store, db.CreateAdminAuditLogParams{
+						AdminID:    admin.ID,
+						Action:     adminActionUpdate,
+						TargetType: adminTargetExchangeRate,
+						Details:    "PLN = 0.26 USD",
+					}))
+				store.EXPECT().
+					UpsertExchangeRate(gomock.Any(), gomock.Eq(db.UpsertExchangeRateParams{
+						Currency:  rate.Currency,
+						RateToUsd: rate.RateToUsd,
+					})).
+					Times(1).
+					Return(rate, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res exchangeRateResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, rate.Currency, res.Currency)
+				require.Equal(t, 0.26, res.RateToUSD)
+			},
+		},
+		{
+			name:     "Invalid Currency",
+			currency: "ZZZ",
+			body:     gin.H{"rate_to_usd": 0.26},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Rate Not Positive",
+			currency: rate.Currency,
+			body:     gin.H{"rate_to_usd": -1},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Internal Server Error",
+			currency: rate.Currency,
+			body:     gin.H{"rate_to_usd": 0.26},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Email, token.RoleAdmin, admin.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AdminActionTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:     "User",
+			currency: rate.Currency,
+			body:     gin.H{"rate_to_usd": 0.26},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					AdminActionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/admin/exchange-rates/%s", BaseUrl, tc.currency)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
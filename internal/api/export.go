@@ -0,0 +1,340 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/xlsx"
+	zerolog "github.com/rs/zerolog/log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatXLSX = "xlsx"
+	// the rows of an export are read and sent to the client in pages of this size
+	exportPageSize = 100
+)
+
+// jobsExportHeader are the columns of the jobs export, the job fields
+// are named as in the import, so the columns can be reused there
+var jobsExportHeader = []string{
+	"id",
+	"title",
+	"description",
+	"industry",
+	"location",
+	"salary_min",
+	"salary_max",
+	"salary_currency",
+	"salary_period",
+	"requirements",
+	"required_skills",
+	"employment_type",
+	"work_mode",
+	"seniority_level",
+	"status",
+	"created_at",
+	"expires_at",
+	"applications",
+}
+
+var jobApplicationsExportHeader = []string{
+	"application_id",
+	"application_status",
+	"application_date",
+	"job_id",
+	"job_title",
+	"user_id",
+	"user_full_name",
+	"user_email",
+	"user_location",
+	"user_telegram_id",
+	"user_skills",
+}
+
+// exportWriter writes the records of an export in one of the formats
+type exportWriter interface {
+	Write(record []string) error
+	Flush() error
+	Close() error
+}
+
+// csvExportWriter adapts csv.Writer to exportWriter
+type csvExportWriter struct {
+	*csv.Writer
+}
+
+func (w csvExportWriter) Write(record []string) error {
+	sanitized := make([]string, len(record))
+	for i, value := range record {
+		sanitized[i] = sanitizeCSVCell(value)
+	}
+	return w.Writer.Write(sanitized)
+}
+
+func (w csvExportWriter) Flush() error {
+	w.Writer.Flush()
+	return w.Writer.Error()
+}
+
+func (w csvExportWriter) Close() error {
+	return w.Flush()
+}
+
+// sanitizeCSVCell prevents values entered by users from being evaluated
+// as formulas when the file is opened in a spreadsheet application,
+// cells of the XLSX export are always text, so they do not need it
+func sanitizeCSVCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// exportTime formats a time of the export, null times are empty cells
+func exportTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.UTC().Format(time.RFC3339)
+}
+
+// writeExport streams the header and the records of the rows to the response
+// as an attachment. The rows are read page by page with nextPage, which gets the last
+// row of the previous page (nil for the first one), and every page is written before
+// the next one is read. The first page is read before the response is started,
+// so its error can still be returned as JSON.
+func writeExport[T any](ctx *gin.Context, format, filename, sheetName string, header []string, nextPage func(last *T) ([]T, error), record func(T) []string) {
+	rows, err := nextPage(nil)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if format == exportFormatXLSX {
+		ctx.Header("Content-Type", xlsx.ContentType)
+	} else {
+		format = exportFormatCSV
+		ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+"."+format))
+	ctx.Status(http.StatusOK)
+
+	var w exportWriter
+	if format == exportFormatXLSX {
+		w, err = xlsx.NewWriter(ctx.Writer, sheetName)
+	} else {
+		w = csvExportWriter{csv.NewWriter(ctx.Writer)}
+	}
+
+	if err == nil {
+		err = w.Write(header)
+	}
+	for err == nil && len(rows) > 0 {
+		for i := 0; err == nil && i < len(rows); i++ {
+			err = w.Write(record(rows[i]))
+		}
+		if err == nil {
+			err = w.Flush()
+		}
+		// a page shorter than the page size is the last one
+		if err != nil || len(rows) < exportPageSize {
+			break
+		}
+
+		ctx.Writer.Flush()
+		rows, err = nextPage(&rows[len(rows)-1])
+	}
+	if err == nil {
+		err = w.Close()
+	}
+
+	// the status is already sent, the error can only be logged
+	if err != nil {
+		zerolog.Error().Err(err).Str("path", ctx.FullPath()).Msg("cannot write export")
+		ctx.Abort()
+	}
+}
+
+type exportEmployerJobsRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=csv xlsx"`
+}
+
+// @Schemes
+// @Summary Export jobs of an employer
+// @Description Export all jobs of the company of the authenticated employer as a CSV or XLSX file, without pagination. Every row contains the fields of the job, the required skills separated with a semicolon and the number of applications.
+// @Tags jobs
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @param format query string false "file format ('csv' or 'xlsx'), csv by default"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/employer/export [get]
+// exportEmployerJobs streams all jobs of an authenticated employer as a file
+func (server *Server) exportEmployerJobs(ctx *gin.Context) {
+	var request exportEmployerJobsRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	nextPage := func(last *db.ExportJobsForEmployerRow) ([]db.ExportJobsForEmployerRow, error) {
+		params := db.ExportJobsForEmployerParams{
+			CompanyID: authEmployer.CompanyID,
+			Limit:     exportPageSize,
+		}
+		if last != nil {
+			params.CursorCreatedAt = sql.NullTime{Time: last.CreatedAt, Valid: true}
+			params.CursorID = last.ID
+		}
+		return server.store.ExportJobsForEmployer(ctx, params)
+	}
+
+	filename := fmt.Sprintf("jobs-%s", time.Now().UTC().Format(time.DateOnly))
+	writeExport(ctx, request.Format, filename, "Jobs", jobsExportHeader, nextPage, func(job db.ExportJobsForEmployerRow) []string {
+		return []string{
+			strconv.Itoa(int(job.ID)),
+			job.Title,
+			job.Description,
+			job.Industry,
+			job.Location,
+			strconv.Itoa(int(job.SalaryMin)),
+			strconv.Itoa(int(job.SalaryMax)),
+			job.SalaryCurrency,
+			string(job.SalaryPeriod),
+			job.Requirements,
+			strings.Join(job.RequiredSkills, csvSkillsSeparator),
+			string(job.EmploymentType),
+			string(job.WorkMode),
+			string(job.SeniorityLevel),
+			string(job.Status),
+			job.CreatedAt.UTC().Format(time.RFC3339),
+			exportTime(job.ExpiresAt),
+			strconv.FormatInt(job.Applications, 10),
+		}
+	})
+}
+
+type exportJobApplicationsForEmployerRequest struct {
+	JobID  int32                `form:"job_id" binding:"required,min=1"`
+	Status db.ApplicationStatus `form:"status" binding:"omitempty,oneof=Applied Seen Interviewing Offered Rejected"`
+	Format string               `form:"format" binding:"omitempty,oneof=csv xlsx"`
+}
+
+// @Schemes
+// @Summary Export job applications (employer)
+// @Description Export all applications for a job with a given ID as a CSV or XLSX file, without pagination. Only employers of the company of the job can access this endpoint. Every row contains the status and the date of the application, the job and the contact details and the skills of the applicant.
+// @Tags job applications
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @param job_id query int true "job ID"
+// @param status query string false "filter by status ('Applied', 'Seen', 'Interviewing', 'Offered', 'Rejected')"
+// @param format query string false "file format ('csv' or 'xlsx'), csv by default"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Employer is trying to access job that does not belong to them."
+// @Failure 404 {object} ErrorResponse "Job with given ID does not exist"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /job-applications/employer/export [get]
+// exportJobApplicationsForEmployer streams all job applications for a given job
+// that authenticated employer created as a file
+func (server *Server) exportJobApplicationsForEmployer(ctx *gin.Context) {
+	var request exportJobApplicationsForEmployerRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// get the job to check if it exists and is owned by the employer
+	companyID, err := server.store.GetCompanyIDOfJob(ctx, request.JobID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = fmt.Errorf("job with ID %d does not exist", request.JobID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// check if the job belongs to the employer
+	if companyID != authEmployer.CompanyID {
+		err = fmt.Errorf("job with ID %d does not belong to employer with ID %d", request.JobID, authEmployer.CompanyID)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	params := db.ExportJobApplicationsForEmployerParams{
+		JobID: request.JobID,
+		// this value does not matter if the FilterStatus is false
+		Status:       db.ApplicationStatusApplied,
+		FilterStatus: false,
+		Limit:        exportPageSize,
+	}
+	if request.Status != "" {
+		params.FilterStatus = true
+		params.Status = request.Status
+	}
+
+	nextPage := func(last *db.ExportJobApplicationsForEmployerRow) ([]db.ExportJobApplicationsForEmployerRow, error) {
+		if last != nil {
+			params.CursorAppliedAt = sql.NullTime{Time: last.ApplicationDate, Valid: true}
+			params.CursorID = last.ApplicationID
+		}
+		return server.store.ExportJobApplicationsForEmployer(ctx, params)
+	}
+
+	filename := fmt.Sprintf("job-%d-applications-%s", request.JobID, time.Now().UTC().Format(time.DateOnly))
+	writeExport(ctx, request.Format, filename, "Applications", jobApplicationsExportHeader, nextPage, func(application db.ExportJobApplicationsForEmployerRow) []string {
+		return []string{
+			strconv.Itoa(int(application.ApplicationID)),
+			string(application.ApplicationStatus),
+			application.ApplicationDate.UTC().Format(time.RFC3339),
+			strconv.Itoa(int(application.JobID)),
+			application.JobTitle,
+			strconv.Itoa(int(application.UserID)),
+			application.UserFullName,
+			application.UserEmail,
+			application.UserLocation,
+			application.UserTelegramID,
+			strings.Join(application.UserSkills, csvSkillsSeparator),
+		}
+	})
+}
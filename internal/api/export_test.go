@@ -0,0 +1,578 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/grannnsacker/job-finder-back/pkg/xlsx"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestExportEmployerJobsAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	user, _ := generateRandomUser(t)
+
+	var jobs []db.ExportJobsForEmployerRow
+	for i := 0; i < 3; i++ {
+		j := generateRandomJob()
+		jobs = append(jobs, db.ExportJobsForEmployerRow{
+			ID:             j.ID,
+			Title:          j.Title,
+			Industry:       j.Industry,
+			Description:    j.Description,
+			Location:       j.Location,
+			SalaryMin:      j.SalaryMin,
+			SalaryMax:      j.SalaryMax,
+			SalaryCurrency: j.SalaryCurrency,
+			SalaryPeriod:   j.SalaryPeriod,
+			Requirements:   j.Requirements,
+			CreatedAt:      time.Now().UTC().Truncate(time.Second),
+			Status:         j.Status,
+			EmploymentType: j.EmploymentType,
+			WorkMode:       j.WorkMode,
+			SeniorityLevel: j.SeniorityLevel,
+			RequiredSkills: []string{"Go", "Postgres"},
+			Applications:   int64(i),
+		})
+	}
+	// the title is entered by the employer, it must not become a formula
+	jobs[0].Title = "=HYPERLINK(\"http://example.com\")"
+
+	firstPage := db.ExportJobsForEmployerParams{
+		CompanyID: employer.CompanyID,
+		Limit:     exportPageSize,
+	}
+
+	// one more job than fits on a page, so the export reads the second page
+	pagedJobs := make([]db.ExportJobsForEmployerRow, exportPageSize+1)
+	for i := range pagedJobs {
+		pagedJobs[i] = jobs[0]
+		pagedJobs[i].ID = int32(len(pagedJobs) - i)
+		pagedJobs[i].CreatedAt = jobs[0].CreatedAt.Add(-time.Duration(i) * time.Minute)
+	}
+	lastOfFirstPage := pagedJobs[exportPageSize-1]
+	secondPage := db.ExportJobsForEmployerParams{
+		CompanyID:       employer.CompanyID,
+		Limit:           exportPageSize,
+		CursorCreatedAt: sql.NullTime{Time: lastOfFirstPage.CreatedAt, Valid: true},
+		CursorID:        lastOfFirstPage.ID,
+	}
+
+	testCases := []struct {
+		name          string
+		format        string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK CSV",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					ExportJobsForEmployer(gomock.Any(), gomock.Eq(firstPage)).
+					Times(1).
+					Return(jobs, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/csv; charset=utf-8", recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Header().Get("Content-Disposition"), "attachment;")
+				require.Contains(t, recorder.Header().Get("Content-Disposition"), ".csv")
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, len(jobs)+1)
+				require.Equal(t, jobsExportHeader, records[0])
+
+				for i, job := range jobs {
+					record := records[i+1]
+					require.Equal(t, strconv.Itoa(int(job.ID)), record[0])
+					require.Equal(t, "Go;Postgres", record[10])
+					require.Equal(t, string(job.Status), record[14])
+					require.Equal(t, job.CreatedAt.Format(time.RFC3339), record[15])
+					require.Equal(t, "", record[16])
+					require.Equal(t, strconv.Itoa(i), record[17])
+				}
+				require.Equal(t, "'"+jobs[0].Title, records[1][1])
+				require.Equal(t, jobs[1].Title, records[2][1])
+			},
+		},
+		{
+			name:   "OK XLSX",
+			format: "xlsx",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					ExportJobsForEmployer(gomock.Any(), gomock.Eq(firstPage)).
+					Times(1).
+					Return(jobs, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, xlsx.ContentType, recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Header().Get("Content-Disposition"), ".xlsx")
+
+				sheet := readXLSXSheet(t, recorder.Body.Bytes())
+				require.Contains(t, sheet, "<t xml:space=\"preserve\">required_skills</t>")
+				require.Contains(t, sheet, "<t xml:space=\"preserve\">=HYPERLINK(&#34;http://example.com&#34;)</t>")
+				require.Contains(t, sheet, fmt.Sprintf("<row r=\"%d\">", len(jobs)+1))
+			},
+		},
+		{
+			name: "OK Multiple Pages",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				gomock.InOrder(
+					store.EXPECT().
+						ExportJobsForEmployer(gomock.Any(), gomock.Eq(firstPage)).
+						Times(1).
+						Return(pagedJobs[:exportPageSize], nil),
+					store.EXPECT().
+						ExportJobsForEmployer(gomock.Any(), gomock.Eq(secondPage)).
+						Times(1).
+						Return(pagedJobs[exportPageSize:], nil),
+				)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, len(pagedJobs)+1)
+				for i, job := range pagedJobs {
+					require.Equal(t, strconv.Itoa(int(job.ID)), records[i+1][0])
+				}
+			},
+		},
+		{
+			name:   "Invalid Format",
+			format: "pdf",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ExportJobsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Unauthorized Only Employer Access",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ExportJobsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Employer Not Found",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					ExportJobsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error ExportJobsForEmployer",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					ExportJobsForEmployer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ExportJobsForEmployerRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, "application/json; charset=utf-8", recorder.Header().Get("Content-Type"))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/jobs/employer/export"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			if tc.format != "" {
+				q := req.URL.Query()
+				q.Add("format", tc.format)
+				req.URL.RawQuery = q.Encode()
+			}
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestExportJobApplicationsForEmployerAPI(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+	user, _ := generateRandomUser(t)
+	jobID := utils.RandomInt(1, 1000)
+
+	var applications []db.ExportJobApplicationsForEmployerRow
+	for i := 0; i < 3; i++ {
+		u, _ := generateRandomUser(t)
+		applications = append(applications, db.ExportJobApplicationsForEmployerRow{
+			ApplicationID:     int32(i + 1),
+			ApplicationStatus: db.ApplicationStatusApplied,
+			ApplicationDate:   time.Now().UTC().Truncate(time.Second),
+			JobID:             jobID,
+			JobTitle:          utils.RandomString(6),
+			UserID:            u.ID,
+			UserFullName:      u.FullName,
+			UserEmail:         u.Email,
+			UserLocation:      u.Location,
+			UserSkills:        []string{"Go"},
+		})
+	}
+
+	type Query struct {
+		jobID  int32
+		status string
+		format string
+	}
+
+	testCases := []struct {
+		name          string
+		query         Query
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK CSV",
+			query: Query{jobID: jobID},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(jobID)).
+					Times(1).
+					Return(company.ID, nil)
+				params := db.ExportJobApplicationsForEmployerParams{
+					JobID:        jobID,
+					FilterStatus: false,
+					Status:       db.ApplicationStatusApplied,
+					Limit:        exportPageSize,
+				}
+				store.EXPECT().
+					ExportJobApplicationsForEmployer(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(applications, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Header().Get("Content-Disposition"), fmt.Sprintf("job-%d-applications-", jobID))
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, len(applications)+1)
+				require.Equal(t, jobApplicationsExportHeader, records[0])
+
+				for i, application := range applications {
+					record := records[i+1]
+					require.Equal(t, strconv.Itoa(int(application.ApplicationID)), record[0])
+					require.Equal(t, string(application.ApplicationStatus), record[1])
+					require.Equal(t, application.ApplicationDate.Format(time.RFC3339), record[2])
+					require.Equal(t, application.UserFullName, record[6])
+					require.Equal(t, application.UserEmail, record[7])
+					require.Equal(t, "Go", record[10])
+				}
+			},
+		},
+		{
+			name:  "OK XLSX With Status",
+			query: Query{jobID: jobID, status: string(db.ApplicationStatusInterviewing), format: "xlsx"},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(jobID)).
+					Times(1).
+					Return(company.ID, nil)
+				params := db.ExportJobApplicationsForEmployerParams{
+					JobID:        jobID,
+					FilterStatus: true,
+					Status:       db.ApplicationStatusInterviewing,
+					Limit:        exportPageSize,
+				}
+				store.EXPECT().
+					ExportJobApplicationsForEmployer(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(applications[:1], nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, xlsx.ContentType, recorder.Header().Get("Content-Type"))
+
+				sheet := readXLSXSheet(t, recorder.Body.Bytes())
+				require.Contains(t, sheet, applications[0].UserEmail)
+				require.NotContains(t, sheet, applications[1].UserEmail)
+			},
+		},
+		{
+			name:  "Missing Job ID",
+			query: Query{},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ExportJobApplicationsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Status",
+			query: Query{jobID: jobID, status: "invalid"},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ExportJobApplicationsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Unauthorized Only Employer Access",
+			query: Query{jobID: jobID},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ExportJobApplicationsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "Job Not Found",
+			query: Query{jobID: jobID},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(jobID)).
+					Times(1).
+					Return(int32(0), sql.ErrNoRows)
+				store.EXPECT().
+					ExportJobApplicationsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "Job Of Another Company",
+			query: Query{jobID: jobID},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(jobID)).
+					Times(1).
+					Return(company.ID+1, nil)
+				store.EXPECT().
+					ExportJobApplicationsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error ExportJobApplicationsForEmployer",
+			query: Query{jobID: jobID},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(jobID)).
+					Times(1).
+					Return(company.ID, nil)
+				store.EXPECT().
+					ExportJobApplicationsForEmployer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ExportJobApplicationsForEmployerRow{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/job-applications/employer/export"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			q := req.URL.Query()
+			if tc.query.jobID != 0 {
+				q.Add("job_id", strconv.Itoa(int(tc.query.jobID)))
+			}
+			if tc.query.status != "" {
+				q.Add("status", tc.query.status)
+			}
+			if tc.query.format != "" {
+				q.Add("format", tc.query.format)
+			}
+			req.URL.RawQuery = q.Encode()
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// readXLSXSheet returns the XML of the only sheet of the XLSX file
+func readXLSXSheet(t *testing.T, data []byte) string {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	f, err := archive.Open("xl/worksheets/sheet1.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	sheet, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return string(sheet)
+}
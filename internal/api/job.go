@@ -2,14 +2,16 @@ package api
 
 import (
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
 	"github.com/grannnsacker/job-finder-back/internal/esearch"
 	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"math"
 	"net/http"
+	"slices"
+	"time"
 )
 
 var (
@@ -17,53 +19,162 @@ var (
 	onlyUsersAccessError     = errors.New("only users can access this endpoint")
 	jobOwnershipError        = errors.New("job does not belong to this employer")
 	salaryRangeError         = errors.New("salary min cannot be greater than salary max")
+	jobSkillOwnershipError   = errors.New("skills to remove do not belong to this job")
 )
 
+// public job listings are sorted from the newest by default
+const defaultJobsSort = "newest"
+
+// jobs matching the user are sorted from the highest match score by default
+const defaultMatchingJobsSort = "score"
+
 type jobResponse struct {
+	ID             int32                        `json:"id"`
 	Title          string                       `json:"title"`
 	Description    string                       `json:"description"`
 	Industry       string                       `json:"industry"`
 	Location       string                       `json:"location"`
 	SalaryMin      int32                        `json:"salary_min"`
 	SalaryMax      int32                        `json:"salary_max"`
+	SalaryCurrency string                       `json:"salary_currency"`
+	SalaryPeriod   db.SalaryPeriod              `json:"salary_period"`
 	Requirements   string                       `json:"requirements"`
 	RequiredSkills []db.ListJobSkillsByJobIDRow `json:"required_skills"`
+	EmploymentType db.EmploymentType            `json:"employment_type"`
+	WorkMode       db.WorkMode                  `json:"work_mode"`
+	SeniorityLevel db.SeniorityLevel            `json:"seniority_level"`
+	Status         db.JobStatus                 `json:"status"`
+	ExpiresAt      *time.Time                   `json:"expires_at"`
+	// PublishAt is set for drafts that are scheduled to be published
+	PublishAt *time.Time `json:"publish_at"`
 }
 
 // newJobResponse creates a job response from a db.Job and db.ListJobSkillsByJobIDRow
 func newJobResponse(job db.Job, skills []db.ListJobSkillsByJobIDRow) jobResponse {
 	return jobResponse{
+		ID:             job.ID,
 		Title:          job.Title,
 		Description:    job.Description,
 		Industry:       job.Industry,
 		Location:       job.Location,
 		SalaryMin:      job.SalaryMin,
 		SalaryMax:      job.SalaryMax,
+		SalaryCurrency: job.SalaryCurrency,
+		SalaryPeriod:   job.SalaryPeriod,
 		Requirements:   job.Requirements,
 		RequiredSkills: skills,
+		EmploymentType: job.EmploymentType,
+		WorkMode:       job.WorkMode,
+		SeniorityLevel: job.SeniorityLevel,
+		Status:         effectiveJobStatus(job),
+		ExpiresAt:      nullTimePointer(job.ExpiresAt),
+		PublishAt:      nullTimePointer(job.PublishAt),
 	}
 }
 
 type createJobRequest struct {
-	Title          string   `json:"title" binding:"required"`
-	Description    string   `json:"description" binding:"required"`
-	Industry       string   `json:"industry" binding:"required"`
-	Location       string   `json:"location" binding:"required"`
-	SalaryMin      int32    `json:"salary_min" binding:"required,min=0"`
-	SalaryMax      int32    `json:"salary_max" binding:"required,min=0"`
-	Requirements   string   `json:"requirements" binding:"required"`
-	RequiredSkills []string `json:"required_skills" binding:"required"`
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description" binding:"required"`
+	Industry    string `json:"industry" binding:"required"`
+	Location    string `json:"location" binding:"required"`
+	SalaryMin   int32  `json:"salary_min" binding:"required,min=0"`
+	SalaryMax   int32  `json:"salary_max" binding:"required,min=0"`
+	// the salary is in USD per month by default
+	SalaryCurrency string          `json:"salary_currency" binding:"omitempty,iso4217"`
+	SalaryPeriod   db.SalaryPeriod `json:"salary_period" binding:"omitempty,oneof=hourly monthly yearly"`
+	Requirements   string          `json:"requirements" binding:"required"`
+	RequiredSkills []string        `json:"required_skills" binding:"required"`
+	// defaults to full_time, on_site and middle
+	EmploymentType db.EmploymentType `json:"employment_type" binding:"omitempty,oneof=full_time part_time contract internship temporary"`
+	WorkMode       db.WorkMode       `json:"work_mode" binding:"omitempty,oneof=on_site remote hybrid"`
+	SeniorityLevel db.SeniorityLevel `json:"seniority_level" binding:"omitempty,oneof=intern junior middle senior lead"`
+	// drafts are not listed until they are published, jobs are published by default
+	Status    db.JobStatus `json:"status" binding:"omitempty,oneof=draft published"`
+	ExpiresAt *time.Time   `json:"expires_at"`
+	// the job is created as a draft and published by the scheduler at publish_at
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+// validate checks the rules of the job that are not covered by the binding tags
+func (request createJobRequest) validate() error {
+	if request.SalaryMin > request.SalaryMax {
+		return salaryRangeError
+	}
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		return expiresAtInPastError
+	}
+
+	if request.PublishAt != nil && request.Status == db.JobStatusPublished {
+		return publishAtNotDraftError
+	}
+
+	return validateSchedule(request.PublishAt, request.ExpiresAt)
+}
+
+// setDefaults sets the default values of the fields that were not provided
+func (request *createJobRequest) setDefaults() {
+	if request.Status == "" && request.PublishAt != nil {
+		request.Status = db.JobStatusDraft
+	}
+	if request.Status == "" {
+		request.Status = db.JobStatusPublished
+	}
+	if request.SalaryCurrency == "" {
+		request.SalaryCurrency = defaultSalaryCurrency
+	}
+	if request.SalaryPeriod == "" {
+		request.SalaryPeriod = defaultSalaryPeriod
+	}
+	if request.EmploymentType == "" {
+		request.EmploymentType = db.EmploymentTypeFullTime
+	}
+	if request.WorkMode == "" {
+		request.WorkMode = db.WorkModeOnSite
+	}
+	if request.SeniorityLevel == "" {
+		request.SeniorityLevel = db.SeniorityLevelMiddle
+	}
+}
+
+// createJobParams creates the params to create the job in the company with the given ID
+func (request createJobRequest) createJobParams(companyID int32) db.CreateJobParams {
+	params := db.CreateJobParams{
+		Title:          request.Title,
+		Industry:       request.Industry,
+		CompanyID:      companyID,
+		Description:    request.Description,
+		Location:       request.Location,
+		SalaryMin:      request.SalaryMin,
+		SalaryMax:      request.SalaryMax,
+		Requirements:   request.Requirements,
+		Status:         request.Status,
+		EmploymentType: request.EmploymentType,
+		WorkMode:       request.WorkMode,
+		SeniorityLevel: request.SeniorityLevel,
+		SalaryCurrency: request.SalaryCurrency,
+		SalaryPeriod:   request.SalaryPeriod,
+	}
+	if request.ExpiresAt != nil {
+		params.ExpiresAt = sql.NullTime{Time: *request.ExpiresAt, Valid: true}
+	}
+	if request.PublishAt != nil {
+		params.PublishAt = sql.NullTime{Time: *request.PublishAt, Valid: true}
+	}
+
+	return params
 }
 
 // @Schemes
 // @Summary Create job
-// @Description Create a new job
+// @Description Create a new job. The job is published right away unless status is draft or publish_at is set, a job with publish_at is a draft until it is published at that time. A published job expires at expires_at.
 // @Tags jobs
 // @Accept json
 // @Produce json
 // @param CreateJobRequest body createJobRequest true "Job details"
 // @Success 201 {object} jobResponse
-// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 400 {object} ErrorResponse "Invalid request body, expires_at or publish_at is in the past, expires_at is before publish_at or there is no exchange rate for the currency"
+// @Failure 403 {object} ErrorResponse "Email address has not been verified or the role of the employer does not allow creating jobs"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
 // @Router /jobs [post]
@@ -75,42 +186,60 @@ func (server *Server) createJob(ctx *gin.Context) {
 		return
 	}
 
-	if request.SalaryMin > request.SalaryMax {
-		ctx.JSON(http.StatusBadRequest, errorResponse(salaryRangeError))
+	if err := request.validate(); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
+	request.setDefaults()
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	// create job
-	params := db.CreateJobParams{
-		Title:        request.Title,
-		Industry:     request.Industry,
-		CompanyID:    authEmployer.CompanyID,
-		Description:  request.Description,
-		Location:     request.Location,
-		SalaryMin:    request.SalaryMin,
-		SalaryMax:    request.SalaryMax,
-		Requirements: request.Requirements,
+	if !authEmployer.IsEmailVerified {
+		ctx.JSON(http.StatusForbidden, errorResponse(emailNotVerifiedError))
+		return
 	}
 
-	job, err := server.store.CreateJob(ctx, params)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "create jobs")))
 		return
 	}
 
-	// create job skills
-	err = server.store.CreateMultipleJobSkills(ctx, request.RequiredSkills, job.ID)
+	server.createJobWithSkills(ctx, authEmployer, request)
+}
+
+// createJobWithSkills creates the job with its skills in the company, adds it to the
+// elasticsearch index if it is published and responds with the created job,
+// the request must be validated and have the defaults set
+func (server *Server) createJobWithSkills(ctx *gin.Context, authEmployer db.Employer, request createJobRequest) {
+	companyID := authEmployer.CompanyID
+
+	// create job with its skills, the created job is the first revision
+	result, err := server.store.CreateJobTx(ctx, db.CreateJobTxParams{
+		CreateJobParams: request.createJobParams(companyID),
+		RequiredSkills:  request.RequiredSkills,
+		AuthorID:        authEmployer.ID,
+	})
 	if err != nil {
+		if isUnsupportedCurrencyError(err) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(unsupportedCurrencyError))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	job := result.Job
 
 	listJobSkillsParams := db.ListJobSkillsByJobIDParams{
 		JobID:  job.ID,
@@ -124,43 +253,200 @@ func (server *Server) createJob(ctx *gin.Context) {
 		return
 	}
 
+	// drafts are not searchable until they are published
+	if !isJobIndexed(job) {
+		ctx.JSON(http.StatusCreated, newJobResponse(job, jobSkills))
+		return
+	}
+
 	// creation was successful - create an elasticsearch index
+	err = server.indexJob(ctx, job)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, newJobResponse(job, jobSkills))
+}
+
+type cloneJobRequest struct {
+	// the fields that are omitted are copied from the job
+	Title          string          `json:"title"`
+	Description    string          `json:"description"`
+	Industry       string          `json:"industry"`
+	Location       string          `json:"location"`
+	SalaryMin      int32           `json:"salary_min" binding:"min=0"`
+	SalaryMax      int32           `json:"salary_max" binding:"min=0"`
+	SalaryCurrency string          `json:"salary_currency" binding:"omitempty,iso4217"`
+	SalaryPeriod   db.SalaryPeriod `json:"salary_period" binding:"omitempty,oneof=hourly monthly yearly"`
+	Requirements   string          `json:"requirements"`
+	// the skills replace the skills of the job, they are copied if omitted
+	RequiredSkills []string          `json:"required_skills"`
+	EmploymentType db.EmploymentType `json:"employment_type" binding:"omitempty,oneof=full_time part_time contract internship temporary"`
+	WorkMode       db.WorkMode       `json:"work_mode" binding:"omitempty,oneof=on_site remote hybrid"`
+	SeniorityLevel db.SeniorityLevel `json:"seniority_level" binding:"omitempty,oneof=intern junior middle senior lead"`
+	// the status and the schedule are not copied, the clone is published by default
+	Status    db.JobStatus `json:"status" binding:"omitempty,oneof=draft published"`
+	ExpiresAt *time.Time   `json:"expires_at"`
+	PublishAt *time.Time   `json:"publish_at"`
+}
+
+// createJobRequest creates the request to create a copy of the job with the overrides
+func (request cloneJobRequest) createJobRequest(job db.Job, skills []string) createJobRequest {
+	clone := createJobRequest{
+		Title:          job.Title,
+		Description:    job.Description,
+		Industry:       job.Industry,
+		Location:       job.Location,
+		SalaryMin:      job.SalaryMin,
+		SalaryMax:      job.SalaryMax,
+		SalaryCurrency: job.SalaryCurrency,
+		SalaryPeriod:   job.SalaryPeriod,
+		Requirements:   job.Requirements,
+		RequiredSkills: skills,
+		EmploymentType: job.EmploymentType,
+		WorkMode:       job.WorkMode,
+		SeniorityLevel: job.SeniorityLevel,
+		Status:         request.Status,
+		ExpiresAt:      request.ExpiresAt,
+		PublishAt:      request.PublishAt,
+	}
 
-	// get the company name
-	companyName, err := server.store.GetCompanyNameByID(ctx, authEmployer.CompanyID)
+	if request.Title != "" {
+		clone.Title = request.Title
+	}
+	if request.Description != "" {
+		clone.Description = request.Description
+	}
+	if request.Industry != "" {
+		clone.Industry = request.Industry
+	}
+	if request.Location != "" {
+		clone.Location = request.Location
+	}
+	if request.SalaryMin != 0 {
+		clone.SalaryMin = request.SalaryMin
+	}
+	if request.SalaryMax != 0 {
+		clone.SalaryMax = request.SalaryMax
+	}
+	if request.SalaryCurrency != "" {
+		clone.SalaryCurrency = request.SalaryCurrency
+	}
+	if request.SalaryPeriod != "" {
+		clone.SalaryPeriod = request.SalaryPeriod
+	}
+	if request.Requirements != "" {
+		clone.Requirements = request.Requirements
+	}
+	if request.RequiredSkills != nil {
+		clone.RequiredSkills = request.RequiredSkills
+	}
+	if request.EmploymentType != "" {
+		clone.EmploymentType = request.EmploymentType
+	}
+	if request.WorkMode != "" {
+		clone.WorkMode = request.WorkMode
+	}
+	if request.SeniorityLevel != "" {
+		clone.SeniorityLevel = request.SeniorityLevel
+	}
+
+	return clone
+}
+
+// @Schemes
+// @Summary Clone job
+// @Description Create a new job as a copy of the job with the given id, including its required skills. The fields in the body replace the copied ones, e.g. to post the same job in another location. The status, expires_at and publish_at are not copied, the new job is published right away unless status is draft or publish_at is set.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @param id path integer true "Job ID"
+// @param CloneJobRequest body cloneJobRequest false "Fields to change in the copy"
+// @Success 201 {object} jobResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID or request body, expires_at is in the past or there is no exchange rate for the currency"
+// @Failure 401 {object} ErrorResponse "User making the request not an employer or employer not the owner of the job"
+// @Failure 403 {object} ErrorResponse "Email address has not been verified or the role of the employer does not allow creating jobs"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/clone [post]
+// cloneJob handles creating a job posting as a copy of another job of the company
+func (server *Server) cloneJob(ctx *gin.Context) {
+	var uriRequest getJobRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// the body is optional, without it the job is copied as it is
+	var request cloneJobRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
-	var skills []string
-	// get the skill names from the job skills
-	for _, skill := range jobSkills {
-		skills = append(skills, skill.Skill)
-	}
-
-	j := esearch.Job{
-		ID:           job.ID,
-		Title:        job.Title,
-		Industry:     job.Industry,
-		CompanyName:  companyName,
-		Description:  job.Description,
-		Location:     job.Location,
-		SalaryMin:    job.SalaryMin,
-		SalaryMax:    job.SalaryMax,
-		Requirements: job.Requirements,
-		JobSkills:    skills,
-	}
-
-	err = server.esDetails.client.IndexJobAsDocument(
-		int(server.esDetails.lastDocumentIndex),
-		j,
-	)
+
+	if !authEmployer.IsEmailVerified {
+		ctx.JSON(http.StatusForbidden, errorResponse(emailNotVerifiedError))
+		return
+	}
+
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "create jobs")))
+		return
+	}
+
+	// get the job that is copied
+	job, err := server.store.GetJob(ctx, uriRequest.ID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, newJobResponse(job, jobSkills))
+	// check if job is owned by the employer
+	if job.CompanyID != authEmployer.CompanyID {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(jobOwnershipError))
+		return
+	}
+
+	var skills []string
+	if request.RequiredSkills == nil {
+		skills, err = server.store.ListAllJobSkillsByJobID(ctx, job.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	clone := request.createJobRequest(job, skills)
+	if err := clone.validate(); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	clone.setDefaults()
+	clone.RequiredSkills = uniqueSkills(clone.RequiredSkills)
+
+	server.createJobWithSkills(ctx, authEmployer, clone)
 }
 
 type deleteJobRequest struct {
@@ -169,10 +455,12 @@ type deleteJobRequest struct {
 
 // @Schemes
 // @Summary Delete job
-// @Description Delete the job with the given id
+// @Description Delete the job with the given id. The job is no longer listed, but applicants still see it in their job applications.
 // @Tags jobs
 // @param id path integer true "Job ID"
 // @Success 204 {null} null
+// @Failure 401 {object} ErrorResponse "User making the request not an employer or employer not the owner of the job"
+// @Failure 403 {object} ErrorResponse "Role of the employer in the company does not allow deleting jobs"
 // @Failure 404 {object} ErrorResponse "Job not found"
 // @Failure 500 {object} ErrorResponse "Any error"
 // @Security ApiKeyAuth
@@ -187,8 +475,13 @@ func (server *Server) deleteJob(ctx *gin.Context) {
 
 	// get employer that is making the request
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -210,30 +503,28 @@ func (server *Server) deleteJob(ctx *gin.Context) {
 		return
 	}
 
-	// delete job skills first
-	err = server.store.DeleteJobSkillsByJobID(ctx, job.ID)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	// check if the role of the employer in the company allows deleting jobs
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "delete jobs")))
 		return
 	}
 
-	// delete job
+	// the job is only marked as deleted, its skills and applications are kept,
+	// so applicants still see the job in the history of their applications
 	err = server.store.DeleteJob(ctx, request.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	// delete the job from the elasticsearch index
-	// get document ID
-	documentID, err := server.esDetails.client.GetDocumentIDByJobID(int(job.ID))
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	// drafts, closed jobs and jobs unpublished by an admin are not in the elasticsearch index
+	if !isJobIndexed(job) {
+		ctx.JSON(http.StatusNoContent, nil)
 		return
 	}
 
-	// delete the document from the index
-	err = server.esDetails.client.DeleteJobDocument(documentID)
+	// delete the job from the elasticsearch index
+	err = server.removeJobFromSearch(job.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -247,15 +538,20 @@ type updateJobUriRequest struct {
 }
 
 type updateJobRequest struct {
-	Title                    string   `json:"title"`
-	Description              string   `json:"description"`
-	Industry                 string   `json:"industry"`
-	Location                 string   `json:"location"`
-	SalaryMin                int32    `json:"salary_min"`
-	SalaryMax                int32    `json:"salary_max"`
-	Requirements             string   `json:"requirements"`
-	RequiredSkillsToAdd      []string `json:"required_skills_to_add"`
-	RequiredSkillIDsToRemove []int32  `json:"required_skill_ids_to_remove"`
+	Title                    string            `json:"title"`
+	Description              string            `json:"description"`
+	Industry                 string            `json:"industry"`
+	Location                 string            `json:"location"`
+	SalaryMin                int32             `json:"salary_min"`
+	SalaryMax                int32             `json:"salary_max"`
+	Requirements             string            `json:"requirements"`
+	RequiredSkillsToAdd      []string          `json:"required_skills_to_add"`
+	RequiredSkillIDsToRemove []int32           `json:"required_skill_ids_to_remove"`
+	EmploymentType           db.EmploymentType `json:"employment_type" binding:"omitempty,oneof=full_time part_time contract internship temporary"`
+	WorkMode                 db.WorkMode       `json:"work_mode" binding:"omitempty,oneof=on_site remote hybrid"`
+	SeniorityLevel           db.SeniorityLevel `json:"seniority_level" binding:"omitempty,oneof=intern junior middle senior lead"`
+	SalaryCurrency           string            `json:"salary_currency" binding:"omitempty,iso4217"`
+	SalaryPeriod             db.SalaryPeriod   `json:"salary_period" binding:"omitempty,oneof=hourly monthly yearly"`
 }
 
 // @Schemes
@@ -267,8 +563,9 @@ type updateJobRequest struct {
 // @Accept json
 // @Produce json
 // @Success 200 {object} jobResponse
-// @Failure 400 {object} ErrorResponse "Invalid request query or body"
+// @Failure 400 {object} ErrorResponse "Invalid request query or body, skills to remove of another job or there is no exchange rate for the currency"
 // @Failure 401 {object} ErrorResponse "User making the request not an employer or employer not the owner of the job"
+// @Failure 403 {object} ErrorResponse "Role of the employer in the company does not allow updating jobs"
 // @Failure 404 {object} ErrorResponse "Job not found"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
@@ -284,7 +581,7 @@ func (server *Server) updateJob(ctx *gin.Context) {
 
 	// job details
 	var request updateJobRequest
-	err := json.NewDecoder(ctx.Request.Body).Decode(&request)
+	err := ctx.ShouldBindJSON(&request)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
@@ -292,12 +589,13 @@ func (server *Server) updateJob(ctx *gin.Context) {
 
 	// get employer that is making the request
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -320,17 +618,28 @@ func (server *Server) updateJob(ctx *gin.Context) {
 		return
 	}
 
+	// check if the role of the employer in the company allows updating jobs
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "update jobs")))
+		return
+	}
+
 	// update job
 	params := db.UpdateJobParams{
-		ID:           job.ID,
-		Title:        request.Title,
-		Description:  request.Description,
-		Industry:     request.Industry,
-		Location:     request.Location,
-		SalaryMin:    request.SalaryMin,
-		SalaryMax:    request.SalaryMax,
-		Requirements: request.Requirements,
-		CompanyID:    job.CompanyID,
+		ID:             job.ID,
+		Title:          request.Title,
+		Description:    request.Description,
+		Industry:       request.Industry,
+		Location:       request.Location,
+		SalaryMin:      request.SalaryMin,
+		SalaryMax:      request.SalaryMax,
+		Requirements:   request.Requirements,
+		CompanyID:      job.CompanyID,
+		EmploymentType: request.EmploymentType,
+		WorkMode:       request.WorkMode,
+		SeniorityLevel: request.SeniorityLevel,
+		SalaryCurrency: request.SalaryCurrency,
+		SalaryPeriod:   request.SalaryPeriod,
 	}
 
 	if params.SalaryMin > params.SalaryMax {
@@ -360,32 +669,47 @@ func (server *Server) updateJob(ctx *gin.Context) {
 	if request.Requirements == "" {
 		params.Requirements = job.Requirements
 	}
-
-	job, err = server.store.UpdateJob(ctx, params)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+	if request.EmploymentType == "" {
+		params.EmploymentType = job.EmploymentType
+	}
+	if request.WorkMode == "" {
+		params.WorkMode = job.WorkMode
+	}
+	if request.SeniorityLevel == "" {
+		params.SeniorityLevel = job.SeniorityLevel
+	}
+	if request.SalaryCurrency == "" {
+		params.SalaryCurrency = job.SalaryCurrency
+	}
+	if request.SalaryPeriod == "" {
+		params.SalaryPeriod = job.SalaryPeriod
 	}
 
-	// --- update job skills
+	// every skill is removed once, so the removed skills can be counted
+	slices.Sort(request.RequiredSkillIDsToRemove)
+	request.RequiredSkillIDsToRemove = slices.Compact(request.RequiredSkillIDsToRemove)
 
-	// delete
-	if len(request.RequiredSkillIDsToRemove) > 0 {
-		err = server.store.DeleteMultipleJobSkills(ctx, request.RequiredSkillIDsToRemove)
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	// update job and its skills, they are stored as a new revision
+	result, err := server.store.UpdateJobTx(ctx, db.UpdateJobTxParams{
+		UpdateJobParams:          params,
+		RequiredSkillIDsToRemove: request.RequiredSkillIDsToRemove,
+		RequiredSkillsToAdd:      request.RequiredSkillsToAdd,
+		AuthorID:                 authEmployer.ID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusBadRequest, errorResponse(jobSkillOwnershipError))
 			return
 		}
-	}
-
-	// add
-	if len(request.RequiredSkillsToAdd) > 0 {
-		err = server.store.CreateMultipleJobSkills(ctx, request.RequiredSkillsToAdd, job.ID)
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		if isUnsupportedCurrencyError(err) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(unsupportedCurrencyError))
 			return
 		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
 	}
+	job = result.Job
 
 	// get skills
 	jobSkillsParams := db.ListJobSkillsByJobIDParams{
@@ -399,32 +723,14 @@ func (server *Server) updateJob(ctx *gin.Context) {
 		return
 	}
 
-	// get document id for the job to update the elasticsearch index
-	documentID, err := server.esDetails.client.GetDocumentIDByJobID(int(job.ID))
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	// drafts, closed jobs and jobs unpublished by an admin are not in the elasticsearch index
+	if !isJobIndexed(job) {
+		ctx.JSON(http.StatusOK, newJobResponse(job, jobSkills))
 		return
 	}
 
-	// convert job skills to slice of strings
-	var skills []string
-	for _, skill := range jobSkills {
-		skills = append(skills, skill.Skill)
-	}
-
-	esJob := esearch.Job{
-		Title:        job.Title,
-		Industry:     job.Industry,
-		Description:  job.Description,
-		Location:     job.Location,
-		SalaryMin:    job.SalaryMin,
-		SalaryMax:    job.SalaryMax,
-		Requirements: job.Requirements,
-		JobSkills:    skills,
-	}
-
-	// update elasticsearch index
-	err = server.esDetails.client.UpdateJobDocument(documentID, esJob)
+	// the whole document is indexed again, so it keeps the ID and the company name of the job
+	err = server.indexJob(ctx, job)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -439,7 +745,7 @@ type getJobRequest struct {
 
 // @Schemes
 // @Summary Get job
-// @Description Get details of the job with the given id
+// @Description Get details of the job with the given id. The view is counted in the stats of the job, once per viewer and day.
 // @Tags jobs
 // @Param id path integer true "Job ID"
 // @Produce json
@@ -467,33 +773,57 @@ func (server *Server) getJob(ctx *gin.Context) {
 		return
 	}
 
+	server.recordJobView(ctx, job.ID)
+
 	ctx.JSON(http.StatusOK, job)
 }
 
 type filterAndListJobs struct {
-	Title       string `form:"title"`
-	Industry    string `form:"industry"`
-	JobLocation string `form:"job_location"`
-	SalaryMin   int32  `form:"salary_min"`
-	SalaryMax   int32  `form:"salary_max"`
-	Page        int32  `form:"page" binding:"required,min=1"`
-	PageSize    int32  `form:"page_size" binding:"required,min=5,max=15"`
+	Title      string   `form:"title"`
+	Industries []string `form:"industry" binding:"max=10"`
+	Locations  []string `form:"location" binding:"max=10"`
+	// JobLocation is the single location accepted before location could be repeated,
+	// it is kept for older clients and matched as one more location
+	JobLocation    string    `form:"job_location"`
+	Skills         []string  `form:"skill" binding:"max=10"`
+	SkillMatch     string    `form:"skill_match" binding:"omitempty,oneof=any all"`
+	CreatedAfter   time.Time `form:"created_after" time_format:"2006-01-02" time_utc:"1"`
+	SalaryMin      int32     `form:"salary_min"`
+	SalaryMax      int32     `form:"salary_max"`
+	SalaryCurrency string    `form:"salary_currency" binding:"omitempty,iso4217"`
+	SalaryPeriod   string    `form:"salary_period" binding:"omitempty,oneof=hourly monthly yearly"`
+	EmploymentType string    `form:"employment_type" binding:"omitempty,oneof=full_time part_time contract internship temporary"`
+	WorkMode       string    `form:"work_mode" binding:"omitempty,oneof=on_site remote hybrid"`
+	SeniorityLevel string    `form:"seniority_level" binding:"omitempty,oneof=intern junior middle senior lead"`
+	Sort           string    `form:"sort" binding:"omitempty,oneof=newest oldest salary-desc salary-asc company"`
+	Cursor         string    `form:"cursor"`
+	PageSize       int32     `form:"page_size" binding:"required,min=5,max=15"`
 }
 
 // @Schemes
 // @Summary Filter and list jobs
-// @Description Filter and list jobs
+// @Description Filter and list jobs, the newest first by default. Returns the jobs of the page, the total number of matching jobs and the cursor of the next page.
 // @Tags jobs
-// @Param page query integer true "Page number"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned without it"
 // @Param page_size query integer true "Page size"
 // @Param title query string false "Job title - matches partially (ILIKE)"
-// @Param industry query string false "Job industry - exact name"
-// @Param job_location query string false "Job location - exact name"
+// @Param industry query []string false "Job industry - exact name, can be repeated to match any of the industries" collectionFormat(multi)
+// @Param location query []string false "Job location - exact name, can be repeated to match any of the locations" collectionFormat(multi)
+// @Param job_location query string false "Job location - exact name. Deprecated, use location instead."
+// @Param skill query []string false "Required skill - exact name, can be repeated" collectionFormat(multi)
+// @Param skill_match query string false "Whether jobs must require any or all of the skills, any by default" Enums(any, all)
+// @Param created_after query string false "Only jobs created on or after the date, e.g. 2024-01-31" Format(date)
 // @Param salary_min query integer false "Salary min - must be smaller or equal salary_max"
 // @Param salary_max query integer false "Salary max - must be greater or equal salary_min"
+// @Param salary_currency query string false "ISO 4217 currency of salary_min and salary_max, USD by default. Salaries of jobs are converted with the exchange rates before they are compared."
+// @Param salary_period query string false "Period of salary_min and salary_max, monthly by default" Enums(hourly, monthly, yearly)
+// @Param employment_type query string false "Employment type" Enums(full_time, part_time, contract, internship, temporary)
+// @Param work_mode query string false "Work mode" Enums(on_site, remote, hybrid)
+// @Param seniority_level query string false "Seniority level" Enums(intern, junior, middle, senior, lead)
+// @Param sort query string false "Order of the jobs, newest by default. Salaries are compared in USD per year, salary-desc sorts by salary max and salary-asc by salary min." Enums(newest, oldest, salary-desc, salary-asc, company)
 // @Produce json
-// @Success 200 {array} []db.ListJobsByFiltersRow
-// @Failure 400 {object} ErrorResponse "Invalid query"
+// @Success 200 {object} listResponse[db.ListJobsByFiltersRow]
+// @Failure 400 {object} ErrorResponse "Invalid query, invalid cursor or there is no exchange rate for the currency"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Router /jobs [get]
 // filterAndListJobs handles filtering and listing jobs
@@ -503,21 +833,63 @@ func (server *Server) filterAndListJobs(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
+	if request.Sort == "" {
+		request.Sort = defaultJobsSort
+	}
+
+	cursor, err := decodeCursor(request.Cursor, request.Sort)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if request.SalaryCurrency == "" {
+		request.SalaryCurrency = defaultSalaryCurrency
+	} else {
+		// salaries cannot be converted from a currency without an exchange rate
+		_, err := server.store.GetExchangeRate(ctx, request.SalaryCurrency)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				ctx.JSON(http.StatusBadRequest, errorResponse(unsupportedCurrencyError))
+				return
+			}
+
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+	if request.SalaryPeriod == "" {
+		request.SalaryPeriod = string(defaultSalaryPeriod)
+	}
+
+	if request.JobLocation != "" {
+		request.Locations = append(request.Locations, request.JobLocation)
+	}
+
+	// empty params like "location=" are sent by forms and do not filter
+	isEmpty := func(value string) bool { return value == "" }
+	request.Locations = slices.DeleteFunc(request.Locations, isEmpty)
+	request.Industries = slices.DeleteFunc(request.Industries, isEmpty)
+	request.Skills = slices.DeleteFunc(request.Skills, isEmpty)
+
+	// all skills are counted in the query, so they must not repeat
+	slices.Sort(request.Skills)
+	request.Skills = slices.Compact(request.Skills)
 
 	params := db.ListJobsByFiltersParams{
-		Limit:  request.PageSize,
-		Offset: (request.Page - 1) * request.PageSize,
+		// one more job is fetched to check if there is a next page
+		Limit: request.PageSize + 1,
 		Title: sql.NullString{
 			String: request.Title,
 			Valid:  request.Title != "",
 		},
-		JobLocation: sql.NullString{
-			String: request.JobLocation,
-			Valid:  request.JobLocation != "",
-		},
-		Industry: sql.NullString{
-			String: request.Industry,
-			Valid:  request.Industry != "",
+		Locations:      request.Locations,
+		Industries:     request.Industries,
+		Skills:         request.Skills,
+		MatchAllSkills: request.SkillMatch == "all",
+		CreatedAfter: sql.NullTime{
+			Time:  request.CreatedAfter,
+			Valid: !request.CreatedAfter.IsZero(),
 		},
 		SalaryMin: sql.NullInt32{
 			Int32: request.SalaryMin,
@@ -527,6 +899,27 @@ func (server *Server) filterAndListJobs(ctx *gin.Context) {
 			Int32: request.SalaryMax,
 			Valid: request.SalaryMax != 0,
 		},
+		EmploymentType: db.NullEmploymentType{
+			EmploymentType: db.EmploymentType(request.EmploymentType),
+			Valid:          request.EmploymentType != "",
+		},
+		WorkMode: db.NullWorkMode{
+			WorkMode: db.WorkMode(request.WorkMode),
+			Valid:    request.WorkMode != "",
+		},
+		SeniorityLevel: db.NullSeniorityLevel{
+			SeniorityLevel: db.SeniorityLevel(request.SeniorityLevel),
+			Valid:          request.SeniorityLevel != "",
+		},
+		SalaryCurrency:       request.SalaryCurrency,
+		SalaryPeriod:         db.SalaryPeriod(request.SalaryPeriod),
+		CursorCreatedAt:      cursor.createdAt(),
+		CursorID:             cursor.ID,
+		CursorSalary:         cursor.salary(),
+		CursorSalaryCurrency: cursor.salaryCurrency(),
+		CursorSalaryPeriod:   cursor.salaryPeriod(),
+		CursorCompanyName:    cursor.companyName(),
+		Sort:                 request.Sort,
 	}
 
 	jobs, err := server.store.ListJobsByFilters(ctx, params)
@@ -535,28 +928,125 @@ func (server *Server) filterAndListJobs(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, jobs)
+	total, err := server.store.CountJobsByFilters(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newListResponse(jobs, request.PageSize, total, request.Sort, func(job db.ListJobsByFiltersRow) pageCursor {
+		return newJobCursor(request.Sort, job.ID, job.CreatedAt, job.SalaryMin, job.SalaryMax, job.SalaryCurrency, job.SalaryPeriod, job.CompanyName)
+	}))
+}
+
+// newJobCursor creates the cursor of a job in a list in the given sort,
+// the cursor keeps the salary or the company name if the jobs are sorted by them
+func newJobCursor(sort string, id int32, createdAt time.Time, salaryMin int32, salaryMax int32,
+	salaryCurrency string, salaryPeriod db.SalaryPeriod, companyName string) pageCursor {
+	cursor := pageCursor{CreatedAt: createdAt, ID: id}
+	switch sort {
+	case "salary-desc", "salary-asc":
+		cursor.Salary = salaryMin
+		if sort == "salary-desc" {
+			cursor.Salary = salaryMax
+		}
+		cursor.SalaryCurrency = salaryCurrency
+		cursor.SalaryPeriod = salaryPeriod
+	case "company":
+		cursor.CompanyName = companyName
+	}
+	return cursor
 }
 
 type listJobsByMatchingSkillsRequest struct {
-	Page     int32 `form:"page" binding:"required,min=1"`
-	PageSize int32 `form:"page_size" binding:"required,min=5,max=15"`
+	Page     int32  `form:"page" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=15"`
+	Sort     string `form:"sort" binding:"omitempty,oneof=score newest oldest salary-desc salary-asc company"`
+}
+
+// jobMatchBreakdown contains the points of the job for every part of the match score
+type jobMatchBreakdown struct {
+	Skills        float64 `json:"skills"`
+	MatchedSkills int64   `json:"matched_skills"`
+	TotalSkills   int64   `json:"total_skills"`
+	Title         float64 `json:"title"`
+	Industry      float64 `json:"industry"`
+	Location      float64 `json:"location"`
+	Salary        float64 `json:"salary"`
+}
+
+type matchingJobResponse struct {
+	ID             int32             `json:"id"`
+	Title          string            `json:"title"`
+	Industry       string            `json:"industry"`
+	CompanyID      int32             `json:"company_id"`
+	CompanyName    string            `json:"company_name"`
+	Description    string            `json:"description"`
+	Location       string            `json:"location"`
+	SalaryMin      int32             `json:"salary_min"`
+	SalaryMax      int32             `json:"salary_max"`
+	SalaryCurrency string            `json:"salary_currency"`
+	SalaryPeriod   db.SalaryPeriod   `json:"salary_period"`
+	Requirements   string            `json:"requirements"`
+	CreatedAt      time.Time         `json:"created_at"`
+	EmploymentType db.EmploymentType `json:"employment_type"`
+	WorkMode       db.WorkMode       `json:"work_mode"`
+	SeniorityLevel db.SeniorityLevel `json:"seniority_level"`
+	MatchScore     float64           `json:"match_score"`
+	MatchBreakdown jobMatchBreakdown `json:"match_breakdown"`
+}
+
+// roundMatchScore rounds the points of the match score to one decimal place
+func roundMatchScore(score float64) float64 {
+	return math.Round(score*10) / 10
+}
+
+func newMatchingJobResponse(job db.ListJobsMatchingUserSkillsRow) matchingJobResponse {
+	return matchingJobResponse{
+		ID:             job.ID,
+		Title:          job.Title,
+		Industry:       job.Industry,
+		CompanyID:      job.CompanyID,
+		CompanyName:    job.CompanyName,
+		Description:    job.Description,
+		Location:       job.Location,
+		SalaryMin:      job.SalaryMin,
+		SalaryMax:      job.SalaryMax,
+		SalaryCurrency: job.SalaryCurrency,
+		SalaryPeriod:   job.SalaryPeriod,
+		Requirements:   job.Requirements,
+		CreatedAt:      job.CreatedAt,
+		EmploymentType: job.EmploymentType,
+		WorkMode:       job.WorkMode,
+		SeniorityLevel: job.SeniorityLevel,
+		MatchScore:     roundMatchScore(job.MatchScore),
+		MatchBreakdown: jobMatchBreakdown{
+			Skills:        roundMatchScore(job.SkillsScore),
+			MatchedSkills: job.MatchedSkills,
+			TotalSkills:   job.TotalSkills,
+			Title:         roundMatchScore(job.TitleScore),
+			Industry:      roundMatchScore(job.IndustryScore),
+			Location:      roundMatchScore(job.LocationScore),
+			Salary:        roundMatchScore(job.SalaryScore),
+		},
+	}
 }
 
 // @Schemes
 // @Summary List jobs by matching skills
-// @Description List jobs that match the authenticated users skills
+// @Description List jobs that match the authenticated users skills and pay at least their desired salary min. Salaries in different currencies and periods are compared in USD per year. Every job has a match score of up to 100 points with its breakdown: skills up to 50 (the share of the job skills the user has, weighted by the years of experience), desired title 20, desired industry 10, location 10 (remote jobs match any location) and the share of the desired salary range the job pays 10.
 // @Tags jobs
 // @Param page query integer true "Page number"
 // @Param page_size query integer true "Page size"
+// @Param sort query string false "Order of the jobs, the highest match score by default. Salaries are compared in USD per year, salary-desc sorts by salary max and salary-asc by salary min." Enums(score, newest, oldest, salary-desc, salary-asc, company)
 // @Produce json
-// @Success 200 {array} []db.ListJobsMatchingUserSkillsRow
+// @Success 200 {array} []matchingJobResponse
 // @Failure 400 {object} ErrorResponse "Invalid query"
 // @Failure 401 {object} ErrorResponse "Employer making the request - only users can access"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Router /jobs/match-skills [get]
 // listJobsByMatchingSkills handles listing all jobs
-// that skills match the users skills.
+// that skills match the users skills, with their match score.
 func (server *Server) listJobsByMatchingSkills(ctx *gin.Context) {
 	var request listJobsByMatchingSkillsRequest
 	if err := ctx.ShouldBindQuery(&request); err != nil {
@@ -564,21 +1054,15 @@ func (server *Server) listJobsByMatchingSkills(ctx *gin.Context) {
 		return
 	}
 
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
-	if err != nil {
-		// person is authenticated but cannot be find in users table
-		// means that this is an employer
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
-		}
-
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+	if request.Sort == "" {
+		request.Sort = defaultMatchingJobsSort
 	}
 
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
 	params := db.ListJobsMatchingUserSkillsParams{
-		UserID: authUser.ID,
+		UserID: authPayload.SubjectID,
+		Sort:   request.Sort,
 		Limit:  request.PageSize,
 		Offset: (request.Page - 1) * request.PageSize,
 	}
@@ -589,34 +1073,40 @@ func (server *Server) listJobsByMatchingSkills(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, jobs)
+	res := make([]matchingJobResponse, len(jobs))
+	for i, job := range jobs {
+		res[i] = newMatchingJobResponse(job)
+	}
+
+	ctx.JSON(http.StatusOK, res)
 }
 
 type listJobsByCompanyRequest struct {
 	ID           int32  `form:"id"`
 	Name         string `form:"name"`
 	NameContains string `form:"name_contains"`
-	Page         int32  `form:"page" binding:"required,min=1"`
+	Sort         string `form:"sort" binding:"omitempty,oneof=newest oldest salary-desc salary-asc company"`
+	Cursor       string `form:"cursor"`
 	PageSize     int32  `form:"page_size" binding:"required,min=5,max=15"`
 }
 
 // @Schemes
 // @Summary List jobs by company
-// @Description List jobs by company name, id or part of the name.
+// @Description List jobs by company name, id or part of the name, the newest first by default. Returns the jobs of the page, the total number of matching jobs and the cursor of the next page.
 // @Tags jobs
-// @Param page query integer true "Page number"
+// @Param cursor query string false "next_cursor of the previous page, the first page is returned without it"
 // @Param page_size query integer true "Page size"
 // @Param id query integer false "Company ID"
 // @Param name query string false "Company name"
 // @Param name_contains query string false "Part of the company name"
+// @Param sort query string false "Order of the jobs, newest by default. Salaries are compared in USD per year, salary-desc sorts by salary max and salary-asc by salary min." Enums(newest, oldest, salary-desc, salary-asc, company)
 // @Produce json
-// @Success 200 {array} []db.ListJobsByCompanyNameRow
-// @Failure 400 {object} ErrorResponse "Invalid query. Only one of the three parameters is allowed."
+// @Success 200 {object} listResponse[db.ListJobsByCompanyNameRow]
+// @Failure 400 {object} ErrorResponse "Invalid query or cursor. Only one of the three parameters is allowed."
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Router /jobs/company [get]
 // listJobsByCompany  handles listing jobs by company.
 // Required parameters:
-// - page (page number)
 // - page_size (number of items per page)
 // and either:
 // - id (company id) or
@@ -651,11 +1141,30 @@ func (server *Server) listJobsByCompany(ctx *gin.Context) {
 		return
 	}
 
+	if request.Sort == "" {
+		request.Sort = defaultJobsSort
+	}
+
+	cursor, err := decodeCursor(request.Cursor, request.Sort)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// one more job is fetched to check if there is a next page
+	limit := request.PageSize + 1
+
 	if request.ID != 0 {
 		params := db.ListJobsByCompanyIDParams{
-			CompanyID: request.ID,
-			Limit:     request.PageSize,
-			Offset:    (request.Page - 1) * request.PageSize,
+			CompanyID:            request.ID,
+			Limit:                limit,
+			CursorCreatedAt:      cursor.createdAt(),
+			Sort:                 request.Sort,
+			CursorID:             cursor.ID,
+			CursorSalary:         cursor.salary(),
+			CursorSalaryCurrency: cursor.salaryCurrency(),
+			CursorSalaryPeriod:   cursor.salaryPeriod(),
+			CursorCompanyName:    cursor.companyName(),
 		}
 
 		jobs, err := server.store.ListJobsByCompanyID(ctx, params)
@@ -664,15 +1173,29 @@ func (server *Server) listJobsByCompany(ctx *gin.Context) {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, jobs)
+		total, err := server.store.CountJobsByCompanyID(ctx, request.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, newListResponse(jobs, request.PageSize, total, request.Sort, func(job db.ListJobsByCompanyIDRow) pageCursor {
+			return newJobCursor(request.Sort, job.ID, job.CreatedAt, job.SalaryMin, job.SalaryMax, job.SalaryCurrency, job.SalaryPeriod, job.CompanyName)
+		}))
 		return
 	}
 
 	if request.Name != "" {
 		params := db.ListJobsByCompanyExactNameParams{
-			Name:   request.Name,
-			Limit:  request.PageSize,
-			Offset: (request.Page - 1) * request.PageSize,
+			Name:                 request.Name,
+			Limit:                limit,
+			CursorCreatedAt:      cursor.createdAt(),
+			Sort:                 request.Sort,
+			CursorID:             cursor.ID,
+			CursorSalary:         cursor.salary(),
+			CursorSalaryCurrency: cursor.salaryCurrency(),
+			CursorSalaryPeriod:   cursor.salaryPeriod(),
+			CursorCompanyName:    cursor.companyName(),
 		}
 
 		jobs, err := server.store.ListJobsByCompanyExactName(ctx, params)
@@ -681,14 +1204,28 @@ func (server *Server) listJobsByCompany(ctx *gin.Context) {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, jobs)
+		total, err := server.store.CountJobsByCompanyExactName(ctx, request.Name)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, newListResponse(jobs, request.PageSize, total, request.Sort, func(job db.ListJobsByCompanyExactNameRow) pageCursor {
+			return newJobCursor(request.Sort, job.ID, job.CreatedAt, job.SalaryMin, job.SalaryMax, job.SalaryCurrency, job.SalaryPeriod, job.CompanyName)
+		}))
 		return
 	}
 	if request.NameContains != "" {
 		params := db.ListJobsByCompanyNameParams{
-			Name:   request.NameContains,
-			Limit:  request.PageSize,
-			Offset: (request.Page - 1) * request.PageSize,
+			Name:                 request.NameContains,
+			Limit:                limit,
+			CursorCreatedAt:      cursor.createdAt(),
+			Sort:                 request.Sort,
+			CursorID:             cursor.ID,
+			CursorSalary:         cursor.salary(),
+			CursorSalaryCurrency: cursor.salaryCurrency(),
+			CursorSalaryPeriod:   cursor.salaryPeriod(),
+			CursorCompanyName:    cursor.companyName(),
 		}
 
 		jobs, err := server.store.ListJobsByCompanyName(ctx, params)
@@ -697,23 +1234,37 @@ func (server *Server) listJobsByCompany(ctx *gin.Context) {
 			return
 		}
 
-		ctx.JSON(http.StatusOK, jobs)
+		total, err := server.store.CountJobsByCompanyName(ctx, request.NameContains)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, newListResponse(jobs, request.PageSize, total, request.Sort, func(job db.ListJobsByCompanyNameRow) pageCursor {
+			return newJobCursor(request.Sort, job.ID, job.CreatedAt, job.SalaryMin, job.SalaryMax, job.SalaryCurrency, job.SalaryPeriod, job.CompanyName)
+		}))
 	}
 }
 
 type searchJobsRequest struct {
-	Search   string `form:"search" binding:"required"`
-	Page     int32  `form:"page" binding:"required,min=1"`
-	PageSize int32  `form:"page_size" binding:"required,min=5,max=15"`
+	Search         string `form:"search" binding:"required"`
+	EmploymentType string `form:"employment_type" binding:"omitempty,oneof=full_time part_time contract internship temporary"`
+	WorkMode       string `form:"work_mode" binding:"omitempty,oneof=on_site remote hybrid"`
+	SeniorityLevel string `form:"seniority_level" binding:"omitempty,oneof=intern junior middle senior lead"`
+	Page           int32  `form:"page" binding:"required,min=1"`
+	PageSize       int32  `form:"page_size" binding:"required,min=5,max=15"`
 }
 
 // @Schemes
 // @Summary Search jobs
-// @Description Search for jobs with elasticsearch.
+// @Description Search for jobs with elasticsearch. The appearances of the jobs in the results are counted in the stats of the jobs, once per viewer and day.
 // @Tags jobs
 // @Param page query integer true "Page number"
 // @Param page_size query integer true "Page size"
 // @Param search query string true "Search query"
+// @Param employment_type query string false "Employment type" Enums(full_time, part_time, contract, internship, temporary)
+// @Param work_mode query string false "Work mode" Enums(on_site, remote, hybrid)
+// @Param seniority_level query string false "Seniority level" Enums(intern, junior, middle, senior, lead)
 // @Produce json
 // @Success 200 {array} []esearch.Job
 // @Failure 400 {object} ErrorResponse "Invalid query"
@@ -729,30 +1280,42 @@ func (server *Server) searchJobs(ctx *gin.Context) {
 		return
 	}
 
-	jobs, err := server.esDetails.client.SearchJobs(ctx, request.Search, request.Page, request.PageSize)
+	filters := esearch.JobFilters{
+		EmploymentType: request.EmploymentType,
+		WorkMode:       request.WorkMode,
+		SeniorityLevel: request.SeniorityLevel,
+	}
+
+	jobs, err := server.esDetails.client.SearchJobs(ctx, request.Search, filters, request.Page, request.PageSize)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	jobIDs := make([]int32, 0, len(jobs))
+	for _, job := range jobs {
+		jobIDs = append(jobIDs, job.ID)
+	}
+	server.recordJobImpressions(ctx, jobIDs)
+
 	ctx.JSON(http.StatusOK, jobs)
 }
 
 type listEmployerJobsRequest struct {
-	Page     int32  `form:"page" binding:"required,min=1"`
+	Cursor   string `form:"cursor"`
 	PageSize int32  `form:"page_size" binding:"required,min=5,max=15"`
 	Sort     string `form:"sort" binding:"omitempty,oneof=date-asc date-desc"`
 }
 
 // @Schemes
 // @Summary List all jobs of an employer
-// @Description List all jobs of an employer. Only employers can access this endpoint. Returns a list of jobs that were created by the authenticated employer. Results are paginated based on page and page_size query parameters.
+// @Description List all jobs of an employer. Only employers can access this endpoint. Returns a list of jobs that were created by the authenticated employer. Results are paginated based on cursor and page_size query parameters, the response contains the total number of jobs and the cursor of the next page.
 // @Tags jobs
-// @param page query int true "page number"
+// @param cursor query string false "next_cursor of the previous page, the first page is returned without it"
 // @param page_size query int true "page size"
 // @param sort query string false "sort by date ('date-asc' or 'date-desc')"
-// @Success 200 {object} []db.ListJobsForEmployerRow
-// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Success 200 {object} listResponse[db.ListJobsForEmployerRow]
+// @Failure 400 {object} ErrorResponse "Invalid query parameters or cursor"
 // @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
@@ -765,13 +1328,17 @@ func (server *Server) listEmployerJobs(ctx *gin.Context) {
 		return
 	}
 
-	// check if the employer is authenticated (and is an employer, not user)
+	cursor, err := decodeCursor(request.Cursor, request.Sort)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -781,8 +1348,10 @@ func (server *Server) listEmployerJobs(ctx *gin.Context) {
 
 	params := db.ListJobsForEmployerParams{
 		CompanyID: authEmployer.CompanyID,
-		Limit:     request.PageSize,
-		Offset:    (request.Page - 1) * request.PageSize,
+		// one more job is fetched to check if there is a next page
+		Limit:           request.PageSize + 1,
+		CursorCreatedAt: cursor.createdAt(),
+		CursorID:        cursor.ID,
 	}
 
 	// by default, sort by date descending
@@ -800,5 +1369,13 @@ func (server *Server) listEmployerJobs(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, jobs)
+	total, err := server.store.CountJobsForEmployer(ctx, authEmployer.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newListResponse(jobs, request.PageSize, total, request.Sort, func(job db.ListJobsForEmployerRow) pageCursor {
+		return pageCursor{CreatedAt: job.CreatedAt, ID: job.ID}
+	}))
 }
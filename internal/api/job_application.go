@@ -63,18 +63,18 @@ func newJobApplicationResponse(jobApplication db.JobApplication) jobApplicationR
 // @Success 200 {object} jobApplicationResponse
 // @Failure 400 {object} ErrorResponse "Invalid request body"
 // @Failure 401 {object} ErrorResponse "Unauthorized. Only users can access, not employers."
+// @Failure 403 {object} ErrorResponse "Email address has not been verified or the job is not accepting applications (draft, closed or expired)"
+// @Failure 404 {object} ErrorResponse "Job not found"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
 // @Router /job-applications [post]
 // createJobApplication creates a new job application
 func (server *Server) createJobApplication(ctx *gin.Context) {
-	// check if the user is authenticated
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
+	authUser, err := server.store.GetUserByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by the employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -82,6 +82,11 @@ func (server *Server) createJobApplication(ctx *gin.Context) {
 		return
 	}
 
+	if !authUser.IsEmailVerified {
+		ctx.JSON(http.StatusForbidden, errorResponse(emailNotVerifiedError))
+		return
+	}
+
 	// get the CV file
 	file, header, err := ctx.Request.FormFile("cv")
 	if err != nil || header == nil {
@@ -111,10 +116,27 @@ func (server *Server) createJobApplication(ctx *gin.Context) {
 		return
 	}
 
+	// only published jobs that have not expired accept applications
+	job, err := server.store.GetJob(ctx, int32(jobID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if effectiveJobStatus(job) != db.JobStatusPublished || job.UnpublishedAt.Valid {
+		ctx.JSON(http.StatusForbidden, errorResponse(jobNotOpenError))
+		return
+	}
+
 	// create job application in the database
 	params := db.CreateJobApplicationTxParams{
 		CreateJobApplicationParams: db.CreateJobApplicationParams{
-			UserID: authUser.ID,
+			UserID: authPayload.SubjectID,
 			JobID:  int32(jobID),
 			Message: sql.NullString{
 				String: message,
@@ -132,7 +154,7 @@ func (server *Server) createJobApplication(ctx *gin.Context) {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
 			case "unique_violation":
-				err := fmt.Errorf("user with ID %d has already applied for this job", authUser.ID)
+				err := fmt.Errorf("user with ID %d has already applied for this job", authPayload.SubjectID)
 				ctx.JSON(http.StatusForbidden, errorResponse(err))
 				return
 			}
@@ -159,6 +181,8 @@ type getJobApplicationForUserResponse struct {
 	ApplicationMessage string               `json:"application_message"`
 	CvLink             string               `json:"cv_link"`
 	UserID             int32                `json:"user_id"`
+	// JobRevision is the revision of the job the user applied to
+	JobRevision int32 `json:"job_revision,omitempty"`
 }
 
 // @Schemes
@@ -186,19 +210,7 @@ func (server *Server) getJobApplicationForUser(ctx *gin.Context) {
 		return
 	}
 
-	// check if the user is authenticated (and is a user, not employer)
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by an employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
-			return
-		}
-
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
 
 	// get the job application from the database
 	jobApplication, err := server.store.GetJobApplicationForUser(ctx, request.ID)
@@ -215,8 +227,8 @@ func (server *Server) getJobApplicationForUser(ctx *gin.Context) {
 	}
 
 	// check if the authenticated user is the applicant
-	if authUser.ID != jobApplication.UserID {
-		err = fmt.Errorf("user with ID %d is not the applicant of this job application", authUser.ID)
+	if authPayload.SubjectID != jobApplication.UserID {
+		err = fmt.Errorf("user with ID %d is not the applicant of this job application", authPayload.SubjectID)
 		ctx.JSON(http.StatusForbidden, errorResponse(err))
 		return
 	}
@@ -240,6 +252,7 @@ func (server *Server) getJobApplicationForUser(ctx *gin.Context) {
 		ApplicationStatus: jobApplication.ApplicationStatus,
 		ApplicationDate:   jobApplication.ApplicationDate,
 		CvLink:            fmt.Sprintf("%s/%s", server.config.ServerAddress, url),
+		JobRevision:       jobApplication.JobRevision.Int32,
 	}
 	if jobApplication.ApplicationMessage.Valid {
 		res.ApplicationMessage = jobApplication.ApplicationMessage.String
@@ -264,6 +277,8 @@ type getJobApplicationForEmployerResponse struct {
 	UserFullName       string               `json:"user_full_name"`
 	UserLocation       string               `json:"user_location"`
 	CvLink             string               `json:"cv_link"`
+	// JobRevision is the revision of the job the user applied to
+	JobRevision int32 `json:"job_revision,omitempty"`
 }
 
 // @Schemes
@@ -291,13 +306,11 @@ func (server *Server) getJobApplicationForEmployer(ctx *gin.Context) {
 		return
 	}
 
-	// check if the employer is authenticated (and is an employer, not user)
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -344,6 +357,7 @@ func (server *Server) getJobApplicationForEmployer(ctx *gin.Context) {
 		UserEmail:         jobApplication.UserEmail,
 		UserFullName:      jobApplication.UserFullName,
 		UserLocation:      jobApplication.UserLocation,
+		JobRevision:       jobApplication.JobRevision.Int32,
 	}
 
 	if jobApplication.ApplicationMessage.Valid {
@@ -403,7 +417,7 @@ type changeJobApplicationStatusResponse struct {
 // @Success 200 {object} changeJobApplicationStatusResponse
 // @Failure 400 {object} ErrorResponse "Invalid status or job application ID"
 // @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
-// @Failure 403 {object} ErrorResponse "Only an employer that is part of the company that created the job that this application is for can access this endpoint.
+// @Failure 403 {object} ErrorResponse "Only an employer that is part of the company that created the job that this application is for and whose role allows it can access this endpoint.
 // @Failure 404 {object} ErrorResponse "Job application with given ID does not exist"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
@@ -422,13 +436,11 @@ func (server *Server) changeJobApplicationStatus(ctx *gin.Context) {
 		return
 	}
 
-	// check if the employer is authenticated (and is an employer, not user)
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -466,6 +478,12 @@ func (server *Server) changeJobApplicationStatus(ctx *gin.Context) {
 		return
 	}
 
+	// viewers can only read job applications
+	if !canManageApplications(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "change statuses of job applications")))
+		return
+	}
+
 	// update the job application status
 	err = server.store.UpdateJobApplicationStatus(ctx, db.UpdateJobApplicationStatusParams{
 		ID:     uriRequest.ID,
@@ -515,19 +533,7 @@ func (server *Server) updateJobApplication(ctx *gin.Context) {
 		return
 	}
 
-	// check if the user is authenticated (and is a user, not an employer)
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by an employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
-			return
-		}
-
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
 
 	// get the job application and check if the user created it
 	applicationDetails, err := server.store.GetJobApplicationUserIDAndStatus(ctx, request.ID)
@@ -551,9 +557,9 @@ func (server *Server) updateJobApplication(ctx *gin.Context) {
 	}
 
 	// compare userID and users ID to check if the user created the job application
-	if applicationDetails.UserID != authUser.ID {
+	if applicationDetails.UserID != authPayload.SubjectID {
 		ctx.JSON(http.StatusForbidden, errorResponse(
-			userNotOwnerOfApplicationError(authUser.ID),
+			userNotOwnerOfApplicationError(authPayload.SubjectID),
 		))
 		return
 	}
@@ -641,19 +647,7 @@ func (server *Server) deleteJobApplication(ctx *gin.Context) {
 		return
 	}
 
-	// check if the user is authenticated (and is a user, not an employer)
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by an employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
-			return
-		}
-
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
 
 	// get the userID of the job application and check if the user created it
 	userID, err := server.store.GetJobApplicationUserID(ctx, request.ID)
@@ -670,9 +664,9 @@ func (server *Server) deleteJobApplication(ctx *gin.Context) {
 	}
 
 	//  check if the user created the job application
-	if userID != authUser.ID {
+	if userID != authPayload.SubjectID {
 		ctx.JSON(http.StatusForbidden, errorResponse(
-			userNotOwnerOfApplicationError(authUser.ID),
+			userNotOwnerOfApplicationError(authPayload.SubjectID),
 		))
 		return
 	}
@@ -687,7 +681,7 @@ func (server *Server) deleteJobApplication(ctx *gin.Context) {
 }
 
 type listJobApplicationsForUser struct {
-	Page     int32                `form:"page" binding:"required,min=1"`
+	Cursor   string               `form:"cursor"`
 	PageSize int32                `form:"page_size" binding:"required,min=5,max=15"`
 	Sort     string               `form:"sort" binding:"omitempty,oneof=date-asc date-desc"`
 	Status   db.ApplicationStatus `form:"status" binding:"omitempty,oneof=Applied Seen Interviewing Offered Rejected"`
@@ -695,20 +689,20 @@ type listJobApplicationsForUser struct {
 
 // @Schemes
 // @Summary List job applications (user)
-// @Description List job applications. Only users can access this endpoint. Returns a list of job applications that authenticated user created. Results are paginated based on page and page_size query parameters.
+// @Description List job applications. Only users can access this endpoint. Returns a list of job applications that authenticated user created, including applications to jobs that were deleted since (job_deleted). Results are paginated based on cursor and page_size query parameters, the response contains the total number of applications and the cursor of the next page.
 // @Tags job applications
-// @param page query int true "page number"
+// @param cursor query string false "next_cursor of the previous page, the first page is returned without it"
 // @param page_size query int true "page size"
 // @param sort query string false "sort by date ('date-asc' or 'date-desc')"
 // @param status query string false "filter by status ('Applied', 'Seen', 'Interviewing', 'Offered', 'Rejected')"
-// @Success 200 {object} []db.ListJobApplicationsForUserRow
-// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Success 200 {object} listResponse[db.ListJobApplicationsForUserRow]
+// @Failure 400 {object} ErrorResponse "Invalid query parameters or cursor"
 // @Failure 401 {object} ErrorResponse "Unauthorized. Only users can access, not employers."
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
 // @Router /job-applications/user [get]
 // listJobApplicationsForUser lists all job applications that authenticated
-// user created. Results are paginated based on page and page_size query parameters.
+// user created. Results are paginated based on cursor and page_size query parameters.
 func (server *Server) listJobApplicationsForUser(ctx *gin.Context) {
 	var request listJobApplicationsForUser
 	if err := ctx.ShouldBindQuery(&request); err != nil {
@@ -716,25 +710,21 @@ func (server *Server) listJobApplicationsForUser(ctx *gin.Context) {
 		return
 	}
 
-	// check if the user is authenticated (and is a user, not an employer)
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
+	cursor, err := decodeCursor(request.Cursor, request.Sort)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by an employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
-			return
-		}
-
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
 
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
 	// get the job applications
 	params := db.ListJobApplicationsForUserParams{
-		UserID: authUser.ID,
-		Limit:  request.PageSize,
-		Offset: (request.Page - 1) * request.PageSize,
+		UserID: authPayload.SubjectID,
+		// one more application is fetched to check if there is a next page
+		Limit:           request.PageSize + 1,
+		CursorAppliedAt: cursor.createdAt(),
+		CursorID:        cursor.ID,
 
 		// this value does not matter if the FilterStatus is false
 		// it just needs to be set to one of the values from the
@@ -765,12 +755,24 @@ func (server *Server) listJobApplicationsForUser(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, jobApplications)
+	total, err := server.store.CountJobApplicationsForUser(ctx, db.CountJobApplicationsForUserParams{
+		UserID:       params.UserID,
+		FilterStatus: params.FilterStatus,
+		Status:       params.Status,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newListResponse(jobApplications, request.PageSize, total, request.Sort, func(application db.ListJobApplicationsForUserRow) pageCursor {
+		return pageCursor{CreatedAt: application.ApplicationDate, ID: application.ApplicationID}
+	}))
 }
 
 type listJobApplicationsForEmployer struct {
 	JobID    int32                `form:"job_id" binding:"required,min=1"`
-	Page     int32                `form:"page" binding:"required,min=1"`
+	Cursor   string               `form:"cursor"`
 	PageSize int32                `form:"page_size" binding:"required,min=5,max=15"`
 	Sort     string               `form:"sort" binding:"omitempty,oneof=date-asc date-desc"`
 	Status   db.ApplicationStatus `form:"status" binding:"omitempty,oneof=Applied Seen Interviewing Offered Rejected"`
@@ -778,15 +780,15 @@ type listJobApplicationsForEmployer struct {
 
 // @Schemes
 // @Summary List job applications (employer)
-// @Description List job applications for a job with a given ID. Only employers can access this endpoint. Returns a list of job applications that were made for a given job. Results are paginated based on page and page_size query parameters.
+// @Description List job applications for a job with a given ID. Only employers can access this endpoint. Returns a list of job applications that were made for a given job. Results are paginated based on cursor and page_size query parameters, the response contains the total number of applications and the cursor of the next page.
 // @Tags job applications
 // @param job_id query int true "job ID"
-// @param page query int true "page number"
+// @param cursor query string false "next_cursor of the previous page, the first page is returned without it"
 // @param page_size query int true "page size"
 // @param sort query string false "sort by date ('date-asc' or 'date-desc')"
 // @param status query string false "filter by status ('Applied', 'Seen', 'Interviewing', 'Offered', 'Rejected')"
-// @Success 200 {object} []db.ListJobApplicationsForEmployerRow
-// @Failure 400 {object} ErrorResponse "Invalid query parameters"
+// @Success 200 {object} listResponse[db.ListJobApplicationsForEmployerRow]
+// @Failure 400 {object} ErrorResponse "Invalid query parameters or cursor"
 // @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
 // @Failure 403 {object} ErrorResponse "Employer is trying to access job that does not belong to them."
 // @Failure 404 {object} ErrorResponse "Job with given ID does not exist"
@@ -794,7 +796,7 @@ type listJobApplicationsForEmployer struct {
 // @Security ApiKeyAuth
 // @Router /job-applications/employer [get]
 // listJobApplicationsForEmployer lists all job applications for a given job
-// that authenticated employer created. Results are paginated based on page and page_size query parameters.
+// that authenticated employer created. Results are paginated based on cursor and page_size query parameters.
 func (server *Server) listJobApplicationsForEmployer(ctx *gin.Context) {
 	var request listJobApplicationsForEmployer
 	if err := ctx.ShouldBindQuery(&request); err != nil {
@@ -802,13 +804,17 @@ func (server *Server) listJobApplicationsForEmployer(ctx *gin.Context) {
 		return
 	}
 
-	// check if the employer is authenticated (and is an employer, not user)
+	cursor, err := decodeCursor(request.Cursor, request.Sort)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authEmployer, err := server.store.GetEmployerByEmail(ctx, authPayload.Email)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so it had to be made by a user
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -838,9 +844,11 @@ func (server *Server) listJobApplicationsForEmployer(ctx *gin.Context) {
 
 	// get the job applications
 	params := db.ListJobApplicationsForEmployerParams{
-		JobID:  request.JobID,
-		Limit:  request.PageSize,
-		Offset: (request.Page - 1) * request.PageSize,
+		JobID: request.JobID,
+		// one more application is fetched to check if there is a next page
+		Limit:           request.PageSize + 1,
+		CursorAppliedAt: cursor.createdAt(),
+		CursorID:        cursor.ID,
 
 		// this value does not matter if the FilterStatus is false
 		// it just needs to be set to one of the values from the
@@ -871,7 +879,19 @@ func (server *Server) listJobApplicationsForEmployer(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, jobApplications)
+	total, err := server.store.CountJobApplicationsForEmployer(ctx, db.CountJobApplicationsForEmployerParams{
+		JobID:        params.JobID,
+		FilterStatus: params.FilterStatus,
+		Status:       params.Status,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newListResponse(jobApplications, request.PageSize, total, request.Sort, func(application db.ListJobApplicationsForEmployerRow) pageCursor {
+		return pageCursor{CreatedAt: application.ApplicationDate, ID: application.ApplicationID}
+	}))
 }
 
 // @Schemes https
@@ -0,0 +1,400 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/internal/esearch"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// at most this many jobs can be imported with one request
+	maxImportedJobs = 100
+	// the size of the body of an import request is limited to 5 MB
+	maxImportBodySize = 5 << 20
+	// required skills in a CSV cell are separated with a semicolon
+	csvSkillsSeparator = ";"
+)
+
+var (
+	unsupportedImportFormatError = errors.New("content type must be text/csv or application/x-ndjson")
+	noJobsToImportError          = errors.New("there are no jobs to import")
+	tooManyJobsToImportError     = fmt.Errorf("at most %d jobs can be imported at once", maxImportedJobs)
+)
+
+// csvImportColumns are the columns of the header of the CSV file, named
+// as the fields of createJobRequest, only the required ones must be present
+var csvImportColumns = map[string]bool{
+	"title":           true,
+	"description":     true,
+	"industry":        true,
+	"location":        true,
+	"salary_min":      true,
+	"salary_max":      true,
+	"salary_currency": false,
+	"salary_period":   false,
+	"requirements":    true,
+	"required_skills": true,
+	"employment_type": false,
+	"work_mode":       false,
+	"seniority_level": false,
+	"status":          false,
+	"expires_at":      false,
+	"publish_at":      false,
+}
+
+// importedJob is a job parsed from one row of the imported file
+type importedJob struct {
+	row     int
+	request createJobRequest
+	err     error
+}
+
+type importJobRowReport struct {
+	// Row is the number of the line in JSON Lines or of the row after the header in CSV
+	Row   int   `json:"row"`
+	JobID int32 `json:"job_id,omitempty"`
+	// Indexed tells if the job is searchable, drafts are not indexed
+	Indexed bool   `json:"indexed"`
+	Error   string `json:"error,omitempty"`
+}
+
+type importJobsResponse struct {
+	Created int                  `json:"created"`
+	Failed  int                  `json:"failed"`
+	Rows    []importJobRowReport `json:"rows"`
+}
+
+// @Schemes
+// @Summary Import jobs
+// @Description Create many jobs at once from a CSV file with a header or from JSON Lines. Every row is validated as the body of the create job request, in CSV the required skills are separated with a semicolon. Valid rows are created together, invalid rows are skipped and reported with the reason.
+// @Tags jobs
+// @Accept text/csv
+// @Accept application/x-ndjson
+// @Produce json
+// @Success 200 {object} importJobsResponse
+// @Failure 400 {object} ErrorResponse "Invalid file, unsupported content type, no jobs or too many jobs"
+// @Failure 403 {object} ErrorResponse "Email address has not been verified or the role of the employer does not allow creating jobs"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/import [post]
+// importJobs handles creating many job postings from a file
+func (server *Server) importJobs(ctx *gin.Context) {
+	body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxImportBodySize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var jobs []importedJob
+	switch ctx.ContentType() {
+	case "text/csv":
+		jobs, err = parseCSVJobs(body)
+	case "application/x-ndjson", "application/jsonl":
+		jobs, err = parseJSONLinesJobs(body)
+	default:
+		err = unsupportedImportFormatError
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if len(jobs) == 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(noJobsToImportError))
+		return
+	}
+	if len(jobs) > maxImportedJobs {
+		ctx.JSON(http.StatusBadRequest, errorResponse(tooManyJobsToImportError))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !authEmployer.IsEmailVerified {
+		ctx.JSON(http.StatusForbidden, errorResponse(emailNotVerifiedError))
+		return
+	}
+
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "create jobs")))
+		return
+	}
+
+	// validate the rows, the currencies are checked before the transaction,
+	// so one unsupported currency does not fail all the jobs
+	supportedCurrencies := map[string]bool{}
+	for i := range jobs {
+		job := &jobs[i]
+		if job.err != nil {
+			continue
+		}
+
+		if job.err = binding.Validator.ValidateStruct(job.request); job.err != nil {
+			continue
+		}
+		if job.err = job.request.validate(); job.err != nil {
+			continue
+		}
+		job.request.setDefaults()
+		// a repeated skill would fail the whole transaction on the unique constraint
+		job.request.RequiredSkills = uniqueSkills(job.request.RequiredSkills)
+
+		supported, checked := supportedCurrencies[job.request.SalaryCurrency]
+		if !checked {
+			_, err := server.store.GetExchangeRate(ctx, job.request.SalaryCurrency)
+			if err != nil && err != sql.ErrNoRows {
+				ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+				return
+			}
+			supported = err == nil
+			supportedCurrencies[job.request.SalaryCurrency] = supported
+		}
+		if !supported {
+			job.err = unsupportedCurrencyError
+		}
+	}
+
+	response := importJobsResponse{
+		Rows: make([]importJobRowReport, len(jobs)),
+	}
+
+	var (
+		params = db.ImportJobsTxParams{AuthorID: authEmployer.ID}
+		// validJobs are the indexes of the jobs that are imported
+		validJobs []int
+	)
+	for i, job := range jobs {
+		response.Rows[i].Row = job.row
+		if job.err != nil {
+			response.Rows[i].Error = job.err.Error()
+			response.Failed++
+			continue
+		}
+
+		params.Jobs = append(params.Jobs, db.ImportJobParams{
+			CreateJobParams: job.request.createJobParams(authEmployer.CompanyID),
+			RequiredSkills:  job.request.RequiredSkills,
+		})
+		validJobs = append(validJobs, i)
+	}
+
+	if len(params.Jobs) == 0 {
+		ctx.JSON(http.StatusOK, response)
+		return
+	}
+
+	result, err := server.store.ImportJobsTx(ctx, params)
+	if err != nil {
+		if isUnsupportedCurrencyError(err) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(unsupportedCurrencyError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// drafts are not searchable until they are published
+	var documents []esearch.Job
+	for i, job := range result.Jobs {
+		response.Rows[validJobs[i]].JobID = job.ID
+		response.Created++
+
+		if isJobIndexed(job) {
+			documents = append(documents, newESJob(job, "", params.Jobs[i].RequiredSkills))
+		}
+	}
+
+	if len(documents) == 0 {
+		ctx.JSON(http.StatusOK, response)
+		return
+	}
+
+	// the jobs are already created, so indexing errors are only reported
+	failed, err := server.indexImportedJobs(ctx, authEmployer.CompanyID, documents)
+	for i, job := range result.Jobs {
+		if !isJobIndexed(job) {
+			continue
+		}
+
+		row := &response.Rows[validJobs[i]]
+		switch {
+		case err != nil:
+			row.Error = fmt.Sprintf("job was created, but could not be indexed: %s", err)
+		case failed[job.ID] != nil:
+			row.Error = fmt.Sprintf("job was created, but could not be indexed: %s", failed[job.ID])
+		default:
+			row.Indexed = true
+		}
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// indexImportedJobs adds the documents of the imported jobs of the company
+// to the elasticsearch index with one bulk request
+func (server *Server) indexImportedJobs(ctx *gin.Context, companyID int32, documents []esearch.Job) (map[int32]error, error) {
+	companyName, err := server.store.GetCompanyNameByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range documents {
+		documents[i].CompanyName = companyName
+	}
+
+	return server.esDetails.client.BulkIndexJobs(ctx, documents)
+}
+
+// parseCSVJobs parses jobs from a CSV file, the first row is the header
+// with the names of the columns, the rows are the jobs
+func parseCSVJobs(body []byte) ([]importedJob, error) {
+	reader := csv.NewReader(bytes.NewReader(body))
+	reader.TrimLeadingSpace = true
+	// rows with a wrong number of cells are reported, not the whole file
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, noJobsToImportError
+		}
+		return nil, err
+	}
+
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if _, ok := csvImportColumns[header[i]]; !ok {
+			return nil, fmt.Errorf("unknown column %q in the CSV header", header[i])
+		}
+	}
+	for column, required := range csvImportColumns {
+		if required && !slices.Contains(header, column) {
+			return nil, fmt.Errorf("column %q is missing in the CSV header", column)
+		}
+	}
+
+	var jobs []importedJob
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		job := importedJob{row: row}
+		if len(record) != len(header) {
+			job.err = fmt.Errorf("row has %d cells, but the header has %d columns", len(record), len(header))
+		} else {
+			job.request, job.err = csvRecordToJobRequest(header, record)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// csvRecordToJobRequest converts a CSV record to a createJobRequest,
+// empty cells are treated as not provided values
+func csvRecordToJobRequest(header, record []string) (createJobRequest, error) {
+	fields := map[string]interface{}{}
+	for i, column := range header {
+		value := strings.TrimSpace(record[i])
+		if value == "" {
+			continue
+		}
+
+		switch column {
+		case "salary_min", "salary_max":
+			salary, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return createJobRequest{}, fmt.Errorf("%s must be an integer", column)
+			}
+			fields[column] = salary
+		case "required_skills":
+			var skills []string
+			for _, skill := range strings.Split(value, csvSkillsSeparator) {
+				if skill = strings.TrimSpace(skill); skill != "" {
+					skills = append(skills, skill)
+				}
+			}
+			fields[column] = skills
+		default:
+			fields[column] = value
+		}
+	}
+
+	// the fields go through the same decoding as a JSON job
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return createJobRequest{}, err
+	}
+
+	var request createJobRequest
+	err = json.Unmarshal(data, &request)
+	return request, err
+}
+
+// parseJSONLinesJobs parses jobs from JSON Lines, every line is one job
+// in the format of the body of the create job request, empty lines are skipped
+func parseJSONLinesJobs(body []byte) ([]importedJob, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	// a line can be as long as the whole body
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportBodySize)
+
+	var jobs []importedJob
+	for row := 1; scanner.Scan(); row++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		job := importedJob{row: row}
+		job.err = json.Unmarshal(line, &job.request)
+		jobs = append(jobs, job)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// uniqueSkills removes repeated skills, keeping the order of the first occurrences
+func uniqueSkills(skills []string) []string {
+	seen := make(map[string]bool, len(skills))
+	unique := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		if !seen[skill] {
+			seen[skill] = true
+			unique = append(unique, skill)
+		}
+	}
+	return unique
+}
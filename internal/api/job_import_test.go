@@ -0,0 +1,415 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/internal/esearch"
+	mockesearch "github.com/grannnsacker/job-finder-back/internal/esearch/mock"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImportJobsAPI(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+	employer.IsEmailVerified = true
+	unverifiedEmployer := employer
+	unverifiedEmployer.IsEmailVerified = false
+
+	job := generateRandomJob()
+	job.CompanyID = employer.CompanyID
+	draftJob := generateRandomJob()
+	draftJob.CompanyID = employer.CompanyID
+	draftJob.Status = db.JobStatusDraft
+	draftJob.WorkMode = db.WorkModeRemote
+
+	csvHeader := "title,description,industry,location,salary_min,salary_max,requirements,required_skills\n"
+	csvRow := func(job db.Job, skills string) string {
+		return fmt.Sprintf("%s,%s,%s,%s,%d,%d,%s,\"%s\"\n",
+			job.Title, job.Description, job.Industry, job.Location, job.SalaryMin, job.SalaryMax, job.Requirements, skills)
+	}
+	// salary min is greater than salary max
+	invalidJob := job
+	invalidJob.SalaryMin, invalidJob.SalaryMax = job.SalaryMax, job.SalaryMin
+	csvBody := csvHeader + csvRow(job, "Go; Postgres;Go") + csvRow(invalidJob, "Go")
+
+	jsonLine := func(fields map[string]interface{}) string {
+		data, err := json.Marshal(fields)
+		require.NoError(t, err)
+		return string(data) + "\n"
+	}
+	jsonLinesBody := jsonLine(map[string]interface{}{
+		"title":           draftJob.Title,
+		"description":     draftJob.Description,
+		"industry":        draftJob.Industry,
+		"location":        draftJob.Location,
+		"salary_min":      draftJob.SalaryMin,
+		"salary_max":      draftJob.SalaryMax,
+		"requirements":    draftJob.Requirements,
+		"required_skills": []string{"Go"},
+		"status":          db.JobStatusDraft,
+		"work_mode":       db.WorkModeRemote,
+	}) + "\n" + jsonLine(map[string]interface{}{
+		"title":           job.Title,
+		"description":     job.Description,
+		"industry":        job.Industry,
+		"location":        job.Location,
+		"salary_min":      job.SalaryMin,
+		"salary_max":      job.SalaryMax,
+		"requirements":    job.Requirements,
+		"required_skills": []string{"Go"},
+		"salary_currency": "XAU",
+	}) + "{\"title\": \n"
+
+	importParams := db.ImportJobsTxParams{
+		Jobs: []db.ImportJobParams{
+			{
+				CreateJobParams: db.CreateJobParams{
+					Title:          job.Title,
+					Industry:       job.Industry,
+					CompanyID:      employer.CompanyID,
+					Description:    job.Description,
+					Location:       job.Location,
+					SalaryMin:      job.SalaryMin,
+					SalaryMax:      job.SalaryMax,
+					Requirements:   job.Requirements,
+					Status:         db.JobStatusPublished,
+					EmploymentType: job.EmploymentType,
+					WorkMode:       job.WorkMode,
+					SeniorityLevel: job.SeniorityLevel,
+					SalaryCurrency: job.SalaryCurrency,
+					SalaryPeriod:   job.SalaryPeriod,
+				},
+				RequiredSkills: []string{"Go", "Postgres"},
+			},
+		},
+		AuthorID: employer.ID,
+	}
+	documents := []esearch.Job{newESJob(job, company.Name, []string{"Go", "Postgres"})}
+
+	testCases := []struct {
+		name          string
+		contentType   string
+		body          string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "OK CSV",
+			contentType: "text/csv",
+			body:        csvBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetExchangeRate(gomock.Any(), gomock.Eq("USD")).
+					Times(1).
+					Return(db.ExchangeRate{Currency: "USD", RateToUsd: "1"}, nil)
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Eq(importParams)).
+					Times(1).
+					Return(db.ImportJobsTxResult{Jobs: []db.Job{job}}, nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(company.Name, nil)
+				client.EXPECT().
+					BulkIndexJobs(gomock.Any(), gomock.Eq(documents)).
+					Times(1).
+					Return(map[int32]error{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response := requireBodyImportJobsResponse(t, recorder.Body)
+				require.Equal(t, 1, response.Created)
+				require.Equal(t, 1, response.Failed)
+				require.Equal(t, importJobRowReport{Row: 1, JobID: job.ID, Indexed: true}, response.Rows[0])
+				require.Equal(t, importJobRowReport{Row: 2, Error: salaryRangeError.Error()}, response.Rows[1])
+			},
+		},
+		{
+			name:        "OK JSON Lines",
+			contentType: "application/x-ndjson",
+			body:        jsonLinesBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetExchangeRate(gomock.Any(), gomock.Eq("USD")).
+					Times(1).
+					Return(db.ExchangeRate{Currency: "USD", RateToUsd: "1"}, nil)
+				store.EXPECT().
+					GetExchangeRate(gomock.Any(), gomock.Eq("XAU")).
+					Times(1).
+					Return(db.ExchangeRate{}, sql.ErrNoRows)
+				params := db.ImportJobsTxParams{
+					Jobs: []db.ImportJobParams{
+						{
+							CreateJobParams: db.CreateJobParams{
+								Title:          draftJob.Title,
+								Industry:       draftJob.Industry,
+								CompanyID:      employer.CompanyID,
+								Description:    draftJob.Description,
+								Location:       draftJob.Location,
+								SalaryMin:      draftJob.SalaryMin,
+								SalaryMax:      draftJob.SalaryMax,
+								Requirements:   draftJob.Requirements,
+								Status:         db.JobStatusDraft,
+								EmploymentType: draftJob.EmploymentType,
+								WorkMode:       db.WorkModeRemote,
+								SeniorityLevel: draftJob.SeniorityLevel,
+								SalaryCurrency: draftJob.SalaryCurrency,
+								SalaryPeriod:   draftJob.SalaryPeriod,
+							},
+							RequiredSkills: []string{"Go"},
+						},
+					},
+					AuthorID: employer.ID,
+				}
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.ImportJobsTxResult{Jobs: []db.Job{draftJob}}, nil)
+				// drafts are not indexed
+				client.EXPECT().
+					BulkIndexJobs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response := requireBodyImportJobsResponse(t, recorder.Body)
+				require.Equal(t, 1, response.Created)
+				require.Equal(t, 2, response.Failed)
+				require.Len(t, response.Rows, 3)
+				require.Equal(t, importJobRowReport{Row: 1, JobID: draftJob.ID}, response.Rows[0])
+				// the empty line is skipped, but counted
+				require.Equal(t, importJobRowReport{Row: 3, Error: unsupportedCurrencyError.Error()}, response.Rows[1])
+				require.Equal(t, 4, response.Rows[2].Row)
+				require.NotEmpty(t, response.Rows[2].Error)
+			},
+		},
+		{
+			name:        "Indexing Failure Is Reported",
+			contentType: "text/csv",
+			body:        csvHeader + csvRow(job, "Go;Postgres"),
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetExchangeRate(gomock.Any(), gomock.Eq("USD")).
+					Times(1).
+					Return(db.ExchangeRate{Currency: "USD", RateToUsd: "1"}, nil)
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Eq(importParams)).
+					Times(1).
+					Return(db.ImportJobsTxResult{Jobs: []db.Job{job}}, nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(company.Name, nil)
+				client.EXPECT().
+					BulkIndexJobs(gomock.Any(), gomock.Eq(documents)).
+					Times(1).
+					Return(map[int32]error{job.ID: errors.New("mapper_parsing_exception")}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				response := requireBodyImportJobsResponse(t, recorder.Body)
+				require.Equal(t, 1, response.Created)
+				require.Equal(t, 0, response.Failed)
+				require.Equal(t, job.ID, response.Rows[0].JobID)
+				require.False(t, response.Rows[0].Indexed)
+				require.Contains(t, response.Rows[0].Error, "mapper_parsing_exception")
+			},
+		},
+		{
+			name:        "Unsupported Content Type",
+			contentType: "application/json",
+			body:        jsonLinesBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "Unknown CSV Column",
+			contentType: "text/csv",
+			body:        strings.Replace(csvHeader, "requirements", "requirement", 1) + csvRow(job, "Go"),
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "No Jobs",
+			contentType: "text/csv",
+			body:        csvHeader,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "Too Many Jobs",
+			contentType: "text/csv",
+			body:        csvHeader + strings.Repeat(csvRow(job, "Go"), maxImportedJobs+1),
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "Email Not Verified",
+			contentType: "text/csv",
+			body:        csvBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(unverifiedEmployer, nil)
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:        "User Making Request",
+			contentType: "text/csv",
+			body:        csvBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleUser, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:        "Internal Server Error ImportJobsTx",
+			contentType: "text/csv",
+			body:        csvBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetExchangeRate(gomock.Any(), gomock.Eq("USD")).
+					Times(1).
+					Return(db.ExchangeRate{Currency: "USD", RateToUsd: "1"}, nil)
+				store.EXPECT().
+					ImportJobsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ImportJobsTxResult{}, sql.ErrConnDone)
+				client.EXPECT().
+					BulkIndexJobs(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/jobs/import"
+			req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", tc.contentType)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func requireBodyImportJobsResponse(t *testing.T, body *bytes.Buffer) importJobsResponse {
+	var response importJobsResponse
+	err := json.Unmarshal(body.Bytes(), &response)
+	require.NoError(t, err)
+	return response
+}
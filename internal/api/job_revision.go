@@ -0,0 +1,391 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"net/http"
+	"time"
+)
+
+var jobApplicationWithoutRevisionError = errors.New("job application is not pinned to a revision of the job")
+
+// jobRevisionNotFoundError returns the error of a missing revision of a job
+func jobRevisionNotFoundError(jobID, revision int32) error {
+	return fmt.Errorf("revision %d of job with ID %d does not exist", revision, jobID)
+}
+
+// checkJobOfEmployer checks that the job exists and belongs to the company
+// of the authenticated employer, if not, the request is aborted
+func (server *Server) checkJobOfEmployer(ctx *gin.Context, jobID int32) bool {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	companyID, err := server.store.GetCompanyIDOfJob(ctx, jobID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = fmt.Errorf("job with ID %d does not exist", jobID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	if companyID != authEmployer.CompanyID {
+		err = fmt.Errorf("job with ID %d does not belong to employer with ID %d", jobID, authEmployer.CompanyID)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return false
+	}
+
+	return true
+}
+
+type jobRevisionResponse struct {
+	ID       int32 `json:"id"`
+	JobID    int32 `json:"job_id"`
+	Revision int32 `json:"revision"`
+	// AuthorID is the employer who made the change, null for revisions of jobs created before
+	// the revisions were stored or if the employer was deleted
+	AuthorID       *int32            `json:"author_id"`
+	Title          string            `json:"title"`
+	Industry       string            `json:"industry"`
+	Description    string            `json:"description"`
+	Location       string            `json:"location"`
+	SalaryMin      int32             `json:"salary_min"`
+	SalaryMax      int32             `json:"salary_max"`
+	SalaryCurrency string            `json:"salary_currency"`
+	SalaryPeriod   db.SalaryPeriod   `json:"salary_period"`
+	Requirements   string            `json:"requirements"`
+	RequiredSkills []string          `json:"required_skills"`
+	EmploymentType db.EmploymentType `json:"employment_type"`
+	WorkMode       db.WorkMode       `json:"work_mode"`
+	SeniorityLevel db.SeniorityLevel `json:"seniority_level"`
+	CreatedAt      time.Time         `json:"created_at"`
+}
+
+func newJobRevisionResponse(revision db.JobRevision) jobRevisionResponse {
+	res := jobRevisionResponse{
+		ID:             revision.ID,
+		JobID:          revision.JobID,
+		Revision:       revision.Revision,
+		Title:          revision.Title,
+		Industry:       revision.Industry,
+		Description:    revision.Description,
+		Location:       revision.Location,
+		SalaryMin:      revision.SalaryMin,
+		SalaryMax:      revision.SalaryMax,
+		SalaryCurrency: revision.SalaryCurrency,
+		SalaryPeriod:   revision.SalaryPeriod,
+		Requirements:   revision.Requirements,
+		RequiredSkills: revision.RequiredSkills,
+		EmploymentType: revision.EmploymentType,
+		WorkMode:       revision.WorkMode,
+		SeniorityLevel: revision.SeniorityLevel,
+		CreatedAt:      revision.CreatedAt,
+	}
+	if revision.AuthorID.Valid {
+		res.AuthorID = &revision.AuthorID.Int32
+	}
+
+	return res
+}
+
+// @Schemes
+// @Summary List revisions of a job
+// @Description List all revisions of the job with the given id, the newest first. A revision is stored on every create and update of the job, including its required skills. Only employers of the company of the job can access this endpoint.
+// @Tags jobs
+// @Produce json
+// @Param id path integer true "Job ID"
+// @Success 200 {array} jobRevisionResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Employer is trying to access job that does not belong to them."
+// @Failure 404 {object} ErrorResponse "Job with given ID does not exist"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/revisions [get]
+// listJobRevisions lists the revisions of a job of the authenticated employer
+func (server *Server) listJobRevisions(ctx *gin.Context) {
+	var request getJobRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !server.checkJobOfEmployer(ctx, request.ID) {
+		return
+	}
+
+	revisions, err := server.store.ListJobRevisions(ctx, request.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]jobRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		res = append(res, newJobRevisionResponse(revision))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type getJobRevisionRequest struct {
+	ID       int32 `uri:"id" binding:"required,min=1"`
+	Revision int32 `uri:"revision" binding:"required,min=1"`
+}
+
+// @Schemes
+// @Summary Get revision of a job
+// @Description Get the job with the given id as it was in the given revision. Only employers of the company of the job can access this endpoint.
+// @Tags jobs
+// @Produce json
+// @Param id path integer true "Job ID"
+// @Param revision path integer true "Revision number, starting from 1"
+// @Success 200 {object} jobRevisionResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID or revision"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Employer is trying to access job that does not belong to them."
+// @Failure 404 {object} ErrorResponse "Job or revision does not exist"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/revisions/{revision} [get]
+// getJobRevision gets a revision of a job of the authenticated employer
+func (server *Server) getJobRevision(ctx *gin.Context) {
+	var request getJobRevisionRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !server.checkJobOfEmployer(ctx, request.ID) {
+		return
+	}
+
+	revision, err := server.store.GetJobRevision(ctx, db.GetJobRevisionParams{
+		JobID:    request.ID,
+		Revision: request.Revision,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(jobRevisionNotFoundError(request.ID, request.Revision)))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newJobRevisionResponse(revision))
+}
+
+type diffJobRevisionsQuery struct {
+	From int32 `form:"from" binding:"required,min=1"`
+	To   int32 `form:"to" binding:"required,min=1"`
+}
+
+// jobRevisionChange is a field that has a different value in the two revisions
+type jobRevisionChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type jobRevisionsDiffResponse struct {
+	JobID int32 `json:"job_id"`
+	From  int32 `json:"from"`
+	To    int32 `json:"to"`
+	// Changes are in the order of the fields of the job, skills are not included
+	Changes       []jobRevisionChange `json:"changes"`
+	SkillsAdded   []string            `json:"skills_added"`
+	SkillsRemoved []string            `json:"skills_removed"`
+}
+
+// diffJobRevisions compares the fields and the skills of the revisions
+func diffJobRevisions(from, to db.JobRevision) jobRevisionsDiffResponse {
+	res := jobRevisionsDiffResponse{
+		JobID:         to.JobID,
+		From:          from.Revision,
+		To:            to.Revision,
+		Changes:       []jobRevisionChange{},
+		SkillsAdded:   []string{},
+		SkillsRemoved: []string{},
+	}
+
+	fields := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"title", from.Title, to.Title},
+		{"industry", from.Industry, to.Industry},
+		{"description", from.Description, to.Description},
+		{"location", from.Location, to.Location},
+		{"salary_min", from.SalaryMin, to.SalaryMin},
+		{"salary_max", from.SalaryMax, to.SalaryMax},
+		{"salary_currency", from.SalaryCurrency, to.SalaryCurrency},
+		{"salary_period", from.SalaryPeriod, to.SalaryPeriod},
+		{"requirements", from.Requirements, to.Requirements},
+		{"employment_type", from.EmploymentType, to.EmploymentType},
+		{"work_mode", from.WorkMode, to.WorkMode},
+		{"seniority_level", from.SeniorityLevel, to.SeniorityLevel},
+	}
+	for _, field := range fields {
+		if field.from != field.to {
+			res.Changes = append(res.Changes, jobRevisionChange{
+				Field: field.name,
+				From:  field.from,
+				To:    field.to,
+			})
+		}
+	}
+
+	fromSkills := make(map[string]bool, len(from.RequiredSkills))
+	for _, skill := range from.RequiredSkills {
+		fromSkills[skill] = true
+	}
+	toSkills := make(map[string]bool, len(to.RequiredSkills))
+	for _, skill := range to.RequiredSkills {
+		toSkills[skill] = true
+		if !fromSkills[skill] {
+			res.SkillsAdded = append(res.SkillsAdded, skill)
+		}
+	}
+	for _, skill := range from.RequiredSkills {
+		if !toSkills[skill] {
+			res.SkillsRemoved = append(res.SkillsRemoved, skill)
+		}
+	}
+
+	return res
+}
+
+// @Schemes
+// @Summary Diff revisions of a job
+// @Description Compare two revisions of the job with the given id. The response lists the fields with different values and the added and removed skills. Only employers of the company of the job can access this endpoint.
+// @Tags jobs
+// @Produce json
+// @Param id path integer true "Job ID"
+// @Param from query integer true "Revision to compare from"
+// @Param to query integer true "Revision to compare to"
+// @Success 200 {object} jobRevisionsDiffResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID or revisions"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Employer is trying to access job that does not belong to them."
+// @Failure 404 {object} ErrorResponse "Job or any of the revisions does not exist"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/revisions/diff [get]
+// diffJobRevisionsOfEmployer compares two revisions of a job of the authenticated employer
+func (server *Server) diffJobRevisionsOfEmployer(ctx *gin.Context) {
+	var request getJobRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var query diffJobRevisionsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !server.checkJobOfEmployer(ctx, request.ID) {
+		return
+	}
+
+	revisions := make([]db.JobRevision, 2)
+	for i, number := range []int32{query.From, query.To} {
+		revision, err := server.store.GetJobRevision(ctx, db.GetJobRevisionParams{
+			JobID:    request.ID,
+			Revision: number,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				ctx.JSON(http.StatusNotFound, errorResponse(jobRevisionNotFoundError(request.ID, number)))
+				return
+			}
+
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		revisions[i] = revision
+	}
+
+	ctx.JSON(http.StatusOK, diffJobRevisions(revisions[0], revisions[1]))
+}
+
+// @Schemes
+// @Summary Get job revision of a job application
+// @Description Get the job as it was when the user applied to it, later changes of the job are not included. Only the applicant (the owner) of the job application can access this endpoint.
+// @Tags job applications
+// @Produce json
+// @param id path int true "job application ID"
+// @Success 200 {object} jobRevisionResponse
+// @Failure 400 {object} ErrorResponse "Invalid ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only users can access, not employers."
+// @Failure 403 {object} ErrorResponse "Only the applicant (the owner) of the job application can access this endpoint."
+// @Failure 404 {object} ErrorResponse "Job application does not exist or it is not pinned to a revision"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /job-applications/user/{id}/job-revision [get]
+// getJobRevisionOfJobApplication gets the revision of the job that the user applied to
+func (server *Server) getJobRevisionOfJobApplication(ctx *gin.Context) {
+	var request getJobApplicationForUserRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	jobApplication, err := server.store.GetJobApplicationForUser(ctx, request.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(
+				jobApplicationDoesNotExistError(request.ID),
+			))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// check if the authenticated user is the applicant
+	if authPayload.SubjectID != jobApplication.UserID {
+		ctx.JSON(http.StatusForbidden, errorResponse(userNotOwnerOfApplicationError(authPayload.SubjectID)))
+		return
+	}
+
+	if !jobApplication.JobRevisionID.Valid {
+		ctx.JSON(http.StatusNotFound, errorResponse(jobApplicationWithoutRevisionError))
+		return
+	}
+
+	revision, err := server.store.GetJobRevisionByID(ctx, jobApplication.JobRevisionID.Int32)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(jobApplicationWithoutRevisionError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newJobRevisionResponse(revision))
+}
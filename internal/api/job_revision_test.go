@@ -0,0 +1,709 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListJobRevisionsAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	job := generateRandomJob()
+	revisions := []db.JobRevision{
+		generateRandomJobRevision(job, employer.ID, 2),
+		generateRandomJobRevision(job, employer.ID, 1),
+	}
+
+	testCases := []struct {
+		name          string
+		jobID         int32
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(employer.CompanyID, nil)
+				store.EXPECT().
+					ListJobRevisions(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(revisions, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var got []jobRevisionResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Len(t, got, len(revisions))
+				for i, revision := range revisions {
+					require.Equal(t, revision.Revision, got[i].Revision)
+					require.Equal(t, revision.Title, got[i].Title)
+					require.Equal(t, revision.RequiredSkills, got[i].RequiredSkills)
+					require.NotNil(t, got[i].AuthorID)
+					require.Equal(t, employer.ID, *got[i].AuthorID)
+				}
+			},
+		},
+		{
+			name:  "Job Not Found",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(int32(0), sql.ErrNoRows)
+				store.EXPECT().
+					ListJobRevisions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "Job Of Other Company",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(employer.CompanyID+1, nil)
+				store.EXPECT().
+					ListJobRevisions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "Employer Not Found",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					ListJobRevisions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(employer.CompanyID, nil)
+				store.EXPECT().
+					ListJobRevisions(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return([]db.JobRevision{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid ID",
+			jobID: 0,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ListJobRevisions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Unauthorized",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobRevisions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/jobs/%d/revisions", BaseUrl, tc.jobID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestGetJobRevisionAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	job := generateRandomJob()
+	revision := generateRandomJobRevision(job, employer.ID, 1)
+	// the revisions of jobs created before the history was stored have no author
+	revision.AuthorID = sql.NullInt32{}
+
+	testCases := []struct {
+		name          string
+		revision      int32
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			revision: revision.Revision,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(employer.CompanyID, nil)
+				params := db.GetJobRevisionParams{
+					JobID:    job.ID,
+					Revision: revision.Revision,
+				}
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(revision, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				got := requireBodyMatchJobRevision(t, recorder.Body, revision)
+				require.Nil(t, got.AuthorID)
+			},
+		},
+		{
+			name:     "Revision Not Found",
+			revision: revision.Revision + 1,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(employer.CompanyID, nil)
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobRevision{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Job Of Other Company",
+			revision: revision.Revision,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(employer.CompanyID+1, nil)
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid Revision",
+			revision: 0,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/jobs/%d/revisions/%d", BaseUrl, job.ID, tc.revision)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestDiffJobRevisionsAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	job := generateRandomJob()
+	from := generateRandomJobRevision(job, employer.ID, 1)
+	to := from
+	to.ID = from.ID + 1
+	to.Revision = 2
+	to.Title = utils.RandomString(8)
+	to.SalaryMax = from.SalaryMax + 100
+	to.RequiredSkills = []string{from.RequiredSkills[0], utils.RandomString(7)}
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "from=1&to=2",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(employer.CompanyID, nil)
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Eq(db.GetJobRevisionParams{JobID: job.ID, Revision: 1})).
+					Times(1).
+					Return(from, nil)
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Eq(db.GetJobRevisionParams{JobID: job.ID, Revision: 2})).
+					Times(1).
+					Return(to, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var got jobRevisionsDiffResponse
+				err = json.Unmarshal(data, &got)
+				require.NoError(t, err)
+				require.Equal(t, job.ID, got.JobID)
+				require.Equal(t, int32(1), got.From)
+				require.Equal(t, int32(2), got.To)
+				require.Len(t, got.Changes, 2)
+				require.Equal(t, "title", got.Changes[0].Field)
+				require.Equal(t, from.Title, got.Changes[0].From)
+				require.Equal(t, to.Title, got.Changes[0].To)
+				require.Equal(t, "salary_max", got.Changes[1].Field)
+				require.Equal(t, []string{to.RequiredSkills[1]}, got.SkillsAdded)
+				require.Equal(t, []string{from.RequiredSkills[1]}, got.SkillsRemoved)
+			},
+		},
+		{
+			name:  "Revision Not Found",
+			query: "from=1&to=3",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(employer.CompanyID, nil)
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Eq(db.GetJobRevisionParams{JobID: job.ID, Revision: 1})).
+					Times(1).
+					Return(from, nil)
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Eq(db.GetJobRevisionParams{JobID: job.ID, Revision: 3})).
+					Times(1).
+					Return(db.JobRevision{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "Missing To",
+			query: "from=1",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Unauthorized User",
+			query: "from=1&to=2",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleUser, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobRevision(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/jobs/%d/revisions/diff?%s", BaseUrl, job.ID, tc.query)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestGetJobRevisionOfJobApplicationAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	job := generateRandomJob()
+	revision := generateRandomJobRevision(job, employer.ID, 3)
+	jobApplication := db.GetJobApplicationForUserRow{
+		ApplicationID:     utils.RandomInt(1, 1000),
+		JobID:             job.ID,
+		JobTitle:          job.Title,
+		ApplicationStatus: db.ApplicationStatusApplied,
+		UserID:            user.ID,
+		JobRevisionID:     sql.NullInt32{Int32: revision.ID, Valid: true},
+		JobRevision:       sql.NullInt32{Int32: revision.Revision, Valid: true},
+	}
+	notPinnedJobApplication := jobApplication
+	notPinnedJobApplication.JobRevisionID = sql.NullInt32{}
+	notPinnedJobApplication.JobRevision = sql.NullInt32{}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobApplicationForUser(gomock.Any(), gomock.Eq(jobApplication.ApplicationID)).
+					Times(1).
+					Return(jobApplication, nil)
+				store.EXPECT().
+					GetJobRevisionByID(gomock.Any(), gomock.Eq(revision.ID)).
+					Times(1).
+					Return(revision, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobRevision(t, recorder.Body, revision)
+			},
+		},
+		{
+			name: "Not Owner",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID+1, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobApplicationForUser(gomock.Any(), gomock.Eq(jobApplication.ApplicationID)).
+					Times(1).
+					Return(jobApplication, nil)
+				store.EXPECT().
+					GetJobRevisionByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Not Pinned To Revision",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobApplicationForUser(gomock.Any(), gomock.Eq(jobApplication.ApplicationID)).
+					Times(1).
+					Return(notPinnedJobApplication, nil)
+				store.EXPECT().
+					GetJobRevisionByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Job Application Not Found",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobApplicationForUser(gomock.Any(), gomock.Eq(jobApplication.ApplicationID)).
+					Times(1).
+					Return(db.GetJobApplicationForUserRow{}, sql.ErrNoRows)
+				store.EXPECT().
+					GetJobRevisionByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobApplicationForUser(gomock.Any(), gomock.Eq(jobApplication.ApplicationID)).
+					Times(1).
+					Return(jobApplication, nil)
+				store.EXPECT().
+					GetJobRevisionByID(gomock.Any(), gomock.Eq(revision.ID)).
+					Times(1).
+					Return(db.JobRevision{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Unauthorized Employer",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobApplicationForUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/job-applications/user/%d/job-revision", BaseUrl, jobApplication.ApplicationID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func generateRandomJobRevision(job db.Job, authorID, revision int32) db.JobRevision {
+	return db.JobRevision{
+		ID:             utils.RandomInt(1, 1000),
+		JobID:          job.ID,
+		Revision:       revision,
+		AuthorID:       sql.NullInt32{Int32: authorID, Valid: true},
+		Title:          job.Title,
+		Industry:       job.Industry,
+		Description:    job.Description,
+		Location:       job.Location,
+		SalaryMin:      job.SalaryMin,
+		SalaryMax:      job.SalaryMax,
+		SalaryCurrency: job.SalaryCurrency,
+		SalaryPeriod:   job.SalaryPeriod,
+		Requirements:   job.Requirements,
+		RequiredSkills: []string{utils.RandomString(5), utils.RandomString(6)},
+		EmploymentType: job.EmploymentType,
+		WorkMode:       job.WorkMode,
+		SeniorityLevel: job.SeniorityLevel,
+		CreatedAt:      time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func requireBodyMatchJobRevision(t *testing.T, body *bytes.Buffer, revision db.JobRevision) jobRevisionResponse {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got jobRevisionResponse
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, revision.ID, got.ID)
+	require.Equal(t, revision.JobID, got.JobID)
+	require.Equal(t, revision.Revision, got.Revision)
+	require.Equal(t, revision.Title, got.Title)
+	require.Equal(t, revision.Description, got.Description)
+	require.Equal(t, revision.SalaryMin, got.SalaryMin)
+	require.Equal(t, revision.SalaryMax, got.SalaryMax)
+	require.Equal(t, revision.RequiredSkills, got.RequiredSkills)
+	require.WithinDuration(t, revision.CreatedAt, got.CreatedAt, time.Second)
+
+	return got
+}
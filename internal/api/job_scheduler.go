@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	zerolog "github.com/rs/zerolog/log"
+	"time"
+)
+
+const (
+	// the scheduler runs every minute if the interval is not configured
+	defaultJobSchedulerInterval = time.Minute
+	// employers are notified this long before their job expires
+	jobExpiryNotificationBefore = 3 * 24 * time.Hour
+)
+
+// StartJobScheduler starts publishing scheduled drafts, expiring jobs and notifying
+// employers about jobs that expire soon in the background, until the context is done
+func (server *Server) StartJobScheduler(ctx context.Context) {
+	interval := server.config.JobSchedulerInterval
+	if interval <= 0 {
+		interval = defaultJobSchedulerInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			server.runJobScheduler(ctx, time.Now())
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// runJobScheduler runs all tasks of the scheduler once, the jobs are claimed by the
+// updates in the db, so instances of the app running the scheduler do not handle them twice
+func (server *Server) runJobScheduler(ctx context.Context, now time.Time) {
+	if err := server.publishScheduledJobs(ctx, now); err != nil {
+		zerolog.Error().Err(err).Msg("cannot publish scheduled jobs")
+	}
+
+	if err := server.expireJobs(ctx, now); err != nil {
+		zerolog.Error().Err(err).Msg("cannot expire jobs")
+	}
+
+	if err := server.notifyAboutExpiringJobs(ctx, now); err != nil {
+		zerolog.Error().Err(err).Msg("cannot notify about expiring jobs")
+	}
+}
+
+// publishScheduledJobs publishes the drafts with publish_at in the past
+// and adds them to the elasticsearch index
+func (server *Server) publishScheduledJobs(ctx context.Context, now time.Time) error {
+	jobs, err := server.store.PublishScheduledJobs(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		if !isJobIndexed(job) {
+			continue
+		}
+
+		// the job is already published, a failure of the index must not stop the other jobs
+		if err = server.indexJob(ctx, job); err != nil {
+			zerolog.Error().Err(err).Int32("job_id", job.ID).Msg("cannot index published job")
+		}
+	}
+
+	return nil
+}
+
+// expireJobs changes the status of the published jobs with expires_at in the past
+// to expired and removes them from the elasticsearch index
+func (server *Server) expireJobs(ctx context.Context, now time.Time) error {
+	jobs, err := server.store.ExpireJobs(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		// jobs unpublished by an admin were already removed
+		if job.UnpublishedAt.Valid {
+			continue
+		}
+
+		if err = server.removeJobFromSearch(job.ID); err != nil {
+			zerolog.Error().Err(err).Int32("job_id", job.ID).Msg("cannot remove expired job from search")
+		}
+	}
+
+	return nil
+}
+
+// notifyAboutExpiringJobs sends an email to the employers that can manage the jobs
+// expiring within jobExpiryNotificationBefore, every expiration is notified once
+func (server *Server) notifyAboutExpiringJobs(ctx context.Context, now time.Time) error {
+	jobs, err := server.store.MarkJobsExpiryNotified(ctx, db.MarkJobsExpiryNotifiedParams{
+		Now:          now,
+		NotifyBefore: now.Add(jobExpiryNotificationBefore),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		if err = server.sendJobExpiryEmail(ctx, job); err != nil {
+			zerolog.Error().Err(err).Int32("job_id", job.ID).Msg("cannot notify about expiring job")
+		}
+	}
+
+	return nil
+}
+
+// sendJobExpiryEmail sends the email about the expiring job
+// to the employers of its company that can manage jobs
+func (server *Server) sendJobExpiryEmail(ctx context.Context, job db.Job) error {
+	employers, err := server.store.ListCompanyEmployers(ctx, job.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	var to []string
+	for _, employer := range employers {
+		if canManageJobs(employer.Role) && !employer.SuspendedAt.Valid {
+			to = append(to, employer.Email)
+		}
+	}
+	if len(to) == 0 {
+		return nil
+	}
+
+	subject := fmt.Sprintf("Job %s expires soon", job.Title)
+	content := fmt.Sprintf(`Hello,<br/>
+	the job <b>%s</b> (ID %d) expires on %s UTC.<br/>
+	After that it is no longer listed and does not accept applications.<br/>
+	Once it has expired, you can reopen it with a new expiration.<br/>
+	`, job.Title, job.ID, job.ExpiresAt.Time.UTC().Format("2006-01-02 15:04"))
+
+	err = server.emailSender.SendEmail(subject, content, to)
+	if err != nil {
+		return fmt.Errorf("cannot send job expiry email: %w", err)
+	}
+
+	return nil
+}
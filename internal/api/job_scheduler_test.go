@@ -0,0 +1,385 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/internal/esearch"
+	mockesearch "github.com/grannnsacker/job-finder-back/internal/esearch/mock"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeEmailSender records the sent emails instead of sending them
+type fakeEmailSender struct {
+	subjects   []string
+	recipients [][]string
+}
+
+func (sender *fakeEmailSender) SendEmail(subject string, content string, to []string) error {
+	sender.subjects = append(sender.subjects, subject)
+	sender.recipients = append(sender.recipients, to)
+	return nil
+}
+
+func TestRunJobScheduler(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	owner, _, company := generateRandomEmployerAndCompany(t)
+	viewer, _, _ := generateRandomEmployerAndCompany(t)
+	viewer.Role = db.EmployerRoleViewer
+	suspendedRecruiter, _, _ := generateRandomEmployerAndCompany(t)
+	suspendedRecruiter.Role = db.EmployerRoleRecruiter
+	suspendedRecruiter.SuspendedAt = sql.NullTime{Time: now, Valid: true}
+
+	publishedJob := generateRandomJob()
+	publishedJob.CompanyID = company.ID
+	otherPublishedJob := generateRandomJob()
+	otherPublishedJob.CompanyID = company.ID
+
+	expiredJob := generateRandomJob()
+	expiredJob.Status = db.JobStatusExpired
+	expiredJob.ExpiresAt = sql.NullTime{Time: now.Add(-time.Minute), Valid: true}
+	unpublishedExpiredJob := expiredJob
+	unpublishedExpiredJob.ID = expiredJob.ID + 1
+	unpublishedExpiredJob.UnpublishedAt = sql.NullTime{Time: now.Add(-time.Hour), Valid: true}
+
+	expiringJob := generateRandomJob()
+	expiringJob.CompanyID = company.ID
+	expiringJob.ExpiresAt = sql.NullTime{Time: now.Add(48 * time.Hour), Valid: true}
+	expiringJob.ExpiryNotifiedAt = sql.NullTime{Time: now, Valid: true}
+
+	notifyParams := db.MarkJobsExpiryNotifiedParams{
+		Now:          now,
+		NotifyBefore: now.Add(jobExpiryNotificationBefore),
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkSent  func(sender *fakeEmailSender)
+	}{
+		{
+			name: "Publish Scheduled Jobs",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					PublishScheduledJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{publishedJob}, nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(publishedJob.ID)).
+					Times(1).
+					Return([]string{utils.RandomString(4)}, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Eq(int(publishedJob.ID)), gomock.Any()).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					ExpireJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{}, nil)
+				store.EXPECT().
+					MarkJobsExpiryNotified(gomock.Any(), gomock.Eq(notifyParams)).
+					Times(1).
+					Return([]db.Job{}, nil)
+			},
+			checkSent: func(sender *fakeEmailSender) {
+				require.Empty(t, sender.subjects)
+			},
+		},
+		{
+			name: "Index Error Does Not Stop Other Jobs",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					PublishScheduledJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{publishedJob, otherPublishedJob}, nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(2).
+					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Any()).
+					Times(2).
+					Return([]string{}, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Eq(int(publishedJob.ID)), gomock.Any()).
+					Times(1).
+					Return(errors.New("index error"))
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Eq(int(otherPublishedJob.ID)), gomock.Any()).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					ExpireJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{}, nil)
+				store.EXPECT().
+					MarkJobsExpiryNotified(gomock.Any(), gomock.Eq(notifyParams)).
+					Times(1).
+					Return([]db.Job{}, nil)
+			},
+			checkSent: func(sender *fakeEmailSender) {
+				require.Empty(t, sender.subjects)
+			},
+		},
+		{
+			name: "Expire Jobs",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					PublishScheduledJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{}, nil)
+				store.EXPECT().
+					ExpireJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{expiredJob, unpublishedExpiredJob}, nil)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Eq(strconv.Itoa(int(expiredJob.ID)))).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					MarkJobsExpiryNotified(gomock.Any(), gomock.Eq(notifyParams)).
+					Times(1).
+					Return([]db.Job{}, nil)
+			},
+			checkSent: func(sender *fakeEmailSender) {
+				require.Empty(t, sender.subjects)
+			},
+		},
+		{
+			name: "Notify About Expiring Jobs",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					PublishScheduledJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{}, nil)
+				store.EXPECT().
+					ExpireJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{}, nil)
+				store.EXPECT().
+					MarkJobsExpiryNotified(gomock.Any(), gomock.Eq(notifyParams)).
+					Times(1).
+					Return([]db.Job{expiringJob}, nil)
+				store.EXPECT().
+					ListCompanyEmployers(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return([]db.Employer{owner, viewer, suspendedRecruiter}, nil)
+			},
+			checkSent: func(sender *fakeEmailSender) {
+				require.Len(t, sender.subjects, 1)
+				require.Contains(t, sender.subjects[0], expiringJob.Title)
+				require.Equal(t, []string{owner.Email}, sender.recipients[0])
+			},
+		},
+		{
+			name: "Query Error Does Not Stop Other Tasks",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					PublishScheduledJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{}, sql.ErrConnDone)
+				store.EXPECT().
+					ExpireJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{}, sql.ErrConnDone)
+				store.EXPECT().
+					MarkJobsExpiryNotified(gomock.Any(), gomock.Eq(notifyParams)).
+					Times(1).
+					Return([]db.Job{}, sql.ErrConnDone)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkSent: func(sender *fakeEmailSender) {
+				require.Empty(t, sender.subjects)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			sender := &fakeEmailSender{}
+			server.emailSender = sender
+
+			server.runJobScheduler(context.Background(), now)
+
+			tc.checkSent(sender)
+		})
+	}
+}
+
+// fakeSearchClient keeps the indexed documents in memory by their IDs
+type fakeSearchClient struct {
+	esearch.ESearchClient
+	documents map[string]esearch.Job
+}
+
+func (client *fakeSearchClient) IndexJobAsDocument(documentID int, job esearch.Job) error {
+	client.documents[strconv.Itoa(documentID)] = job
+	return nil
+}
+
+func (client *fakeSearchClient) DeleteJobDocument(documentID string) error {
+	delete(client.documents, documentID)
+	return nil
+}
+
+func TestEditedJobIsRemovedFromSearch(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	employer, _, company := generateRandomEmployerAndCompany(t)
+
+	job := generateRandomJob()
+	job.CompanyID = company.ID
+	job.ExpiresAt = sql.NullTime{Time: now.Add(time.Hour), Valid: true}
+	skills := []string{"go", "sql"}
+
+	editedJob := job
+	editedJob.Title = utils.RandomString(8)
+	expiredJob := editedJob
+	expiredJob.Status = db.JobStatusExpired
+	closedJob := editedJob
+	closedJob.Status = db.JobStatusClosed
+	closedJob.ExpiresAt = sql.NullTime{}
+
+	documentID := strconv.Itoa(int(job.ID))
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		remove     func(t *testing.T, server *Server)
+	}{
+		{
+			name: "Expire",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					PublishScheduledJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{}, nil)
+				store.EXPECT().
+					ExpireJobs(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return([]db.Job{expiredJob}, nil)
+				store.EXPECT().
+					MarkJobsExpiryNotified(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Job{}, nil)
+			},
+			remove: func(t *testing.T, server *Server) {
+				server.runJobScheduler(context.Background(), now)
+			},
+		},
+		{
+			name: "Close",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(editedJob, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(closedJob, nil)
+			},
+			remove: func(t *testing.T, server *Server) {
+				recorder := httptest.NewRecorder()
+				url := fmt.Sprintf("%s/jobs/%d/close", BaseUrl, job.ID)
+				req, err := http.NewRequest(http.MethodPost, url, nil)
+				require.NoError(t, err)
+				addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+				server.router.ServeHTTP(recorder, req)
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := &fakeSearchClient{
+				documents: map[string]esearch.Job{documentID: newESJob(job, company.Name, skills)},
+			}
+			server := newTestServer(t, store, client)
+
+			// the job is edited first
+			store.EXPECT().
+				GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+				Times(1).
+				Return(employer, nil)
+			store.EXPECT().
+				GetJob(gomock.Any(), gomock.Eq(job.ID)).
+				Times(1).
+				Return(job, nil)
+			store.EXPECT().
+				UpdateJobTx(gomock.Any(), gomock.Any()).
+				Times(1).
+				Return(db.UpdateJobTxResult{Job: editedJob}, nil)
+			store.EXPECT().
+				ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
+				Times(1).
+				Return([]db.ListJobSkillsByJobIDRow{}, nil)
+			store.EXPECT().
+				GetCompanyNameByID(gomock.Any(), gomock.Eq(company.ID)).
+				Times(1).
+				Return(company.Name, nil)
+			store.EXPECT().
+				ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+				Times(1).
+				Return(skills, nil)
+
+			data, err := json.Marshal(gin.H{"title": editedJob.Title})
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("%s/jobs/%d", BaseUrl, job.ID)
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			// the edited document keeps the ID and the company name of the job
+			require.Equal(t, newESJob(editedJob, company.Name, skills), client.documents[documentID])
+
+			// then it is removed from the search
+			tc.buildStubs(store)
+			tc.remove(t, server)
+			require.NotContains(t, client.documents, documentID)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/internal/esearch"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"net/http"
+	"time"
+)
+
+var (
+	expiresAtInPastError          = errors.New("expires_at must be in the future")
+	publishAtInPastError          = errors.New("publish_at must be in the future")
+	expiresAtBeforePublishAtError = errors.New("expires_at must be after publish_at")
+	publishAtNotDraftError        = errors.New("publish_at can only be set for drafts")
+	jobUnpublishedByAdminError    = errors.New("job has been unpublished by an admin and cannot be published")
+	jobNotOpenError               = errors.New("job is not accepting applications")
+)
+
+// jobStatusTransitionError return the job cannot change its status error
+func jobStatusTransitionError(status db.JobStatus, action string) error {
+	return fmt.Errorf("job with status %s cannot be %s", status, action)
+}
+
+// validateSchedule checks that the job is scheduled to be published in the future
+// and does not expire before it is published
+func validateSchedule(publishAt *time.Time, expiresAt *time.Time) error {
+	if publishAt == nil {
+		return nil
+	}
+
+	if !publishAt.After(time.Now()) {
+		return publishAtInPastError
+	}
+
+	if expiresAt != nil && !expiresAt.After(*publishAt) {
+		return expiresAtBeforePublishAtError
+	}
+
+	return nil
+}
+
+// effectiveJobStatus returns the status of the job,
+// a published job is expired as soon as its expires_at has passed
+func effectiveJobStatus(job db.Job) db.JobStatus {
+	if job.Status == db.JobStatusPublished && job.ExpiresAt.Valid && !job.ExpiresAt.Time.After(time.Now()) {
+		return db.JobStatusExpired
+	}
+	return job.Status
+}
+
+// isJobIndexed checks if the job has a document in the elasticsearch index,
+// drafts, closed and deleted jobs and jobs unpublished by an admin are not indexed
+func isJobIndexed(job db.Job) bool {
+	return job.Status == db.JobStatusPublished && !job.UnpublishedAt.Valid && !job.DeletedAt.Valid
+}
+
+// indexJob adds the job with its skills to the elasticsearch index
+func (server *Server) indexJob(ctx context.Context, job db.Job) error {
+	companyName, err := server.store.GetCompanyNameByID(ctx, job.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	skills, err := server.store.ListAllJobSkillsByJobID(ctx, job.ID)
+	if err != nil {
+		return err
+	}
+
+	return server.esDetails.client.IndexJobAsDocument(int(job.ID), newESJob(job, companyName, skills))
+}
+
+// newESJob creates the document of the job in the elasticsearch index
+func newESJob(job db.Job, companyName string, skills []string) esearch.Job {
+	return esearch.Job{
+		ID:             job.ID,
+		Title:          job.Title,
+		Industry:       job.Industry,
+		CompanyName:    companyName,
+		Description:    job.Description,
+		Location:       job.Location,
+		SalaryMin:      job.SalaryMin,
+		SalaryMax:      job.SalaryMax,
+		SalaryCurrency: job.SalaryCurrency,
+		SalaryPeriod:   string(job.SalaryPeriod),
+		Requirements:   job.Requirements,
+		JobSkills:      skills,
+		EmploymentType: string(job.EmploymentType),
+		WorkMode:       string(job.WorkMode),
+		SeniorityLevel: string(job.SeniorityLevel),
+		Status:         string(job.Status),
+		ExpiresAt:      nullTimePointer(job.ExpiresAt),
+	}
+}
+
+// getJobToManage gets the job and checks if the authenticated employer
+// can manage it, if not, the request is aborted
+func (server *Server) getJobToManage(ctx *gin.Context, jobID int32, action string) (db.Job, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return db.Job{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Job{}, false
+	}
+
+	job, err := server.store.GetJob(ctx, jobID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return db.Job{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Job{}, false
+	}
+
+	if job.CompanyID != authEmployer.CompanyID {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(jobOwnershipError))
+		return db.Job{}, false
+	}
+
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, action)))
+		return db.Job{}, false
+	}
+
+	return job, true
+}
+
+type jobStatusUriRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+type publishJobRequest struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+	// a draft with publish_at stays a draft until the scheduler publishes it at that time
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+type jobStatusResponse struct {
+	ID        int32        `json:"id"`
+	Status    db.JobStatus `json:"status"`
+	ExpiresAt *time.Time   `json:"expires_at"`
+	PublishAt *time.Time   `json:"publish_at"`
+}
+
+// newJobStatusResponse converts db.Job to jobStatusResponse
+func newJobStatusResponse(job db.Job) jobStatusResponse {
+	return jobStatusResponse{
+		ID:        job.ID,
+		Status:    effectiveJobStatus(job),
+		ExpiresAt: nullTimePointer(job.ExpiresAt),
+		PublishAt: nullTimePointer(job.PublishAt),
+	}
+}
+
+// @Schemes
+// @Summary Publish job
+// @Description Publish a draft job, so it is listed, searchable and accepts applications. The job stops being listed after expires_at, it does not expire if expires_at is omitted. With publish_at the job stays a draft and it is published at that time.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @param id path integer true "Job ID"
+// @param PublishJobRequest body publishJobRequest false "Schedule and expiration of the job"
+// @Success 200 {object} jobStatusResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID, expires_at or publish_at is in the past or expires_at is before publish_at"
+// @Failure 401 {object} ErrorResponse "User making the request not an employer or employer not the owner of the job"
+// @Failure 403 {object} ErrorResponse "Role of the employer does not allow publishing jobs or the job was unpublished by an admin"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Failure 409 {object} ErrorResponse "Job is not a draft"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/publish [post]
+// publishJob handles publishing a draft job
+func (server *Server) publishJob(ctx *gin.Context) {
+	server.changeJobStatus(ctx, db.JobStatusPublished, "published", []db.JobStatus{db.JobStatusDraft})
+}
+
+// @Schemes
+// @Summary Reopen job
+// @Description Publish a closed or expired job again. The job stops being listed after expires_at, it does not expire if expires_at is omitted.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @param id path integer true "Job ID"
+// @param PublishJobRequest body publishJobRequest false "Expiration of the job"
+// @Success 200 {object} jobStatusResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID, expires_at is in the past or publish_at is set, only drafts can be scheduled"
+// @Failure 401 {object} ErrorResponse "User making the request not an employer or employer not the owner of the job"
+// @Failure 403 {object} ErrorResponse "Role of the employer does not allow publishing jobs or the job was unpublished by an admin"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Failure 409 {object} ErrorResponse "Job is not closed or expired"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/reopen [post]
+// reopenJob handles publishing a closed or expired job again
+func (server *Server) reopenJob(ctx *gin.Context) {
+	server.changeJobStatus(ctx, db.JobStatusPublished, "reopened", []db.JobStatus{db.JobStatusClosed, db.JobStatusExpired})
+}
+
+// @Schemes
+// @Summary Close job
+// @Description Close a published job, it is no longer listed and does not accept applications. It can be published again with POST /jobs/{id}/reopen.
+// @Tags jobs
+// @Produce json
+// @param id path integer true "Job ID"
+// @Success 200 {object} jobStatusResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID"
+// @Failure 401 {object} ErrorResponse "User making the request not an employer or employer not the owner of the job"
+// @Failure 403 {object} ErrorResponse "Role of the employer does not allow closing jobs"
+// @Failure 404 {object} ErrorResponse "Job not found"
+// @Failure 409 {object} ErrorResponse "Job is not published or expired"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/close [post]
+// closeJob handles closing a published job
+func (server *Server) closeJob(ctx *gin.Context) {
+	server.changeJobStatus(ctx, db.JobStatusClosed, "closed", []db.JobStatus{db.JobStatusPublished, db.JobStatusExpired})
+}
+
+// changeJobStatus moves the job from one of the allowed statuses to the new status
+// and keeps the elasticsearch index in sync
+func (server *Server) changeJobStatus(ctx *gin.Context, status db.JobStatus, action string, from []db.JobStatus) {
+	var uriRequest jobStatusUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// the body is optional, without expires_at the published job does not expire
+	var request publishJobRequest
+	if status == db.JobStatusPublished && ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(expiresAtInPastError))
+			return
+		}
+
+		if err := validateSchedule(request.PublishAt, request.ExpiresAt); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+
+	job, ok := server.getJobToManage(ctx, uriRequest.ID, "change status of jobs")
+	if !ok {
+		return
+	}
+
+	current := effectiveJobStatus(job)
+	allowed := false
+	for _, s := range from {
+		if current == s {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		ctx.JSON(http.StatusConflict, errorResponse(jobStatusTransitionError(current, action)))
+		return
+	}
+
+	// closed and expired jobs are reopened right away
+	if request.PublishAt != nil && current != db.JobStatusDraft {
+		ctx.JSON(http.StatusBadRequest, errorResponse(publishAtNotDraftError))
+		return
+	}
+
+	if status == db.JobStatusPublished && job.UnpublishedAt.Valid {
+		ctx.JSON(http.StatusForbidden, errorResponse(jobUnpublishedByAdminError))
+		return
+	}
+
+	params := db.UpdateJobStatusParams{
+		ID:        job.ID,
+		Status:    status,
+		ExpiresAt: job.ExpiresAt,
+	}
+	if status == db.JobStatusPublished {
+		params.ExpiresAt = sql.NullTime{}
+		if request.ExpiresAt != nil {
+			params.ExpiresAt = sql.NullTime{Time: *request.ExpiresAt, Valid: true}
+		}
+		if request.PublishAt != nil {
+			params.Status = db.JobStatusDraft
+			params.PublishAt = sql.NullTime{Time: *request.PublishAt, Valid: true}
+		}
+	}
+
+	updatedJob, err := server.store.UpdateJobStatus(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// an expired job is still in the index, it is replaced with the new expiration
+	if isJobIndexed(job) {
+		err = server.removeJobFromSearch(job.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	if isJobIndexed(updatedJob) {
+		err = server.indexJob(ctx, updatedJob)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, newJobStatusResponse(updatedJob))
+}
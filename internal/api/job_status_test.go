@@ -0,0 +1,638 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/internal/esearch"
+	mockesearch "github.com/grannnsacker/job-finder-back/internal/esearch/mock"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPublishJobAPI(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
+
+	job := generateRandomJob()
+	job.CompanyID = company.ID
+	job.Status = db.JobStatusDraft
+
+	unpublishedJob := job
+	unpublishedJob.UnpublishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	publishedJob := job
+	publishedJob.Status = db.JobStatusPublished
+	publishedJob.ExpiresAt = sql.NullTime{Time: expiresAt, Valid: true}
+
+	publishAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	scheduledJob := job
+	scheduledJob.ExpiresAt = sql.NullTime{Time: expiresAt, Valid: true}
+	scheduledJob.PublishAt = sql.NullTime{Time: publishAt, Valid: true}
+
+	skills := []string{utils.RandomString(4)}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"expires_at": expiresAt},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Eq(db.UpdateJobStatusParams{
+						ID:        job.ID,
+						Status:    db.JobStatusPublished,
+						ExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+					})).
+					Times(1).
+					Return(publishedJob, nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(skills, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Eq(int(job.ID)), gomock.Eq(esearch.Job{
+						ID:             job.ID,
+						Title:          job.Title,
+						Industry:       job.Industry,
+						CompanyName:    company.Name,
+						Description:    job.Description,
+						Location:       job.Location,
+						SalaryMin:      job.SalaryMin,
+						SalaryMax:      job.SalaryMax,
+						SalaryCurrency: job.SalaryCurrency,
+						SalaryPeriod:   string(job.SalaryPeriod),
+						Requirements:   job.Requirements,
+						JobSkills:      skills,
+						EmploymentType: string(job.EmploymentType),
+						WorkMode:       string(job.WorkMode),
+						SeniorityLevel: string(job.SeniorityLevel),
+						Status:         string(db.JobStatusPublished),
+						ExpiresAt:      &publishedJob.ExpiresAt.Time,
+					})).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res jobStatusResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, job.ID, res.ID)
+				require.Equal(t, db.JobStatusPublished, res.Status)
+				require.NotNil(t, res.ExpiresAt)
+				require.WithinDuration(t, expiresAt, *res.ExpiresAt, time.Second)
+			},
+		},
+		{
+			name: "Scheduled",
+			body: gin.H{"publish_at": publishAt, "expires_at": expiresAt},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Eq(db.UpdateJobStatusParams{
+						ID:        job.ID,
+						Status:    db.JobStatusDraft,
+						ExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+						PublishAt: sql.NullTime{Time: publishAt, Valid: true},
+					})).
+					Times(1).
+					Return(scheduledJob, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res jobStatusResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, db.JobStatusDraft, res.Status)
+				require.NotNil(t, res.PublishAt)
+				require.WithinDuration(t, publishAt, *res.PublishAt, time.Second)
+			},
+		},
+		{
+			name: "Publish At In Past",
+			body: gin.H{"publish_at": time.Now().Add(-time.Hour)},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Expires Before Publish At",
+			body: gin.H{"publish_at": expiresAt.Add(time.Hour), "expires_at": expiresAt},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Not Draft",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(publishedJob, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Unpublished By Admin",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(unpublishedJob, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Expires At In The Past",
+			body: gin.H{"expires_at": time.Now().Add(-time.Hour)},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Viewer",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, viewer.Email, token.RoleEmployer, viewer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(viewer.ID)).
+					Times(1).
+					Return(viewer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Job Of Another Company",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				otherJob := job
+				otherJob.CompanyID = company.ID + 1
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(otherJob, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Job Not Found",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(db.Job{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "User",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, employer.Email, token.RoleUser, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/jobs/%d/publish", BaseUrl, job.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCloseJobAPI(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+
+	job := generateRandomJob()
+	job.CompanyID = company.ID
+
+	closedJob := job
+	closedJob.Status = db.JobStatusClosed
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Eq(db.UpdateJobStatusParams{
+						ID:     job.ID,
+						Status: db.JobStatusClosed,
+					})).
+					Times(1).
+					Return(closedJob, nil)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Eq(strconv.Itoa(int(job.ID)))).
+					Times(1).
+					Return(nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res jobStatusResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, db.JobStatusClosed, res.Status)
+				require.Nil(t, res.ExpiresAt)
+			},
+		},
+		{
+			name: "Already Closed",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(closedJob, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error DeleteJobDocument",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(closedJob, nil)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Eq(strconv.Itoa(int(job.ID)))).
+					Times(1).
+					Return(fmt.Errorf("elasticsearch error"))
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/jobs/%d/close", BaseUrl, job.ID)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestReopenJobAPI(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+
+	job := generateRandomJob()
+	job.CompanyID = company.ID
+
+	closedJob := job
+	closedJob.Status = db.JobStatusClosed
+
+	expiredJob := job
+	expiredJob.ExpiresAt = sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK Closed",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(closedJob, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Eq(db.UpdateJobStatusParams{
+						ID:     job.ID,
+						Status: db.JobStatusPublished,
+					})).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return([]string{}, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Eq(int(job.ID)), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res jobStatusResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, db.JobStatusPublished, res.Status)
+				require.Nil(t, res.ExpiresAt)
+			},
+		},
+		{
+			name: "OK Expired",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(expiredJob, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Eq(db.UpdateJobStatusParams{
+						ID:     job.ID,
+						Status: db.JobStatusPublished,
+					})).
+					Times(1).
+					Return(job, nil)
+				// the expired job is still in the index, its document is replaced
+				client.EXPECT().
+					DeleteJobDocument(gomock.Eq(strconv.Itoa(int(job.ID)))).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return([]string{}, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Eq(int(job.ID)), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Published",
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					UpdateJobStatus(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/jobs/%d/reopen", BaseUrl, job.ID)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
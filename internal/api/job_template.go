@@ -0,0 +1,381 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/lib/pq"
+	"net/http"
+)
+
+var (
+	jobTemplateNotFoundError  = errors.New("job template does not exist")
+	jobTemplateOwnershipError = errors.New("job template does not belong to the company of this employer")
+	jobTemplateExistsError    = errors.New("job template with this name already exists in the company")
+)
+
+// getJobTemplateOfEmployer gets the template and checks that it belongs to the company
+// of the authenticated employer, if not, the request is aborted
+func (server *Server) getJobTemplateOfEmployer(ctx *gin.Context, templateID int32) (db.Employer, db.JobTemplate, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return db.Employer{}, db.JobTemplate{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Employer{}, db.JobTemplate{}, false
+	}
+
+	template, err := server.store.GetJobTemplate(ctx, templateID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(jobTemplateNotFoundError))
+			return db.Employer{}, db.JobTemplate{}, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Employer{}, db.JobTemplate{}, false
+	}
+
+	if template.CompanyID != authEmployer.CompanyID {
+		ctx.JSON(http.StatusForbidden, errorResponse(jobTemplateOwnershipError))
+		return db.Employer{}, db.JobTemplate{}, false
+	}
+
+	return authEmployer, template, true
+}
+
+// isJobTemplateExistsError checks if the error is caused by a template with the same name
+func isJobTemplateExistsError(err error) bool {
+	if pqErr, ok := err.(*pq.Error); ok {
+		return pqErr.Code.Name() == "unique_violation"
+	}
+	return false
+}
+
+type jobTemplateUriRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+type createJobTemplateRequest struct {
+	Name           string   `json:"name" binding:"required,max=100"`
+	Description    string   `json:"description" binding:"required"`
+	Requirements   string   `json:"requirements" binding:"required"`
+	RequiredSkills []string `json:"required_skills" binding:"required"`
+}
+
+// @Schemes
+// @Summary Create job template
+// @Description Create a template of a job of the company of the authenticated employer. The template carries the description, the requirements and the required skills, jobs can be created from it with POST /job-templates/{id}/jobs.
+// @Tags job templates
+// @Accept json
+// @Produce json
+// @param CreateJobTemplateRequest body createJobTemplateRequest true "Template details"
+// @Success 201 {object} db.JobTemplate
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Role of the employer does not allow managing job templates or a template with the name already exists"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /job-templates [post]
+// createJobTemplate handles creating a job template of the company
+func (server *Server) createJobTemplate(ctx *gin.Context) {
+	var request createJobTemplateRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "manage job templates")))
+		return
+	}
+
+	template, err := server.store.CreateJobTemplate(ctx, db.CreateJobTemplateParams{
+		CompanyID:      authEmployer.CompanyID,
+		Name:           request.Name,
+		Description:    request.Description,
+		Requirements:   request.Requirements,
+		RequiredSkills: uniqueSkills(request.RequiredSkills),
+	})
+	if err != nil {
+		if isJobTemplateExistsError(err) {
+			ctx.JSON(http.StatusForbidden, errorResponse(jobTemplateExistsError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, template)
+}
+
+// @Schemes
+// @Summary List job templates
+// @Description List the job templates of the company of the authenticated employer, sorted by name.
+// @Tags job templates
+// @Produce json
+// @Success 200 {array} db.JobTemplate
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /job-templates [get]
+// listJobTemplates handles listing the job templates of the company
+func (server *Server) listJobTemplates(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	templates, err := server.store.ListJobTemplates(ctx, authEmployer.CompanyID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, templates)
+}
+
+// @Schemes
+// @Summary Get job template
+// @Description Get the job template with the given id of the company of the authenticated employer.
+// @Tags job templates
+// @Produce json
+// @param id path integer true "Template ID"
+// @Success 200 {object} db.JobTemplate
+// @Failure 400 {object} ErrorResponse "Invalid template ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Template belongs to another company"
+// @Failure 404 {object} ErrorResponse "Template not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /job-templates/{id} [get]
+// getJobTemplate handles getting a job template of the company
+func (server *Server) getJobTemplate(ctx *gin.Context) {
+	var request jobTemplateUriRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, template, ok := server.getJobTemplateOfEmployer(ctx, request.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, template)
+}
+
+type updateJobTemplateRequest struct {
+	// the fields that are omitted are not changed
+	Name         string `json:"name" binding:"max=100"`
+	Description  string `json:"description"`
+	Requirements string `json:"requirements"`
+	// the skills replace the skills of the template
+	RequiredSkills []string `json:"required_skills"`
+}
+
+// @Schemes
+// @Summary Update job template
+// @Description Update the job template with the given id, the fields that are omitted are not changed. Jobs that were created from the template are not changed.
+// @Tags job templates
+// @Accept json
+// @Produce json
+// @param id path integer true "Template ID"
+// @param UpdateJobTemplateRequest body updateJobTemplateRequest true "Template details to update"
+// @Success 200 {object} db.JobTemplate
+// @Failure 400 {object} ErrorResponse "Invalid template ID or request body"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Template belongs to another company, role of the employer does not allow managing job templates or a template with the name already exists"
+// @Failure 404 {object} ErrorResponse "Template not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /job-templates/{id} [patch]
+// updateJobTemplate handles updating a job template of the company
+func (server *Server) updateJobTemplate(ctx *gin.Context) {
+	var uriRequest jobTemplateUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var request updateJobTemplateRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authEmployer, template, ok := server.getJobTemplateOfEmployer(ctx, uriRequest.ID)
+	if !ok {
+		return
+	}
+
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "manage job templates")))
+		return
+	}
+
+	params := db.UpdateJobTemplateParams{
+		ID:             template.ID,
+		Name:           template.Name,
+		Description:    template.Description,
+		Requirements:   template.Requirements,
+		RequiredSkills: template.RequiredSkills,
+	}
+	if request.Name != "" {
+		params.Name = request.Name
+	}
+	if request.Description != "" {
+		params.Description = request.Description
+	}
+	if request.Requirements != "" {
+		params.Requirements = request.Requirements
+	}
+	if request.RequiredSkills != nil {
+		params.RequiredSkills = uniqueSkills(request.RequiredSkills)
+	}
+
+	template, err := server.store.UpdateJobTemplate(ctx, params)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(jobTemplateNotFoundError))
+			return
+		}
+		if isJobTemplateExistsError(err) {
+			ctx.JSON(http.StatusForbidden, errorResponse(jobTemplateExistsError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, template)
+}
+
+// @Schemes
+// @Summary Delete job template
+// @Description Delete the job template with the given id. Jobs that were created from the template are not changed.
+// @Tags job templates
+// @param id path integer true "Template ID"
+// @Success 204 {null} null
+// @Failure 400 {object} ErrorResponse "Invalid template ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Template belongs to another company or role of the employer does not allow managing job templates"
+// @Failure 404 {object} ErrorResponse "Template not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /job-templates/{id} [delete]
+// deleteJobTemplate handles deleting a job template of the company
+func (server *Server) deleteJobTemplate(ctx *gin.Context) {
+	var request jobTemplateUriRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authEmployer, template, ok := server.getJobTemplateOfEmployer(ctx, request.ID)
+	if !ok {
+		return
+	}
+
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "manage job templates")))
+		return
+	}
+
+	err := server.store.DeleteJobTemplate(ctx, template.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// @Schemes
+// @Summary Create job from template
+// @Description Create a new job from the job template with the given id. The body is the same as the body of POST /jobs, but description, requirements and required_skills are taken from the template if they are omitted.
+// @Tags job templates
+// @Accept json
+// @Produce json
+// @param id path integer true "Template ID"
+// @param CreateJobRequest body createJobRequest true "Job details, description, requirements and required_skills are optional"
+// @Success 201 {object} jobResponse
+// @Failure 400 {object} ErrorResponse "Invalid template ID or request body, expires_at is in the past or there is no exchange rate for the currency"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Template belongs to another company, email address has not been verified or the role of the employer does not allow creating jobs"
+// @Failure 404 {object} ErrorResponse "Template not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /job-templates/{id}/jobs [post]
+// createJobFromTemplate handles creating a job posting from a job template of the company
+func (server *Server) createJobFromTemplate(ctx *gin.Context) {
+	var uriRequest jobTemplateUriRequest
+	if err := ctx.ShouldBindUri(&uriRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authEmployer, template, ok := server.getJobTemplateOfEmployer(ctx, uriRequest.ID)
+	if !ok {
+		return
+	}
+
+	if !authEmployer.IsEmailVerified {
+		ctx.JSON(http.StatusForbidden, errorResponse(emailNotVerifiedError))
+		return
+	}
+
+	if !canManageJobs(authEmployer.Role) {
+		ctx.JSON(http.StatusForbidden, errorResponse(roleNotAllowedError(authEmployer.Role, "create jobs")))
+		return
+	}
+
+	// the fields of the template are replaced by the ones in the body,
+	// the required fields are validated after that
+	request := createJobRequest{
+		Description:    template.Description,
+		Requirements:   template.Requirements,
+		RequiredSkills: template.RequiredSkills,
+	}
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if err := request.validate(); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	request.setDefaults()
+	request.RequiredSkills = uniqueSkills(request.RequiredSkills)
+
+	server.createJobWithSkills(ctx, authEmployer, request)
+}
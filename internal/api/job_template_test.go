@@ -0,0 +1,878 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	mockesearch "github.com/grannnsacker/job-finder-back/internal/esearch/mock"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateJobTemplateAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
+	template := generateRandomJobTemplate(employer.CompanyID)
+
+	body := gin.H{
+		"name":            template.Name,
+		"description":     template.Description,
+		"requirements":    template.Requirements,
+		"required_skills": append(template.RequiredSkills, template.RequiredSkills[0]),
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: body,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				params := db.CreateJobTemplateParams{
+					CompanyID:      employer.CompanyID,
+					Name:           template.Name,
+					Description:    template.Description,
+					Requirements:   template.Requirements,
+					RequiredSkills: template.RequiredSkills,
+				}
+				store.EXPECT().
+					CreateJobTemplate(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(template, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchJobTemplate(t, recorder.Body, template)
+			},
+		},
+		{
+			name: "Name Already Exists",
+			body: body,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					CreateJobTemplate(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobTemplate{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Viewer Cannot Create",
+			body: body,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(viewer, nil)
+				store.EXPECT().
+					CreateJobTemplate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Description",
+			body: gin.H{
+				"name":            template.Name,
+				"requirements":    template.Requirements,
+				"required_skills": template.RequiredSkills,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateJobTemplate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Unauthorized",
+			body: body,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateJobTemplate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := BaseUrl + "/job-templates"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListJobTemplatesAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	templates := []db.JobTemplate{
+		generateRandomJobTemplate(employer.CompanyID),
+		generateRandomJobTemplate(employer.CompanyID),
+	}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					ListJobTemplates(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(templates, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []db.JobTemplate
+				err := json.NewDecoder(recorder.Body).Decode(&got)
+				require.NoError(t, err)
+				require.Len(t, got, len(templates))
+				for i := range templates {
+					require.Equal(t, templates[i].ID, got[i].ID)
+					require.Equal(t, templates[i].Name, got[i].Name)
+					require.Equal(t, templates[i].RequiredSkills, got[i].RequiredSkills)
+				}
+			},
+		},
+		{
+			name: "Employer Not Found",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					ListJobTemplates(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					ListJobTemplates(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/job-templates"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestGetJobTemplateAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	template := generateRandomJobTemplate(employer.CompanyID)
+	otherTemplate := generateRandomJobTemplate(employer.CompanyID + 1)
+
+	testCases := []struct {
+		name          string
+		templateID    int32
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			templateID: template.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobTemplate(t, recorder.Body, template)
+			},
+		},
+		{
+			name:       "Not Found",
+			templateID: template.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(db.JobTemplate{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "Template Of Another Company",
+			templateID: otherTemplate.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(otherTemplate.ID)).
+					Times(1).
+					Return(otherTemplate, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "Invalid ID",
+			templateID: 0,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/job-templates/%d", BaseUrl, tc.templateID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestUpdateJobTemplateAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
+	template := generateRandomJobTemplate(employer.CompanyID)
+
+	updated := template
+	updated.Description = "new description"
+	updated.RequiredSkills = []string{"go", "sql"}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"description":     updated.Description,
+				"required_skills": []string{"go", "sql", "go"},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				params := db.UpdateJobTemplateParams{
+					ID:             template.ID,
+					Name:           template.Name,
+					Description:    updated.Description,
+					Requirements:   template.Requirements,
+					RequiredSkills: updated.RequiredSkills,
+				}
+				store.EXPECT().
+					UpdateJobTemplate(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(updated, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobTemplate(t, recorder.Body, updated)
+			},
+		},
+		{
+			name: "Name Already Exists",
+			body: gin.H{
+				"name": "taken",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				store.EXPECT().
+					UpdateJobTemplate(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.JobTemplate{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Viewer Cannot Update",
+			body: gin.H{
+				"description": updated.Description,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(viewer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				store.EXPECT().
+					UpdateJobTemplate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Not Found",
+			body: gin.H{
+				"description": updated.Description,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(db.JobTemplate{}, sql.ErrNoRows)
+				store.EXPECT().
+					UpdateJobTemplate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/job-templates/%d", BaseUrl, template.ID)
+			req, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestDeleteJobTemplateAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	template := generateRandomJobTemplate(employer.CompanyID)
+	otherTemplate := generateRandomJobTemplate(employer.CompanyID + 1)
+
+	testCases := []struct {
+		name          string
+		templateID    int32
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			templateID: template.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				store.EXPECT().
+					DeleteJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:       "Template Of Another Company",
+			templateID: otherTemplate.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(otherTemplate.ID)).
+					Times(1).
+					Return(otherTemplate, nil)
+				store.EXPECT().
+					DeleteJobTemplate(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "Internal Server Error",
+			templateID: template.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				store.EXPECT().
+					DeleteJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/job-templates/%d", BaseUrl, tc.templateID)
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCreateJobFromTemplateAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	employer.IsEmailVerified = true
+	unverifiedEmployer := employer
+	unverifiedEmployer.IsEmailVerified = false
+	template := generateRandomJobTemplate(employer.CompanyID)
+
+	job := generateRandomJob()
+	job.CompanyID = employer.CompanyID
+	job.Description = template.Description
+	job.Requirements = template.Requirements
+	job.Status = db.JobStatusDraft
+
+	var jobSkills []db.ListJobSkillsByJobIDRow
+	for _, skill := range template.RequiredSkills {
+		jobSkills = append(jobSkills, db.ListJobSkillsByJobIDRow{
+			ID:    utils.RandomInt(1, 1000),
+			Skill: skill,
+		})
+	}
+
+	body := gin.H{
+		"title":      job.Title,
+		"industry":   job.Industry,
+		"location":   job.Location,
+		"salary_min": job.SalaryMin,
+		"salary_max": job.SalaryMax,
+		"status":     db.JobStatusDraft,
+	}
+
+	params := db.CreateJobParams{
+		Title:          job.Title,
+		Industry:       job.Industry,
+		CompanyID:      employer.CompanyID,
+		Description:    template.Description,
+		Location:       job.Location,
+		SalaryMin:      job.SalaryMin,
+		SalaryMax:      job.SalaryMax,
+		Requirements:   template.Requirements,
+		Status:         db.JobStatusDraft,
+		EmploymentType: db.EmploymentTypeFullTime,
+		WorkMode:       db.WorkModeOnSite,
+		SeniorityLevel: db.SeniorityLevelMiddle,
+		SalaryCurrency: defaultSalaryCurrency,
+		SalaryPeriod:   defaultSalaryPeriod,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: body,
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Eq(db.CreateJobTxParams{
+						CreateJobParams: params,
+						RequiredSkills:  template.RequiredSkills,
+						AuthorID:        employer.ID,
+					})).
+					Times(1).
+					Return(db.CreateJobTxResult{Job: job}, nil)
+				store.EXPECT().
+					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(jobSkills, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchJob(t, recorder.Body, job, jobSkills)
+			},
+		},
+		{
+			name: "OK Overrides Template",
+			body: gin.H{
+				"title":           job.Title,
+				"industry":        job.Industry,
+				"location":        job.Location,
+				"salary_min":      job.SalaryMin,
+				"salary_max":      job.SalaryMax,
+				"status":          db.JobStatusDraft,
+				"requirements":    "other requirements",
+				"required_skills": []string{"go"},
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				overridden := params
+				overridden.Requirements = "other requirements"
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Eq(db.CreateJobTxParams{
+						CreateJobParams: overridden,
+						RequiredSkills:  []string{"go"},
+						AuthorID:        employer.ID,
+					})).
+					Times(1).
+					Return(db.CreateJobTxResult{Job: job}, nil)
+				store.EXPECT().
+					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(jobSkills, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Title",
+			body: gin.H{
+				"industry":   job.Industry,
+				"location":   job.Location,
+				"salary_min": job.SalaryMin,
+				"salary_max": job.SalaryMax,
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Email Not Verified",
+			body: body,
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(unverifiedEmployer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(template, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Template Not Found",
+			body: body,
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJobTemplate(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return(db.JobTemplate{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("%s/job-templates/%d/jobs", BaseUrl, template.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func generateRandomJobTemplate(companyID int32) db.JobTemplate {
+	return db.JobTemplate{
+		ID:             utils.RandomInt(1, 1000),
+		CompanyID:      companyID,
+		Name:           utils.RandomString(10),
+		Description:    utils.RandomString(50),
+		Requirements:   utils.RandomString(50),
+		RequiredSkills: []string{utils.RandomString(5), utils.RandomString(6)},
+		CreatedAt:      time.Now().UTC().Truncate(time.Second),
+		UpdatedAt:      time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+func requireBodyMatchJobTemplate(t *testing.T, body *bytes.Buffer, template db.JobTemplate) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got db.JobTemplate
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, template.ID, got.ID)
+	require.Equal(t, template.CompanyID, got.CompanyID)
+	require.Equal(t, template.Name, got.Name)
+	require.Equal(t, template.Description, got.Description)
+	require.Equal(t, template.Requirements, got.Requirements)
+	require.Equal(t, template.RequiredSkills, got.RequiredSkills)
+}
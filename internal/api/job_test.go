@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -19,14 +20,21 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
 
 func TestCreateJobAPI(t *testing.T) {
 	employer, _, company := generateRandomEmployerAndCompany(t)
+	employer.IsEmailVerified = true
+	unverifiedEmployer := employer
+	unverifiedEmployer.IsEmailVerified = false
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
 
 	job := generateRandomJob()
+	job.CompanyID = employer.CompanyID
 
 	requiredSkills := []string{"skill1", "skill2"}
 	var jobSkills []db.ListJobSkillsByJobIDRow
@@ -49,6 +57,38 @@ func TestCreateJobAPI(t *testing.T) {
 		"required_skills": requiredSkills,
 	}
 
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	draftJob := job
+	draftJob.Status = db.JobStatusDraft
+	draftJob.ExpiresAt = sql.NullTime{Time: expiresAt, Valid: true}
+	draftJob.EmploymentType = db.EmploymentTypeContract
+	draftJob.WorkMode = db.WorkModeRemote
+	draftJob.SeniorityLevel = db.SeniorityLevelSenior
+	draftJob.SalaryCurrency = "EUR"
+	draftJob.SalaryPeriod = db.SalaryPeriodYearly
+	draftRequestBody := gin.H{
+		"title":           job.Title,
+		"description":     job.Description,
+		"industry":        job.Industry,
+		"location":        job.Location,
+		"salary_min":      job.SalaryMin,
+		"salary_max":      job.SalaryMax,
+		"requirements":    job.Requirements,
+		"required_skills": requiredSkills,
+		"status":          db.JobStatusDraft,
+		"expires_at":      expiresAt,
+		"employment_type": db.EmploymentTypeContract,
+		"work_mode":       db.WorkModeRemote,
+		"seniority_level": db.SeniorityLevelSenior,
+		"salary_currency": "EUR",
+		"salary_period":   db.SalaryPeriodYearly,
+	}
+
+	publishAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	scheduledJob := job
+	scheduledJob.Status = db.JobStatusDraft
+	scheduledJob.PublishAt = sql.NullTime{Time: publishAt, Valid: true}
+
 	testCases := []struct {
 		name          string
 		body          gin.H
@@ -60,31 +100,37 @@ func TestCreateJobAPI(t *testing.T) {
 			name: "OK",
 			body: requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				params := db.CreateJobParams{
-					Title:        job.Title,
-					Industry:     job.Industry,
-					CompanyID:    employer.CompanyID,
-					Description:  job.Description,
-					Location:     job.Location,
-					SalaryMin:    job.SalaryMin,
-					SalaryMax:    job.SalaryMax,
-					Requirements: job.Requirements,
+					Title:          job.Title,
+					Industry:       job.Industry,
+					CompanyID:      employer.CompanyID,
+					Description:    job.Description,
+					Location:       job.Location,
+					SalaryMin:      job.SalaryMin,
+					SalaryMax:      job.SalaryMax,
+					Requirements:   job.Requirements,
+					Status:         db.JobStatusPublished,
+					EmploymentType: job.EmploymentType,
+					WorkMode:       job.WorkMode,
+					SeniorityLevel: job.SeniorityLevel,
+					SalaryCurrency: job.SalaryCurrency,
+					SalaryPeriod:   job.SalaryPeriod,
 				}
 				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Eq(params)).
+					CreateJobTx(gomock.Any(), gomock.Eq(db.CreateJobTxParams{
+						CreateJobParams: params,
+						RequiredSkills:  requiredSkills,
+						AuthorID:        employer.ID,
+					})).
 					Times(1).
-					Return(job, nil)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Eq(requiredSkills), gomock.Eq(job.ID)).
-					Times(1).
-					Return(nil)
+					Return(db.CreateJobTxResult{Job: job}, nil)
 				listSkillsParams := db.ListJobSkillsByJobIDParams{
 					JobID:  job.ID,
 					Limit:  10,
@@ -98,20 +144,30 @@ func TestCreateJobAPI(t *testing.T) {
 					GetCompanyNameByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
 					Times(1).
 					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(requiredSkills, nil)
 				j := esearch.Job{
-					ID:           job.ID,
-					Title:        job.Title,
-					Industry:     job.Industry,
-					CompanyName:  company.Name,
-					Description:  job.Description,
-					Location:     job.Location,
-					SalaryMin:    job.SalaryMin,
-					SalaryMax:    job.SalaryMax,
-					Requirements: job.Requirements,
-					JobSkills:    requiredSkills,
+					ID:             job.ID,
+					Title:          job.Title,
+					Industry:       job.Industry,
+					CompanyName:    company.Name,
+					Description:    job.Description,
+					Location:       job.Location,
+					SalaryMin:      job.SalaryMin,
+					SalaryMax:      job.SalaryMax,
+					SalaryCurrency: job.SalaryCurrency,
+					SalaryPeriod:   string(job.SalaryPeriod),
+					Requirements:   job.Requirements,
+					JobSkills:      requiredSkills,
+					EmploymentType: string(job.EmploymentType),
+					WorkMode:       string(job.WorkMode),
+					SeniorityLevel: string(job.SeniorityLevel),
+					Status:         string(db.JobStatusPublished),
 				}
 				client.EXPECT().
-					IndexJobAsDocument(gomock.Eq(1), gomock.Eq(j)).
+					IndexJobAsDocument(gomock.Eq(int(job.ID)), gomock.Eq(j)).
 					Times(1).
 					Return(nil)
 			},
@@ -121,38 +177,45 @@ func TestCreateJobAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error ListJobSkillsByJobID",
-			body: requestBody,
+			name: "OK Draft",
+			body: draftRequestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				params := db.CreateJobParams{
-					Title:        job.Title,
-					Industry:     job.Industry,
-					CompanyID:    employer.CompanyID,
-					Description:  job.Description,
-					Location:     job.Location,
-					SalaryMin:    job.SalaryMin,
-					SalaryMax:    job.SalaryMax,
-					Requirements: job.Requirements,
+					Title:          job.Title,
+					Industry:       job.Industry,
+					CompanyID:      employer.CompanyID,
+					Description:    job.Description,
+					Location:       job.Location,
+					SalaryMin:      job.SalaryMin,
+					SalaryMax:      job.SalaryMax,
+					Requirements:   job.Requirements,
+					Status:         db.JobStatusDraft,
+					ExpiresAt:      sql.NullTime{Time: expiresAt, Valid: true},
+					EmploymentType: db.EmploymentTypeContract,
+					WorkMode:       db.WorkModeRemote,
+					SeniorityLevel: db.SeniorityLevelSenior,
+					SalaryCurrency: "EUR",
+					SalaryPeriod:   db.SalaryPeriodYearly,
 				}
 				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Eq(params)).
+					CreateJobTx(gomock.Any(), gomock.Eq(db.CreateJobTxParams{
+						CreateJobParams: params,
+						RequiredSkills:  requiredSkills,
+						AuthorID:        employer.ID,
+					})).
 					Times(1).
-					Return(job, nil)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Eq(requiredSkills), gomock.Eq(job.ID)).
-					Times(1).
-					Return(nil)
+					Return(db.CreateJobTxResult{Job: draftJob}, nil)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return([]db.ListJobSkillsByJobIDRow{}, sql.ErrConnDone)
+					Return(jobSkills, nil)
 				store.EXPECT().
 					GetCompanyNameByID(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -161,70 +224,244 @@ func TestCreateJobAPI(t *testing.T) {
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res jobResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, job.ID, res.ID)
+				require.Equal(t, db.JobStatusDraft, res.Status)
+				require.Equal(t, db.WorkModeRemote, res.WorkMode)
+				require.Equal(t, "EUR", res.SalaryCurrency)
+				require.NotNil(t, res.ExpiresAt)
+				require.WithinDuration(t, expiresAt, *res.ExpiresAt, time.Second)
 			},
 		},
 		{
-			name: "Internal Server Error CreateMultipleJobSkills",
-			body: requestBody,
+			name: "Expires At In The Past",
+			body: gin.H{
+				"title":           job.Title,
+				"description":     job.Description,
+				"industry":        job.Industry,
+				"location":        job.Location,
+				"salary_min":      job.SalaryMin,
+				"salary_max":      job.SalaryMax,
+				"requirements":    job.Requirements,
+				"required_skills": requiredSkills,
+				"expires_at":      time.Now().Add(-time.Hour),
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "OK Scheduled",
+			body: gin.H{
+				"title":           job.Title,
+				"description":     job.Description,
+				"industry":        job.Industry,
+				"location":        job.Location,
+				"salary_min":      job.SalaryMin,
+				"salary_max":      job.SalaryMax,
+				"requirements":    job.Requirements,
+				"required_skills": requiredSkills,
+				"publish_at":      publishAt,
+			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				params := db.CreateJobParams{
-					Title:        job.Title,
-					Industry:     job.Industry,
-					CompanyID:    employer.CompanyID,
-					Description:  job.Description,
-					Location:     job.Location,
-					SalaryMin:    job.SalaryMin,
-					SalaryMax:    job.SalaryMax,
-					Requirements: job.Requirements,
+					Title:          job.Title,
+					Industry:       job.Industry,
+					CompanyID:      employer.CompanyID,
+					Description:    job.Description,
+					Location:       job.Location,
+					SalaryMin:      job.SalaryMin,
+					SalaryMax:      job.SalaryMax,
+					Requirements:   job.Requirements,
+					Status:         db.JobStatusDraft,
+					EmploymentType: job.EmploymentType,
+					WorkMode:       job.WorkMode,
+					SeniorityLevel: job.SeniorityLevel,
+					SalaryCurrency: job.SalaryCurrency,
+					SalaryPeriod:   job.SalaryPeriod,
+					PublishAt:      sql.NullTime{Time: publishAt, Valid: true},
 				}
 				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Eq(params)).
+					CreateJobTx(gomock.Any(), gomock.Eq(db.CreateJobTxParams{
+						CreateJobParams: params,
+						RequiredSkills:  requiredSkills,
+						AuthorID:        employer.ID,
+					})).
 					Times(1).
-					Return(job, nil)
+					Return(db.CreateJobTxResult{Job: scheduledJob}, nil)
 				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(sql.ErrConnDone)
+					Return(jobSkills, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var res jobResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Equal(t, db.JobStatusDraft, res.Status)
+				require.NotNil(t, res.PublishAt)
+				require.WithinDuration(t, publishAt, *res.PublishAt, time.Second)
+			},
+		},
+		{
+			name: "Publish At For Published Job",
+			body: gin.H{
+				"title":           job.Title,
+				"description":     job.Description,
+				"industry":        job.Industry,
+				"location":        job.Location,
+				"salary_min":      job.SalaryMin,
+				"salary_max":      job.SalaryMax,
+				"requirements":    job.Requirements,
+				"required_skills": requiredSkills,
+				"status":          db.JobStatusPublished,
+				"publish_at":      publishAt,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
+					CreateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Email Not Verified",
+			body: requestBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetCompanyNameByID(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(unverifiedEmployer, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
 					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Viewer Role",
+			body: requestBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(viewer, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
 			},
 		},
 		{
-			name: "Internal Server Error CreateJob",
+			name: "Internal Server Error ListJobSkillsByJobID",
 			body: requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
+				params := db.CreateJobParams{
+					Title:          job.Title,
+					Industry:       job.Industry,
+					CompanyID:      employer.CompanyID,
+					Description:    job.Description,
+					Location:       job.Location,
+					SalaryMin:      job.SalaryMin,
+					SalaryMax:      job.SalaryMax,
+					Requirements:   job.Requirements,
+					Status:         db.JobStatusPublished,
+					EmploymentType: job.EmploymentType,
+					WorkMode:       job.WorkMode,
+					SeniorityLevel: job.SeniorityLevel,
+					SalaryCurrency: job.SalaryCurrency,
+					SalaryPeriod:   job.SalaryPeriod,
+				}
 				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Any()).
+					CreateJobTx(gomock.Any(), gomock.Eq(db.CreateJobTxParams{
+						CreateJobParams: params,
+						RequiredSkills:  requiredSkills,
+						AuthorID:        employer.ID,
+					})).
 					Times(1).
-					Return(db.Job{}, sql.ErrConnDone)
+					Return(db.CreateJobTxResult{Job: job}, nil)
+				store.EXPECT().
+					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListJobSkillsByJobIDRow{}, sql.ErrConnDone)
 				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					GetCompanyNameByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error CreateJobTx",
+			body: requestBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateJobTxResult{}, sql.ErrConnDone)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -240,21 +477,18 @@ func TestCreateJobAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetEmployerByEmail",
+			name: "Internal Server Error GetEmployerByID",
 			body: requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.Employer{}, sql.ErrConnDone)
 				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					CreateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
@@ -274,21 +508,17 @@ func TestCreateJobAPI(t *testing.T) {
 			name: "Internal Server Error GetCompanyNameByID",
 			body: requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(job, nil)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					CreateJobTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(nil)
+					Return(db.CreateJobTxResult{Job: job}, nil)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -309,21 +539,17 @@ func TestCreateJobAPI(t *testing.T) {
 			name: "Internal Server Error IndexJobAsDocument",
 			body: requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(job, nil)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					CreateJobTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(nil)
+					Return(db.CreateJobTxResult{Job: job}, nil)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -332,6 +558,10 @@ func TestCreateJobAPI(t *testing.T) {
 					GetCompanyNameByID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(requiredSkills, nil)
 				client.EXPECT().
 					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -348,17 +578,14 @@ func TestCreateJobAPI(t *testing.T) {
 				"industry": job.Industry,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					CreateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
@@ -374,6 +601,34 @@ func TestCreateJobAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "Invalid Employment Type",
+			body: gin.H{
+				"title":           job.Title,
+				"description":     job.Description,
+				"industry":        job.Industry,
+				"location":        job.Location,
+				"salary_min":      job.SalaryMin,
+				"salary_max":      job.SalaryMax,
+				"requirements":    job.Requirements,
+				"required_skills": requiredSkills,
+				"employment_type": "freelance",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name: "Salary Min Greater Than Max",
 			body: gin.H{
@@ -387,17 +642,14 @@ func TestCreateJobAPI(t *testing.T) {
 				"required_skills": requiredSkills,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					CreateJob(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					CreateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
@@ -452,6 +704,9 @@ func TestDeleteJobAPI(t *testing.T) {
 	// so that the job belongs to the employer
 	job.CompanyID = employer.CompanyID
 
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
+
 	testCases := []struct {
 		name          string
 		jobID         int32
@@ -463,32 +718,23 @@ func TestDeleteJobAPI(t *testing.T) {
 			name:  "OK",
 			jobID: job.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
 					Return(job, nil)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
-					Times(1).
-					Return(nil)
 				store.EXPECT().
 					DeleteJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
 					Return(nil)
-				documentID := strconv.Itoa(int(job.ID))
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Eq(int(job.ID))).
-					Times(1).
-					Return(documentID, nil)
 				client.EXPECT().
-					DeleteJobDocument(gomock.Eq(documentID)).
+					DeleteJobDocument(gomock.Eq(strconv.Itoa(int(job.ID)))).
 					Times(1).
 					Return(nil)
 			},
@@ -496,15 +742,41 @@ func TestDeleteJobAPI(t *testing.T) {
 				require.Equal(t, http.StatusNoContent, recorder.Code)
 			},
 		},
+		{
+			name:  "Viewer Role",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(viewer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					DeleteJob(gomock.Any(), gomock.Any()).
+					Times(0)
+				client.EXPECT().
+					DeleteJobDocument(gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name:  "Unauthorized User",
 			jobID: job.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "unauthorized@example.com", token.RoleEmployer, employer.ID+1, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq("unauthorized@example.com")).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID+1)).
 					Times(1).
 					Return(db.Employer{
 						ID:        employer.ID + 1,
@@ -515,15 +787,9 @@ func TestDeleteJobAPI(t *testing.T) {
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
 					Return(job, nil)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					DeleteJob(gomock.Any(), gomock.Any()).
 					Times(0)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
 				client.EXPECT().
 					DeleteJobDocument(gomock.Any()).
 					Times(0)
@@ -536,24 +802,18 @@ func TestDeleteJobAPI(t *testing.T) {
 			name:  "Invalid Job ID",
 			jobID: 0,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Any()).
 					Times(0)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					DeleteJob(gomock.Any(), gomock.Any()).
 					Times(0)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
 				client.EXPECT().
 					DeleteJobDocument(gomock.Any()).
 					Times(0)
@@ -563,28 +823,22 @@ func TestDeleteJobAPI(t *testing.T) {
 			},
 		},
 		{
-			name:  "Internal Server Error GetEmployerByEmail",
+			name:  "Internal Server Error GetEmployerByID",
 			jobID: job.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.Employer{}, sql.ErrConnDone)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Any()).
 					Times(0)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					DeleteJob(gomock.Any(), gomock.Any()).
 					Times(0)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
 				client.EXPECT().
 					DeleteJobDocument(gomock.Any()).
 					Times(0)
@@ -597,26 +851,20 @@ func TestDeleteJobAPI(t *testing.T) {
 			name:  "Internal Server Error GetJob",
 			jobID: job.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.Job{}, sql.ErrConnDone)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					DeleteJob(gomock.Any(), gomock.Any()).
 					Times(0)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
 				client.EXPECT().
 					DeleteJobDocument(gomock.Any()).
 					Times(0)
@@ -629,28 +877,21 @@ func TestDeleteJobAPI(t *testing.T) {
 			name:  "Internal Server Error DeleteJob",
 			jobID: job.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
 					Return(job, nil)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
-					Times(1).
-					Return(nil)
 				store.EXPECT().
 					DeleteJob(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(sql.ErrConnDone)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
 				client.EXPECT().
 					DeleteJobDocument(gomock.Any()).
 					Times(0)
@@ -660,140 +901,86 @@ func TestDeleteJobAPI(t *testing.T) {
 			},
 		},
 		{
-			name:  "Internal Server Error GetDocumentIDByJobID",
+			name:  "Internal Server Error DeleteJobDocument",
 			jobID: job.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
 					Return(job, nil)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
-					Times(1).
-					Return(nil)
 				store.EXPECT().
 					DeleteJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
 					Return(nil)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Eq(int(job.ID))).
-					Times(1).
-					Return("", errors.New("some error"))
 				client.EXPECT().
 					DeleteJobDocument(gomock.Any()).
-					Times(0)
+					Times(1).
+					Return(errors.New("some error"))
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 		{
-			name:  "Internal Server Error DeleteJobDocument",
+			name:  "Not Found",
 			jobID: job.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
-					Return(job, nil)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
-					Times(1).
-					Return(nil)
+					Return(db.Job{}, sql.ErrNoRows)
 				store.EXPECT().
-					DeleteJob(gomock.Any(), gomock.Eq(job.ID)).
-					Times(1).
-					Return(nil)
-				documentID := strconv.Itoa(int(job.ID))
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Eq(int(job.ID))).
-					Times(1).
-					Return(documentID, nil)
+					DeleteJob(gomock.Any(), gomock.Any()).
+					Times(0)
 				client.EXPECT().
 					DeleteJobDocument(gomock.Any()).
-					Times(1).
-					Return(errors.New("some error"))
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
 		{
-			name:  "Not Found",
+			name:  "OK Closed Job",
 			jobID: job.ID,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				closedJob := job
+				closedJob.Status = db.JobStatusClosed
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
-					Return(db.Job{}, sql.ErrNoRows)
+					Return(closedJob, nil)
 				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					DeleteJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					DeleteJobDocument(gomock.Any()).
-					Times(0)
-			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
-			},
-		},
-		{
-			name:  "Internal Server Error DeleteJobSkillsByJobID",
-			jobID: job.ID,
-			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
-			},
-			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(employer, nil)
-				store.EXPECT().
-					GetJob(gomock.Any(), gomock.Eq(job.ID)).
-					Times(1).
-					Return(job, nil)
-				store.EXPECT().
-					DeleteJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+					DeleteJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
-					Return(sql.ErrConnDone)
-				store.EXPECT().
-					DeleteJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
+					Return(nil)
 				client.EXPECT().
 					DeleteJobDocument(gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusNoContent, recorder.Code)
 			},
 		},
 	}
@@ -826,6 +1013,7 @@ func TestDeleteJobAPI(t *testing.T) {
 
 func TestGetJobAPI(t *testing.T) {
 	employer, _, company := generateRandomEmployerAndCompany(t)
+	user, _ := generateRandomUser(t)
 	job := generateRandomJob()
 
 	// set the company ID to the employer's company ID
@@ -854,6 +1042,7 @@ func TestGetJobAPI(t *testing.T) {
 	testCases := []struct {
 		name          string
 		jobID         int32
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
 		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
@@ -865,6 +1054,56 @@ func TestGetJobAPI(t *testing.T) {
 					GetJobDetails(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
 					Return(getJobRow, nil)
+				store.EXPECT().
+					RecordJobView(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RecordJobViewParams) error {
+						require.Equal(t, job.ID, arg.JobID)
+						require.True(t, strings.HasPrefix(arg.Viewer, "anonymous:"))
+						return nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobDetails(t, recorder.Body, getJobRow)
+			},
+		},
+		{
+			name:  "OK Signed In Viewer",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobDetails(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(getJobRow, nil)
+				params := db.RecordJobViewParams{
+					JobID:  job.ID,
+					Viewer: fmt.Sprintf("%s:%d", token.RoleUser, user.ID),
+				}
+				store.EXPECT().
+					RecordJobView(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "OK Recording View Fails",
+			jobID: job.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetJobDetails(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(getJobRow, nil)
+				store.EXPECT().
+					RecordJobView(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -879,6 +1118,9 @@ func TestGetJobAPI(t *testing.T) {
 					GetJobDetails(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.GetJobDetailsRow{}, sql.ErrNoRows)
+				store.EXPECT().
+					RecordJobView(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
@@ -927,6 +1169,10 @@ func TestGetJobAPI(t *testing.T) {
 			req, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
 
+			if tc.setupAuth != nil {
+				tc.setupAuth(t, req, server.tokenMaker)
+			}
+
 			server.router.ServeHTTP(recorder, req)
 
 			tc.checkResponse(recorder)
@@ -969,7 +1215,7 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 			j = job2
 		}
 		row := db.ListJobsByFiltersRow{
-			ID:           j.ID,
+			ID:           int32(10 - i),
 			Title:        j.Title,
 			Industry:     j.Industry,
 			CompanyID:    j.CompanyID,
@@ -978,20 +1224,39 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 			SalaryMin:    j.SalaryMin,
 			SalaryMax:    j.SalaryMax,
 			Requirements: j.Requirements,
-			CreatedAt:    j.CreatedAt,
+			CreatedAt:    time.Now().UTC().Truncate(time.Second).Add(-time.Duration(i) * time.Hour),
 			CompanyName:  company.Name,
 		}
 		jobs = append(jobs, row)
 	}
 
+	cursorCreatedAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	cursor := pageCursor{CreatedAt: cursorCreatedAt, ID: 7, Sort: "newest"}.encode()
+	salaryCursor := pageCursor{
+		CreatedAt:      cursorCreatedAt,
+		ID:             7,
+		Sort:           "salary-desc",
+		Salary:         5000,
+		SalaryCurrency: "EUR",
+		SalaryPeriod:   db.SalaryPeriodMonthly,
+	}.encode()
+
 	type Query struct {
-		page        int32
-		pageSize    int32
-		industry    string
-		jobLocation string
-		title       string
-		salaryMin   int32
-		salaryMax   int32
+		cursor         string
+		pageSize       int32
+		industries     []string
+		locations      []string
+		jobLocation    string
+		skills         []string
+		skillMatch     string
+		createdAfter   string
+		title          string
+		salaryMin      int32
+		salaryMax      int32
+		workMode       string
+		salaryCurrency string
+		salaryPeriod   string
+		sort           string
 	}
 
 	testCases := []struct {
@@ -1003,27 +1268,19 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 		{
 			name: "OK",
 			query: Query{
-				page:        1,
 				pageSize:    10,
-				industry:    industry2,
+				industries:  []string{industry2},
 				jobLocation: jobLocation2,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				params := db.ListJobsByFiltersParams{
-					Limit:  10,
-					Offset: 0,
+					Limit: 11,
 					Title: sql.NullString{
 						String: "",
 						Valid:  false,
 					},
-					JobLocation: sql.NullString{
-						String: jobLocation2,
-						Valid:  true,
-					},
-					Industry: sql.NullString{
-						String: industry2,
-						Valid:  true,
-					},
+					Locations:  []string{jobLocation2},
+					Industries: []string{industry2},
 					SalaryMin: sql.NullInt32{
 						Int32: 0,
 						Valid: false,
@@ -1032,11 +1289,18 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 						Int32: 0,
 						Valid: false,
 					},
+					SalaryCurrency: "USD",
+					SalaryPeriod:   db.SalaryPeriodMonthly,
+					Sort:           "newest",
 				}
 				store.EXPECT().
 					ListJobsByFilters(gomock.Any(), gomock.Eq(params)).
 					Times(1).
 					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(int64(len(jobs)), nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -1044,11 +1308,221 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "No Page In Query",
+			name: "OK Multiple Values",
 			query: Query{
-				pageSize:    10,
-				industry:    industry,
-				jobLocation: jobLocation,
+				pageSize:     10,
+				industries:   []string{industry, industry2},
+				locations:    []string{jobLocation, jobLocation2, ""},
+				skills:       []string{"Postgres", "Go", "Go"},
+				skillMatch:   "all",
+				createdAfter: "2024-01-31",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListJobsByFiltersParams{
+					Limit:          11,
+					Locations:      []string{jobLocation, jobLocation2},
+					Industries:     []string{industry, industry2},
+					Skills:         []string{"Go", "Postgres"},
+					MatchAllSkills: true,
+					CreatedAfter: sql.NullTime{
+						Time:  time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+						Valid: true,
+					},
+					SalaryCurrency: "USD",
+					SalaryPeriod:   db.SalaryPeriodMonthly,
+					Sort:           "newest",
+				}
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(int64(len(jobs)), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobs(t, recorder.Body, jobs)
+			},
+		},
+		{
+			name: "OK Work Mode",
+			query: Query{
+				pageSize: 10,
+				workMode: string(db.WorkModeRemote),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListJobsByFiltersParams{
+					Limit: 11,
+					WorkMode: db.NullWorkMode{
+						WorkMode: db.WorkModeRemote,
+						Valid:    true,
+					},
+					SalaryCurrency: "USD",
+					SalaryPeriod:   db.SalaryPeriodMonthly,
+					Sort:           "newest",
+				}
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(int64(len(jobs)), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobs(t, recorder.Body, jobs)
+			},
+		},
+		{
+			name: "OK Salary In Another Currency",
+			query: Query{
+				pageSize:       10,
+				salaryMin:      salaryMin,
+				salaryCurrency: "EUR",
+				salaryPeriod:   string(db.SalaryPeriodYearly),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetExchangeRate(gomock.Any(), gomock.Eq("EUR")).
+					Times(1).
+					Return(db.ExchangeRate{Currency: "EUR", RateToUsd: "1.08"}, nil)
+				params := db.ListJobsByFiltersParams{
+					Limit: 11,
+					SalaryMin: sql.NullInt32{
+						Int32: salaryMin,
+						Valid: true,
+					},
+					SalaryCurrency: "EUR",
+					SalaryPeriod:   db.SalaryPeriodYearly,
+					Sort:           "newest",
+				}
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(int64(len(jobs)), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobs(t, recorder.Body, jobs)
+			},
+		},
+		{
+			name: "OK Next Cursor",
+			query: Query{
+				cursor:   cursor,
+				pageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListJobsByFiltersParams{
+					Limit:           6,
+					SalaryCurrency:  "USD",
+					SalaryPeriod:    db.SalaryPeriodMonthly,
+					CursorCreatedAt: sql.NullTime{Time: cursorCreatedAt, Valid: true},
+					CursorID:        7,
+					Sort:            "newest",
+				}
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(20), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got listResponse[db.ListJobsByFiltersRow]
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Len(t, got.Items, 5)
+				require.Equal(t, int64(20), got.Total)
+				require.Equal(t, pageCursor{CreatedAt: jobs[4].CreatedAt, ID: jobs[4].ID, Sort: "newest"}.encode(), got.NextCursor)
+			},
+		},
+		{
+			name: "OK Sort Salary Desc",
+			query: Query{
+				cursor:   salaryCursor,
+				pageSize: 10,
+				sort:     "salary-desc",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListJobsByFiltersParams{
+					Limit:                11,
+					SalaryCurrency:       "USD",
+					SalaryPeriod:         db.SalaryPeriodMonthly,
+					CursorCreatedAt:      sql.NullTime{Time: cursorCreatedAt, Valid: true},
+					CursorID:             7,
+					CursorSalary:         sql.NullInt32{Int32: 5000, Valid: true},
+					CursorSalaryCurrency: sql.NullString{String: "EUR", Valid: true},
+					CursorSalaryPeriod: db.NullSalaryPeriod{
+						SalaryPeriod: db.SalaryPeriodMonthly,
+						Valid:        true,
+					},
+					Sort: "salary-desc",
+				}
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(int64(len(jobs)), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobs(t, recorder.Body, jobs)
+			},
+		},
+		{
+			name: "OK Sort Company Next Cursor",
+			query: Query{
+				pageSize: 5,
+				sort:     "company",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(20), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got listResponse[db.ListJobsByFiltersRow]
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				// the next page starts after the company name even if the company is renamed
+				nextCursor := pageCursor{
+					CreatedAt:   jobs[4].CreatedAt,
+					ID:          jobs[4].ID,
+					Sort:        "company",
+					CompanyName: jobs[4].CompanyName,
+				}
+				require.Equal(t, nextCursor.encode(), got.NextCursor)
+			},
+		},
+		{
+			name: "Cursor Without Value Of The Sort",
+			query: Query{
+				cursor:   pageCursor{CreatedAt: cursorCreatedAt, ID: 7, Sort: "salary-desc"}.encode(),
+				pageSize: 10,
+				sort:     "salary-desc",
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -1060,11 +1534,11 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "No Page Size In Query",
+			name: "Cursor Of Another Sort",
 			query: Query{
-				page:        1,
-				industry:    industry,
-				jobLocation: jobLocation,
+				cursor:   cursor,
+				pageSize: 10,
+				sort:     "salary-asc",
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -1076,12 +1550,10 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Invalid Page",
+			name: "Invalid Sort",
 			query: Query{
-				page:        0,
-				pageSize:    10,
-				industry:    industry,
-				jobLocation: jobLocation,
+				pageSize: 10,
+				sort:     "salary",
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -1093,14 +1565,17 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Invalid Page Size",
+			name: "Unsupported Currency",
 			query: Query{
-				page:        1,
-				pageSize:    50,
-				industry:    industry,
-				jobLocation: jobLocation,
+				pageSize:       10,
+				salaryMin:      salaryMin,
+				salaryCurrency: "XAU",
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetExchangeRate(gomock.Any(), gomock.Eq("XAU")).
+					Times(1).
+					Return(db.ExchangeRate{}, sql.ErrNoRows)
 				store.EXPECT().
 					ListJobsByFilters(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1110,51 +1585,195 @@ func TestFilterAndListJobsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error",
+			name: "Invalid Salary Period",
 			query: Query{
-				page:     1,
-				pageSize: 10,
-				title:    title,
+				pageSize:     10,
+				salaryPeriod: "weekly",
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					ListJobsByFilters(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return([]db.ListJobsByFiltersRow{}, sql.ErrConnDone)
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
-	}
-	for i := range testCases {
-		tc := testCases[i]
-
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			store := mockdb.NewMockStore(ctrl)
-			tc.buildStubs(store)
-
-			server := newTestServer(t, store, nil)
-			recorder := httptest.NewRecorder()
-
-			url := BaseUrl + "/jobs"
-			req, err := http.NewRequest(http.MethodGet, url, nil)
-			require.NoError(t, err)
-
-			// Add query params
-			q := req.URL.Query()
-			q.Add("page", fmt.Sprintf("%d", tc.query.page))
-			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
-			q.Add("industry", tc.query.industry)
-			q.Add("job_location", tc.query.jobLocation)
-			q.Add("title", tc.query.title)
-			q.Add("salary_min", fmt.Sprintf("%d", tc.query.salaryMin))
-			q.Add("salary_max", fmt.Sprintf("%d", tc.query.salaryMax))
-			req.URL.RawQuery = q.Encode()
-
+		{
+			name: "Invalid Skill Match",
+			query: Query{
+				pageSize:   10,
+				skills:     []string{"Go"},
+				skillMatch: "most",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Created After",
+			query: Query{
+				pageSize:     10,
+				createdAfter: "31.01.2024",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Work Mode",
+			query: Query{
+				pageSize: 10,
+				workMode: "office",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "No Page Size In Query",
+			query: Query{
+				industries:  []string{industry},
+				jobLocation: jobLocation,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Cursor",
+			query: Query{
+				cursor:      "invalid",
+				pageSize:    10,
+				industries:  []string{industry},
+				jobLocation: jobLocation,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Page Size",
+			query: Query{
+				pageSize:    50,
+				industries:  []string{industry},
+				jobLocation: jobLocation,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			query: Query{
+				pageSize: 10,
+				title:    title,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListJobsByFiltersRow{}, sql.ErrConnDone)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error CountJobsByFilters",
+			query: Query{
+				pageSize: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsByFilters(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/jobs"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			// Add query params
+			q := req.URL.Query()
+			q.Add("cursor", tc.query.cursor)
+			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			for _, industry := range tc.query.industries {
+				q.Add("industry", industry)
+			}
+			for _, location := range tc.query.locations {
+				q.Add("location", location)
+			}
+			q.Add("job_location", tc.query.jobLocation)
+			for _, skill := range tc.query.skills {
+				q.Add("skill", skill)
+			}
+			q.Add("skill_match", tc.query.skillMatch)
+			q.Add("created_after", tc.query.createdAfter)
+			q.Add("title", tc.query.title)
+			q.Add("salary_min", fmt.Sprintf("%d", tc.query.salaryMin))
+			q.Add("salary_max", fmt.Sprintf("%d", tc.query.salaryMax))
+			q.Add("work_mode", tc.query.workMode)
+			q.Add("salary_currency", tc.query.salaryCurrency)
+			q.Add("salary_period", tc.query.salaryPeriod)
+			q.Add("sort", tc.query.sort)
+			req.URL.RawQuery = q.Encode()
+
 			server.router.ServeHTTP(recorder, req)
 
 			tc.checkResponse(recorder)
@@ -1194,13 +1813,23 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 			Requirements: job.Requirements,
 			CreatedAt:    job.CreatedAt,
 			CompanyName:  company.Name,
+			// the jobs are returned from the best match
+			MatchedSkills: 3,
+			TotalSkills:   3,
+			SkillsScore:   50 - float64(i)*10/3,
+			TitleScore:    20,
+			IndustryScore: 10,
+			LocationScore: 0,
+			SalaryScore:   10,
 		}
+		row.MatchScore = row.SkillsScore + row.TitleScore + row.IndustryScore + row.LocationScore + row.SalaryScore
 		jobs = append(jobs, row)
 	}
 
 	type Query struct {
 		page     int32
 		pageSize int32
+		sort     string
 	}
 
 	testCases := []struct {
@@ -1217,15 +1846,61 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 				pageSize: 10,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListJobsMatchingUserSkillsParams{
+					UserID: user.ID,
+					Sort:   "score",
+					Limit:  10,
+					Offset: 0,
+				}
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					ListJobsMatchingUserSkills(gomock.Any(), gomock.Eq(params)).
 					Times(1).
-					Return(user, nil)
+					Return(jobs, nil)
+
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				data, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var gotJobs []matchingJobResponse
+				err = json.Unmarshal(data, &gotJobs)
+				require.NoError(t, err)
+				require.Len(t, gotJobs, len(jobs))
+
+				// the points are rounded to one decimal place
+				require.Equal(t, 86.7, gotJobs[1].MatchScore)
+				require.Equal(t, jobMatchBreakdown{
+					Skills:        46.7,
+					MatchedSkills: 3,
+					TotalSkills:   3,
+					Title:         20,
+					Industry:      10,
+					Location:      0,
+					Salary:        10,
+				}, gotJobs[1].MatchBreakdown)
+				for i := range jobs {
+					require.Equal(t, newMatchingJobResponse(jobs[i]), gotJobs[i])
+				}
+			},
+		},
+		{
+			name: "OK Sort Newest",
+			query: Query{
+				page:     1,
+				pageSize: 10,
+				sort:     "newest",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
 				params := db.ListJobsMatchingUserSkillsParams{
 					UserID: user.ID,
+					Sort:   "newest",
 					Limit:  10,
 					Offset: 0,
 				}
@@ -1233,7 +1908,6 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 					ListJobsMatchingUserSkills(gomock.Any(), gomock.Eq(params)).
 					Times(1).
 					Return(jobs, nil)
-
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -1241,47 +1915,67 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Employer Making Request",
+			name: "OK Sort Company",
 			query: Query{
-				page:     1,
+				page:     2,
 				pageSize: 10,
+				sort:     "company",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListJobsMatchingUserSkillsParams{
+					UserID: user.ID,
+					Sort:   "company",
+					Limit:  10,
+					Offset: 10,
+				}
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					ListJobsMatchingUserSkills(gomock.Any(), gomock.Eq(params)).
 					Times(1).
-					Return(db.User{}, sql.ErrNoRows)
+					Return(jobs, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobs(t, recorder.Body, jobs)
+			},
+		},
+		{
+			name: "Invalid Sort",
+			query: Query{
+				page:     1,
+				pageSize: 10,
+				sort:     "title",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
 					ListJobsMatchingUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name: "Internal Server Error GetUserByEmail",
+			name: "Employer Making Request",
 			query: Query{
 				page:     1,
 				pageSize: 10,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(db.User{}, sql.ErrConnDone)
 				store.EXPECT().
 					ListJobsMatchingUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
 		{
@@ -1291,13 +1985,9 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 				pageSize: 10,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
-					Times(1).
-					Return(user, nil)
 				store.EXPECT().
 					ListJobsMatchingUserSkills(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -1314,12 +2004,9 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 				pageSize: 50,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					ListJobsMatchingUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1335,12 +2022,9 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 				pageSize: 10,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					ListJobsMatchingUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1355,12 +2039,9 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 				page: 1,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					ListJobsMatchingUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1375,12 +2056,9 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 				pageSize: 10,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
-					Times(0)
 				store.EXPECT().
 					ListJobsMatchingUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1411,6 +2089,7 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 			q := req.URL.Query()
 			q.Add("page", fmt.Sprintf("%d", tc.query.page))
 			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			q.Add("sort", tc.query.sort)
 			req.URL.RawQuery = q.Encode()
 
 			tc.setupAuth(t, req, server.tokenMaker)
@@ -1423,7 +2102,7 @@ func TestListJobsByMatchingSkillsAPI(t *testing.T) {
 }
 
 func TestUpdateJobAPI(t *testing.T) {
-	employer, _, _ := generateRandomEmployerAndCompany(t)
+	employer, _, company := generateRandomEmployerAndCompany(t)
 	employer2, _, _ := generateRandomEmployerAndCompany(t)
 
 	job := generateRandomJob()
@@ -1435,6 +2114,11 @@ func TestUpdateJobAPI(t *testing.T) {
 	job.CompanyID = employer.CompanyID
 	newJob.CompanyID = employer.CompanyID
 
+	recruiter := employer
+	recruiter.Role = db.EmployerRoleRecruiter
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
+
 	requiredSkillsToAdd := []string{"skill1", "skill2"}
 	requiredSkillIDsToRemove := []int32{1, 2}
 
@@ -1465,33 +2149,71 @@ func TestUpdateJobAPI(t *testing.T) {
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
 		{
-			name:  "OK",
+			name:  "Viewer Role",
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
-					Return(employer, nil)
+					Return(viewer, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
 					Return(job, nil)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "OK",
+			jobID: job.ID,
+			body:  requestBody,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
-					Return(newJob, nil)
+					Return(employer, nil)
 				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Eq(requiredSkillIDsToRemove)).
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
-					Return(nil)
+					Return(job, nil)
+				// the fields that are not in the request are kept
+				params := db.UpdateJobTxParams{
+					UpdateJobParams: db.UpdateJobParams{
+						ID:             job.ID,
+						Title:          newJob.Title,
+						Description:    newJob.Description,
+						Industry:       newJob.Industry,
+						Location:       newJob.Location,
+						SalaryMin:      newJob.SalaryMin,
+						SalaryMax:      newJob.SalaryMax,
+						Requirements:   newJob.Requirements,
+						CompanyID:      job.CompanyID,
+						EmploymentType: job.EmploymentType,
+						WorkMode:       job.WorkMode,
+						SeniorityLevel: job.SeniorityLevel,
+						SalaryCurrency: job.SalaryCurrency,
+						SalaryPeriod:   job.SalaryPeriod,
+					},
+					RequiredSkillIDsToRemove: requiredSkillIDsToRemove,
+					RequiredSkillsToAdd:      requiredSkillsToAdd,
+					AuthorID:                 employer.ID,
+				}
 				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Eq(requiredSkillsToAdd), gomock.Eq(newJob.ID)).
+					UpdateJobTx(gomock.Any(), gomock.Eq(params)).
 					Times(1).
-					Return(nil)
+					Return(db.UpdateJobTxResult{Job: newJob}, nil)
 				listSkillsParams := db.ListJobSkillsByJobIDParams{
 					JobID:  newJob.ID,
 					Limit:  10,
@@ -1501,12 +2223,16 @@ func TestUpdateJobAPI(t *testing.T) {
 					ListJobSkillsByJobID(gomock.Any(), gomock.Eq(listSkillsParams)).
 					Times(1).
 					Return(listedSkills, nil)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(newJob.CompanyID)).
+					Times(1).
+					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(newJob.ID)).
 					Times(1).
-					Return(strconv.Itoa(int(job.ID)), nil)
+					Return(requiredSkillsToAdd, nil)
 				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Eq(int(newJob.ID)), gomock.Eq(newESJob(newJob, company.Name, requiredSkillsToAdd))).
 					Times(1).
 					Return(nil)
 			},
@@ -1516,115 +2242,106 @@ func TestUpdateJobAPI(t *testing.T) {
 			},
 		},
 		{
-			name:  "Not Found",
+			name:  "OK Recruiter",
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
-					Return(employer, nil)
+					Return(recruiter, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
-					Return(db.Job{}, sql.ErrNoRows)
+					Return(job, nil)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(0)
+					UpdateJobTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateJobTxResult{Job: newJob}, nil)
+				listSkillsParams := db.ListJobSkillsByJobIDParams{
+					JobID:  newJob.ID,
+					Limit:  10,
+					Offset: 0,
+				}
 				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(0)
+					ListJobSkillsByJobID(gomock.Any(), gomock.Eq(listSkillsParams)).
+					Times(1).
+					Return(listedSkills, nil)
 				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
-					Times(0)
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(newJob.CompanyID)).
+					Times(1).
+					Return(company.Name, nil)
 				store.EXPECT().
-					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(newJob.ID)).
+					Times(1).
+					Return(requiredSkillsToAdd, nil)
 				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
-					Times(0)
+					IndexJobAsDocument(gomock.Eq(int(newJob.ID)), gomock.Eq(newESJob(newJob, company.Name, requiredSkillsToAdd))).
+					Times(1).
+					Return(nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJob(t, recorder.Body, newJob, listedSkills)
 			},
 		},
 		{
-			name:  "Internal Server Error GetJob",
+			name:  "Not Found",
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Eq(job.ID)).
 					Times(1).
-					Return(db.Job{}, sql.ErrConnDone)
-				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(0)
+					Return(db.Job{}, sql.ErrNoRows)
 				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusNotFound, recorder.Code)
 			},
 		},
 		{
-			name:  "Internal Server Error GetEmployerByEmail",
+			name:  "Internal Server Error GetJob",
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
-					Return(db.Employer{}, sql.ErrConnDone)
-				store.EXPECT().
-					GetJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(0)
+					Return(employer, nil)
 				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(0)
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(db.Job{}, sql.ErrConnDone)
 				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -1632,39 +2349,28 @@ func TestUpdateJobAPI(t *testing.T) {
 			},
 		},
 		{
-			name:  "Internal Server Error UpdateJob",
+			name:  "Internal Server Error GetEmployerByID",
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(employer, nil)
-				store.EXPECT().
-					GetJob(gomock.Any(), gomock.Eq(job.ID)).
-					Times(1).
-					Return(job, nil)
-				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
-					Return(db.Job{}, sql.ErrConnDone)
+					Return(db.Employer{}, sql.ErrConnDone)
 				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
+					GetJob(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -1672,19 +2378,15 @@ func TestUpdateJobAPI(t *testing.T) {
 			},
 		},
 		{
-			name:  "Internal Server Error DeleteMultipleJobSkills",
+			name:  "Skill Of Another Job",
 			jobID: job.ID,
-			body: gin.H{
-				"requirements ":                "new requirements",
-				"required_skills_to_add":       requiredSkillsToAdd,
-				"required_skill_ids_to_remove": requiredSkillIDsToRemove,
-			},
+			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -1692,44 +2394,30 @@ func TestUpdateJobAPI(t *testing.T) {
 					Times(1).
 					Return(job, nil)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(newJob, nil)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(sql.ErrConnDone)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
-					Times(0)
+					Return(db.UpdateJobTxResult{}, sql.ErrNoRows)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
-			name:  "Internal Server Error CreateMultipleJobSkills",
+			name:  "Internal Server Error UpdateJobTx",
 			jobID: job.ID,
-			body: gin.H{
-				"title":                        "new title",
-				"required_skills_to_add":       requiredSkillsToAdd,
-				"required_skill_ids_to_remove": requiredSkillIDsToRemove,
-			},
+			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -1737,25 +2425,14 @@ func TestUpdateJobAPI(t *testing.T) {
 					Times(1).
 					Return(job, nil)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(newJob, nil)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(nil)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(sql.ErrConnDone)
+					Return(db.UpdateJobTxResult{}, sql.ErrConnDone)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -1767,11 +2444,11 @@ func TestUpdateJobAPI(t *testing.T) {
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -1779,26 +2456,15 @@ func TestUpdateJobAPI(t *testing.T) {
 					Times(1).
 					Return(job, nil)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(newJob, nil)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(nil)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(nil)
+					Return(db.UpdateJobTxResult{Job: newJob}, nil)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return([]db.ListJobSkillsByJobIDRow{}, sql.ErrConnDone)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -1810,32 +2476,44 @@ func TestUpdateJobAPI(t *testing.T) {
 			jobID: 0,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Seniority Level",
+			jobID: job.ID,
+			body: gin.H{
+				"seniority_level": "principal",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -1850,32 +2528,23 @@ func TestUpdateJobAPI(t *testing.T) {
 				"title":      100,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -1887,11 +2556,11 @@ func TestUpdateJobAPI(t *testing.T) {
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer2.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer2.Email, token.RoleEmployer, employer2.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer2.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer2.ID)).
 					Times(1).
 					Return(employer2, nil)
 				store.EXPECT().
@@ -1899,22 +2568,13 @@ func TestUpdateJobAPI(t *testing.T) {
 					Times(1).
 					Return(job, nil)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -1929,11 +2589,11 @@ func TestUpdateJobAPI(t *testing.T) {
 				"salary_max": 5,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -1941,22 +2601,13 @@ func TestUpdateJobAPI(t *testing.T) {
 					Times(1).
 					Return(job, nil)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -1968,33 +2619,24 @@ func TestUpdateJobAPI(t *testing.T) {
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(db.Employer{}, sql.ErrNoRows)
 				store.EXPECT().
 					GetJob(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Any()).
-					Times(0)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Any(), gomock.Any()).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(0)
 				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(0)
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -2002,15 +2644,15 @@ func TestUpdateJobAPI(t *testing.T) {
 			},
 		},
 		{
-			name:  "Internal Server Error GetDocumentIDByJobID",
+			name:  "Internal Server Error IndexJobAsDocument",
 			jobID: job.ID,
 			body:  requestBody,
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
@@ -2018,71 +2660,23 @@ func TestUpdateJobAPI(t *testing.T) {
 					Times(1).
 					Return(job, nil)
 				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(newJob, nil)
-				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Eq(requiredSkillIDsToRemove)).
+					UpdateJobTx(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return(nil)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Eq(requiredSkillsToAdd), gomock.Eq(newJob.ID)).
-					Times(1).
-					Return(nil)
+					Return(db.UpdateJobTxResult{Job: newJob}, nil)
 				store.EXPECT().
 					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return([]db.ListJobSkillsByJobIDRow{}, nil)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
-					Times(1).
-					Return("", errors.New("some error"))
-				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
-					Times(0)
-			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusInternalServerError, recorder.Code)
-			},
-		},
-		{
-			name:  "Internal Server Error UpdateJobDocument",
-			jobID: job.ID,
-			body:  requestBody,
-			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
-			},
-			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
-				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(employer, nil)
-				store.EXPECT().
-					GetJob(gomock.Any(), gomock.Eq(job.ID)).
-					Times(1).
-					Return(job, nil)
-				store.EXPECT().
-					UpdateJob(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return(newJob, nil)
 				store.EXPECT().
-					DeleteMultipleJobSkills(gomock.Any(), gomock.Eq(requiredSkillIDsToRemove)).
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(newJob.CompanyID)).
 					Times(1).
-					Return(nil)
-				store.EXPECT().
-					CreateMultipleJobSkills(gomock.Any(), gomock.Eq(requiredSkillsToAdd), gomock.Eq(newJob.ID)).
-					Times(1).
-					Return(nil)
+					Return(company.Name, nil)
 				store.EXPECT().
-					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
-					Times(1).
-					Return([]db.ListJobSkillsByJobIDRow{}, nil)
-				client.EXPECT().
-					GetDocumentIDByJobID(gomock.Any()).
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(newJob.ID)).
 					Times(1).
-					Return(strconv.Itoa(int(job.ID)), nil)
+					Return(requiredSkillsToAdd, nil)
 				client.EXPECT().
-					UpdateJobDocument(gomock.Any(), gomock.Any()).
+					IndexJobAsDocument(gomock.Eq(int(newJob.ID)), gomock.Eq(newESJob(newJob, company.Name, requiredSkillsToAdd))).
 					Times(1).
 					Return(errors.New("some error"))
 			},
@@ -2188,11 +2782,12 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 	}
 
 	type Query struct {
-		page         int32
+		cursor       string
 		pageSize     int32
 		id           int32
 		name         string
 		nameContains string
+		sort         string
 	}
 
 	testCases := []struct {
@@ -2204,7 +2799,6 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 		{
 			name: "OK Name",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				name:     company.Name,
 			},
@@ -2213,14 +2807,18 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 					ListJobsByCompanyID(gomock.Any(), gomock.Any()).
 					Times(0)
 				params := db.ListJobsByCompanyExactNameParams{
-					Name:   company.Name,
-					Limit:  10,
-					Offset: 0,
+					Name:  company.Name,
+					Limit: 11,
+					Sort:  "newest",
 				}
 				store.EXPECT().
 					ListJobsByCompanyExactName(gomock.Any(), gomock.Eq(params)).
 					Times(1).
 					Return(jobsByExactName, nil)
+				store.EXPECT().
+					CountJobsByCompanyExactName(gomock.Any(), gomock.Eq(company.Name)).
+					Times(1).
+					Return(int64(len(jobsByExactName)), nil)
 				store.EXPECT().
 					ListJobsByCompanyName(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -2233,20 +2831,23 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 		{
 			name: "OK ID",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				id:       company.ID,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				params := db.ListJobsByCompanyIDParams{
 					CompanyID: company.ID,
-					Limit:     10,
-					Offset:    0,
+					Limit:     11,
+					Sort:      "newest",
 				}
 				store.EXPECT().
 					ListJobsByCompanyID(gomock.Any(), gomock.Eq(params)).
 					Times(1).
 					Return(jobByID, nil)
+				store.EXPECT().
+					CountJobsByCompanyID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(int64(len(jobByID)), nil)
 				store.EXPECT().
 					ListJobsByCompanyExactName(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -2262,7 +2863,6 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 		{
 			name: "OK Name Contains",
 			query: Query{
-				page:         1,
 				pageSize:     10,
 				nameContains: company.Name[1:3],
 			},
@@ -2274,24 +2874,70 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 					ListJobsByCompanyExactName(gomock.Any(), gomock.Any()).
 					Times(0)
 				params := db.ListJobsByCompanyNameParams{
-					Name:   company.Name[1:3],
-					Limit:  10,
-					Offset: 0,
+					Name:  company.Name[1:3],
+					Limit: 11,
+					Sort:  "newest",
+				}
+				store.EXPECT().
+					ListJobsByCompanyName(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(jobByName, nil)
+				store.EXPECT().
+					CountJobsByCompanyName(gomock.Any(), gomock.Eq(company.Name[1:3])).
+					Times(1).
+					Return(int64(len(jobByName)), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobs(t, recorder.Body, jobByName)
+			},
+		},
+		{
+			name: "OK Sort Salary Asc",
+			query: Query{
+				pageSize:     10,
+				nameContains: company.Name[1:3],
+				sort:         "salary-asc",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.ListJobsByCompanyNameParams{
+					Name:  company.Name[1:3],
+					Limit: 11,
+					Sort:  "salary-asc",
 				}
 				store.EXPECT().
 					ListJobsByCompanyName(gomock.Any(), gomock.Eq(params)).
 					Times(1).
 					Return(jobByName, nil)
+				store.EXPECT().
+					CountJobsByCompanyName(gomock.Any(), gomock.Eq(company.Name[1:3])).
+					Times(1).
+					Return(int64(len(jobByName)), nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 				requireBodyMatchJobs(t, recorder.Body, jobByName)
 			},
 		},
+		{
+			name: "Invalid Sort",
+			query: Query{
+				pageSize: 10,
+				id:       company.ID,
+				sort:     "date-asc",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByCompanyID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name: "Invalid Page Size",
 			query: Query{
-				page:     1,
 				pageSize: 50,
 				id:       company.ID,
 			},
@@ -2311,9 +2957,9 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Invalid Page",
+			name: "Invalid Cursor",
 			query: Query{
-				page:     0,
+				cursor:   "invalid",
 				pageSize: 10,
 				id:       company.ID,
 			},
@@ -2335,7 +2981,6 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 		{
 			name: "No Parameters",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 			},
 			buildStubs: func(store *mockdb.MockStore) {
@@ -2356,7 +3001,6 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 		{
 			name: "To Many Parameters",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				id:       company.ID,
 				name:     company.Name,
@@ -2379,7 +3023,6 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 		{
 			name: "Internal Server Error ListJobsByCompanyID",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				id:       company.ID,
 			},
@@ -2388,6 +3031,9 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 					ListJobsByCompanyID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return([]db.ListJobsByCompanyIDRow{}, sql.ErrConnDone)
+				store.EXPECT().
+					CountJobsByCompanyID(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					ListJobsByCompanyExactName(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -2399,10 +3045,29 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "Internal Server Error CountJobsByCompanyID",
+			query: Query{
+				pageSize: 10,
+				id:       company.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListJobsByCompanyID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(jobByID, nil)
+				store.EXPECT().
+					CountJobsByCompanyID(gomock.Any(), gomock.Eq(company.ID)).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
 		{
 			name: "Internal Server Error ListJobsByCompanyExactName",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				name:     company.Name,
 			},
@@ -2425,7 +3090,6 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 		{
 			name: "Internal Server Error ListJobsByCompanyExactName",
 			query: Query{
-				page:         1,
 				pageSize:     10,
 				nameContains: company.Name[1:3],
 			},
@@ -2465,11 +3129,12 @@ func TestListJobsByCompanyAPI(t *testing.T) {
 
 			// Add query params
 			q := req.URL.Query()
-			q.Add("page", fmt.Sprintf("%d", tc.query.page))
+			q.Add("cursor", tc.query.cursor)
 			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
 			q.Add("name", tc.query.name)
 			q.Add("name_contains", tc.query.nameContains)
 			q.Add("id", fmt.Sprintf("%d", tc.query.id))
+			q.Add("sort", tc.query.sort)
 			req.URL.RawQuery = q.Encode()
 
 			server.router.ServeHTTP(recorder, req)
@@ -2515,15 +3180,17 @@ func TestSearchJobsAPI(t *testing.T) {
 	var pageSize int32 = 10
 
 	type Query struct {
-		page     int32
-		pageSize int32
-		search   string
+		page           int32
+		pageSize       int32
+		search         string
+		workMode       string
+		seniorityLevel string
 	}
 
 	testCases := []struct {
 		name          string
 		query         Query
-		buildStubs    func(client *mockesearch.MockESearchClient)
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
 		checkResponse func(recorder *httptest.ResponseRecorder)
 	}{
 		{
@@ -2533,11 +3200,20 @@ func TestSearchJobsAPI(t *testing.T) {
 				pageSize: pageSize,
 				search:   title,
 			},
-			buildStubs: func(client *mockesearch.MockESearchClient) {
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				client.EXPECT().
-					SearchJobs(gomock.Any(), gomock.Eq(title), gomock.Eq(page), gomock.Eq(pageSize)).
+					SearchJobs(gomock.Any(), gomock.Eq(title), gomock.Eq(esearch.JobFilters{}), gomock.Eq(page), gomock.Eq(pageSize)).
 					Times(1).
 					Return(jobs, nil)
+				store.EXPECT().
+					RecordJobImpressions(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RecordJobImpressionsParams) error {
+						require.Len(t, arg.JobIds, len(jobs))
+						require.Equal(t, job.ID, arg.JobIds[0])
+						require.True(t, strings.HasPrefix(arg.Viewer, "anonymous:"))
+						return nil
+					})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -2545,17 +3221,112 @@ func TestSearchJobsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error",
+			name: "OK With Filters",
+			query: Query{
+				page:           page,
+				pageSize:       pageSize,
+				search:         title,
+				workMode:       string(db.WorkModeRemote),
+				seniorityLevel: string(db.SeniorityLevelSenior),
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				filters := esearch.JobFilters{
+					WorkMode:       string(db.WorkModeRemote),
+					SeniorityLevel: string(db.SeniorityLevelSenior),
+				}
+				client.EXPECT().
+					SearchJobs(gomock.Any(), gomock.Eq(title), gomock.Eq(filters), gomock.Eq(page), gomock.Eq(pageSize)).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					RecordJobImpressions(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RecordJobImpressionsParams) error {
+						require.Len(t, arg.JobIds, len(jobs))
+						require.Equal(t, job.ID, arg.JobIds[0])
+						require.True(t, strings.HasPrefix(arg.Viewer, "anonymous:"))
+						return nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobs(t, recorder.Body, jobs)
+			},
+		},
+		{
+			name: "OK Recording Impressions Fails",
+			query: Query{
+				page:     page,
+				pageSize: pageSize,
+				search:   title,
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				client.EXPECT().
+					SearchJobs(gomock.Any(), gomock.Eq(title), gomock.Eq(esearch.JobFilters{}), gomock.Eq(page), gomock.Eq(pageSize)).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					RecordJobImpressions(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchJobs(t, recorder.Body, jobs)
+			},
+		},
+		{
+			name: "No Results",
 			query: Query{
 				page:     page,
 				pageSize: pageSize,
 				search:   title,
 			},
-			buildStubs: func(client *mockesearch.MockESearchClient) {
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				client.EXPECT().
-					SearchJobs(gomock.Any(), gomock.Eq(title), gomock.Eq(page), gomock.Eq(pageSize)).
+					SearchJobs(gomock.Any(), gomock.Eq(title), gomock.Eq(esearch.JobFilters{}), gomock.Eq(page), gomock.Eq(pageSize)).
+					Times(1).
+					Return([]*esearch.Job{}, nil)
+				store.EXPECT().
+					RecordJobImpressions(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Work Mode",
+			query: Query{
+				page:     page,
+				pageSize: pageSize,
+				search:   title,
+				workMode: "office",
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				client.EXPECT().
+					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			query: Query{
+				page:     page,
+				pageSize: pageSize,
+				search:   title,
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				client.EXPECT().
+					SearchJobs(gomock.Any(), gomock.Eq(title), gomock.Eq(esearch.JobFilters{}), gomock.Eq(page), gomock.Eq(pageSize)).
 					Times(1).
 					Return([]*esearch.Job{}, errors.New("some error"))
+				store.EXPECT().
+					RecordJobImpressions(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -2567,9 +3338,9 @@ func TestSearchJobsAPI(t *testing.T) {
 				pageSize: pageSize,
 				search:   title,
 			},
-			buildStubs: func(client *mockesearch.MockESearchClient) {
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				client.EXPECT().
-					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -2582,9 +3353,9 @@ func TestSearchJobsAPI(t *testing.T) {
 				pageSize: pageSize,
 				page:     page,
 			},
-			buildStubs: func(client *mockesearch.MockESearchClient) {
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				client.EXPECT().
-					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -2597,9 +3368,9 @@ func TestSearchJobsAPI(t *testing.T) {
 				page:   page,
 				search: title,
 			},
-			buildStubs: func(client *mockesearch.MockESearchClient) {
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				client.EXPECT().
-					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -2612,9 +3383,9 @@ func TestSearchJobsAPI(t *testing.T) {
 				pageSize: 50,
 				search:   title,
 			},
-			buildStubs: func(client *mockesearch.MockESearchClient) {
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				client.EXPECT().
-					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -2627,9 +3398,9 @@ func TestSearchJobsAPI(t *testing.T) {
 				page:   0,
 				search: title,
 			},
-			buildStubs: func(client *mockesearch.MockESearchClient) {
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
 				client.EXPECT().
-					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					SearchJobs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -2646,7 +3417,7 @@ func TestSearchJobsAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 
 			client := mockesearch.NewMockESearchClient(ctrl)
-			tc.buildStubs(client)
+			tc.buildStubs(store, client)
 
 			server := newTestServer(t, store, client)
 			recorder := httptest.NewRecorder()
@@ -2660,6 +3431,12 @@ func TestSearchJobsAPI(t *testing.T) {
 			q.Add("page", fmt.Sprintf("%d", tc.query.page))
 			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
 			q.Add("search", tc.query.search)
+			if tc.query.workMode != "" {
+				q.Add("work_mode", tc.query.workMode)
+			}
+			if tc.query.seniorityLevel != "" {
+				q.Add("seniority_level", tc.query.seniorityLevel)
+			}
 			req.URL.RawQuery = q.Encode()
 
 			server.router.ServeHTTP(recorder, req)
@@ -2689,7 +3466,7 @@ func TestListEmployerJobsAPI(t *testing.T) {
 	}
 
 	type Query struct {
-		page     int32
+		cursor   string
 		pageSize int32
 		sort     string // 'date-asc' or 'date-desc'
 	}
@@ -2704,22 +3481,20 @@ func TestListEmployerJobsAPI(t *testing.T) {
 		{
 			name: "OK",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				sort:     "date-asc",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				params := db.ListJobsForEmployerParams{
 					CompanyID:     employer.CompanyID,
-					Limit:         10,
-					Offset:        0,
+					Limit:         11,
 					CreatedAtAsc:  true,
 					CreatedAtDesc: false,
 				}
@@ -2727,6 +3502,10 @@ func TestListEmployerJobsAPI(t *testing.T) {
 					ListJobsForEmployer(gomock.Any(), gomock.Eq(params)).
 					Times(1).
 					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsForEmployer(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(int64(len(jobs)), nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -2734,18 +3513,18 @@ func TestListEmployerJobsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Invalid Page",
+			name: "Invalid Cursor",
 			query: Query{
-				page:     0,
+				cursor:   "invalid",
 				pageSize: 10,
 				sort:     "date-asc",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobsForEmployer(gomock.Any(), gomock.Any()).
@@ -2758,16 +3537,15 @@ func TestListEmployerJobsAPI(t *testing.T) {
 		{
 			name: "Invalid Page Size",
 			query: Query{
-				page:     1,
 				pageSize: 50,
 				sort:     "date-asc",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobsForEmployer(gomock.Any(), gomock.Any()).
@@ -2780,16 +3558,15 @@ func TestListEmployerJobsAPI(t *testing.T) {
 		{
 			name: "Invalid Sort",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				sort:     "invalid",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Any()).
+					GetEmployerByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					ListJobsForEmployer(gomock.Any(), gomock.Any()).
@@ -2802,18 +3579,16 @@ func TestListEmployerJobsAPI(t *testing.T) {
 		{
 			name: "Unauthorized Only Employer Access",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				sort:     "date-desc",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(user.Email)).
-					Times(1).
-					Return(db.Employer{}, sql.ErrNoRows)
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					ListJobsForEmployer(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -2823,18 +3598,17 @@ func TestListEmployerJobsAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetEmployerByEmail",
+			name: "Internal Server Error GetEmployerByID",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				sort:     "date-desc",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(db.Employer{}, sql.ErrConnDone)
 				store.EXPECT().
@@ -2848,22 +3622,51 @@ func TestListEmployerJobsAPI(t *testing.T) {
 		{
 			name: "Internal Server Error ListJobsForEmployer",
 			query: Query{
-				page:     1,
 				pageSize: 10,
 				sort:     "date-desc",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
 					Times(1).
 					Return(employer, nil)
 				store.EXPECT().
 					ListJobsForEmployer(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return([]db.ListJobsForEmployerRow{}, sql.ErrConnDone)
+				store.EXPECT().
+					CountJobsForEmployer(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error CountJobsForEmployer",
+			query: Query{
+				pageSize: 10,
+				sort:     "date-desc",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					ListJobsForEmployer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(jobs, nil)
+				store.EXPECT().
+					CountJobsForEmployer(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -2889,7 +3692,7 @@ func TestListEmployerJobsAPI(t *testing.T) {
 
 			// Add query params
 			q := req.URL.Query()
-			q.Add("page", fmt.Sprintf("%d", tc.query.page))
+			q.Add("cursor", tc.query.cursor)
 			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
 			q.Add("sort", tc.query.sort)
 			req.URL.RawQuery = q.Encode()
@@ -2903,29 +3706,376 @@ func TestListEmployerJobsAPI(t *testing.T) {
 	}
 }
 
+func TestCloneJobAPI(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+	employer.IsEmailVerified = true
+	unverifiedEmployer := employer
+	unverifiedEmployer.IsEmailVerified = false
+	viewer := employer
+	viewer.Role = db.EmployerRoleViewer
+
+	job := generateRandomJob()
+	job.CompanyID = employer.CompanyID
+	otherJob := generateRandomJob()
+	otherJob.CompanyID = employer.CompanyID + 1
+
+	skills := []string{"skill1", "skill2"}
+	var jobSkills []db.ListJobSkillsByJobIDRow
+	for _, skill := range skills {
+		jobSkills = append(jobSkills, db.ListJobSkillsByJobIDRow{
+			ID:    utils.RandomInt(1, 1000),
+			Skill: skill,
+		})
+	}
+
+	clone := job
+	clone.ID = job.ID + 1
+	clone.Status = db.JobStatusPublished
+
+	draftClone := clone
+	draftClone.Location = "Warsaw"
+	draftClone.Status = db.JobStatusDraft
+
+	cloneParams := db.CreateJobParams{
+		Title:          job.Title,
+		Industry:       job.Industry,
+		CompanyID:      employer.CompanyID,
+		Description:    job.Description,
+		Location:       job.Location,
+		SalaryMin:      job.SalaryMin,
+		SalaryMax:      job.SalaryMax,
+		Requirements:   job.Requirements,
+		Status:         db.JobStatusPublished,
+		EmploymentType: job.EmploymentType,
+		WorkMode:       job.WorkMode,
+		SeniorityLevel: job.SeniorityLevel,
+		SalaryCurrency: job.SalaryCurrency,
+		SalaryPeriod:   job.SalaryPeriod,
+	}
+
+	testCases := []struct {
+		name          string
+		jobID         int32
+		body          gin.H
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore, client *mockesearch.MockESearchClient)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(skills, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Eq(db.CreateJobTxParams{
+						CreateJobParams: cloneParams,
+						RequiredSkills:  skills,
+						AuthorID:        employer.ID,
+					})).
+					Times(1).
+					Return(db.CreateJobTxResult{Job: clone}, nil)
+				store.EXPECT().
+					ListJobSkillsByJobID(gomock.Any(), gomock.Eq(db.ListJobSkillsByJobIDParams{JobID: clone.ID, Limit: 10})).
+					Times(1).
+					Return(jobSkills, nil)
+				store.EXPECT().
+					GetCompanyNameByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(company.Name, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(clone.ID)).
+					Times(1).
+					Return(skills, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Eq(int(clone.ID)), gomock.Eq(newESJob(clone, company.Name, skills))).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchJob(t, recorder.Body, clone, jobSkills)
+			},
+		},
+		{
+			name:  "OK Overrides",
+			jobID: job.ID,
+			body: gin.H{
+				"location":        "Warsaw",
+				"required_skills": []string{"go", "go", "sql"},
+				"status":          db.JobStatusDraft,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Any()).
+					Times(0)
+				params := cloneParams
+				params.Location = "Warsaw"
+				params.Status = db.JobStatusDraft
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Eq(db.CreateJobTxParams{
+						CreateJobParams: params,
+						RequiredSkills:  []string{"go", "sql"},
+						AuthorID:        employer.ID,
+					})).
+					Times(1).
+					Return(db.CreateJobTxResult{Job: draftClone}, nil)
+				store.EXPECT().
+					ListJobSkillsByJobID(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListJobSkillsByJobIDRow{}, nil)
+				client.EXPECT().
+					IndexJobAsDocument(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				requireBodyMatchJob(t, recorder.Body, draftClone, []db.ListJobSkillsByJobIDRow{})
+			},
+		},
+		{
+			name:  "Salary Min Greater Than Copied Max",
+			jobID: job.ID,
+			body: gin.H{
+				"salary_min": job.SalaryMax + 1,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(job, nil)
+				store.EXPECT().
+					ListAllJobSkillsByJobID(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(skills, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Work Mode",
+			jobID: job.ID,
+			body: gin.H{
+				"work_mode": "office",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Job Not Found",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(db.Job{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "Job Of Another Company",
+			jobID: otherJob.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Eq(otherJob.ID)).
+					Times(1).
+					Return(otherJob, nil)
+				store.EXPECT().
+					CreateJobTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "Email Not Verified",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(unverifiedEmployer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "Viewer Cannot Clone",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(viewer, nil)
+				store.EXPECT().
+					GetJob(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "Unauthorized",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore, client *mockesearch.MockESearchClient) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			client := mockesearch.NewMockESearchClient(ctrl)
+			tc.buildStubs(store, client)
+
+			server := newTestServer(t, store, client)
+			recorder := httptest.NewRecorder()
+
+			var body io.Reader = http.NoBody
+			if tc.body != nil {
+				data, err := json.Marshal(tc.body)
+				require.NoError(t, err)
+				body = bytes.NewReader(data)
+			}
+
+			url := fmt.Sprintf("%s/jobs/%d/clone", BaseUrl, tc.jobID)
+			req, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
 func generateJob(title, industry, jobLocation string, salaryMin, salaryMax int32) db.Job {
 	return db.Job{
-		ID:           utils.RandomInt(1, 1000),
-		Title:        title,
-		Industry:     industry,
-		Description:  utils.RandomString(5),
-		Location:     jobLocation,
-		SalaryMin:    salaryMin,
-		SalaryMax:    salaryMax,
-		Requirements: utils.RandomString(5),
+		ID:             utils.RandomInt(1, 1000),
+		Title:          title,
+		Industry:       industry,
+		Description:    utils.RandomString(5),
+		Location:       jobLocation,
+		SalaryMin:      salaryMin,
+		SalaryMax:      salaryMax,
+		Requirements:   utils.RandomString(5),
+		Status:         db.JobStatusPublished,
+		EmploymentType: db.EmploymentTypeFullTime,
+		WorkMode:       db.WorkModeOnSite,
+		SeniorityLevel: db.SeniorityLevelMiddle,
+		SalaryCurrency: "USD",
+		SalaryPeriod:   db.SalaryPeriodMonthly,
 	}
 }
 
 func generateRandomJob() db.Job {
 	return db.Job{
-		ID:           utils.RandomInt(1, 1000),
-		Title:        utils.RandomString(4),
-		Industry:     utils.RandomString(2),
-		Description:  utils.RandomString(5),
-		Location:     utils.RandomString(4),
-		SalaryMin:    utils.RandomInt(100, 200),
-		SalaryMax:    utils.RandomInt(201, 300),
-		Requirements: utils.RandomString(5),
+		ID:             utils.RandomInt(1, 1000),
+		Title:          utils.RandomString(4),
+		Industry:       utils.RandomString(2),
+		Description:    utils.RandomString(5),
+		Location:       utils.RandomString(4),
+		SalaryMin:      utils.RandomInt(100, 200),
+		SalaryMax:      utils.RandomInt(201, 300),
+		Requirements:   utils.RandomString(5),
+		Status:         db.JobStatusPublished,
+		EmploymentType: db.EmploymentTypeFullTime,
+		WorkMode:       db.WorkModeOnSite,
+		SeniorityLevel: db.SeniorityLevelMiddle,
+		SalaryCurrency: "USD",
+		SalaryPeriod:   db.SalaryPeriodMonthly,
 	}
 }
 
@@ -2944,6 +4094,9 @@ func requireBodyMatchJob(t *testing.T, body *bytes.Buffer, job db.Job, skills []
 	require.Equal(t, job.SalaryMin, gotJob.SalaryMin)
 	require.Equal(t, job.SalaryMax, gotJob.SalaryMax)
 	require.Equal(t, job.Requirements, gotJob.Requirements)
+	require.Equal(t, job.EmploymentType, gotJob.EmploymentType)
+	require.Equal(t, job.WorkMode, gotJob.WorkMode)
+	require.Equal(t, job.SeniorityLevel, gotJob.SeniorityLevel)
 	require.Equal(t, skills, gotJob.RequiredSkills)
 }
 
@@ -2963,41 +4116,45 @@ func requireBodyMatchJobs(t *testing.T, body *bytes.Buffer, jobs interface{}) {
 
 	switch j := jobs.(type) {
 	case []db.ListJobsByFiltersRow:
-		var gotJobRows []db.ListJobsByFiltersRow
-		err = json.Unmarshal(data, &gotJobRows)
+		var got listResponse[db.ListJobsByFiltersRow]
+		err = json.Unmarshal(data, &got)
 		require.NoError(t, err)
+		gotJobRows := got.Items
 
 		for i := 0; i < len(j); i++ {
 			require.Equal(t, j[i], gotJobRows[i])
 		}
 	case []db.ListJobsMatchingUserSkillsRow:
-		var gotJobRows []db.ListJobsMatchingUserSkillsRow
-		err = json.Unmarshal(data, &gotJobRows)
+		var gotJobs []matchingJobResponse
+		err = json.Unmarshal(data, &gotJobs)
 		require.NoError(t, err)
 
 		for i := 0; i < len(j); i++ {
-			require.Equal(t, j[i], gotJobRows[i])
+			require.Equal(t, newMatchingJobResponse(j[i]), gotJobs[i])
 		}
 	case []db.ListJobsByCompanyExactNameRow:
-		var gotJobRows []db.ListJobsByCompanyExactNameRow
-		err = json.Unmarshal(data, &gotJobRows)
+		var got listResponse[db.ListJobsByCompanyExactNameRow]
+		err = json.Unmarshal(data, &got)
 		require.NoError(t, err)
+		gotJobRows := got.Items
 
 		for i := 0; i < len(j); i++ {
 			require.Equal(t, j[i], gotJobRows[i])
 		}
 	case []db.ListJobsByCompanyNameRow:
-		var gotJobRows []db.ListJobsByCompanyNameRow
-		err = json.Unmarshal(data, &gotJobRows)
+		var got listResponse[db.ListJobsByCompanyNameRow]
+		err = json.Unmarshal(data, &got)
 		require.NoError(t, err)
+		gotJobRows := got.Items
 
 		for i := 0; i < len(j); i++ {
 			require.Equal(t, j[i], gotJobRows[i])
 		}
 	case []db.ListJobsByCompanyIDRow:
-		var gotJobRows []db.ListJobsByCompanyIDRow
-		err = json.Unmarshal(data, &gotJobRows)
+		var got listResponse[db.ListJobsByCompanyIDRow]
+		err = json.Unmarshal(data, &got)
 		require.NoError(t, err)
+		gotJobRows := got.Items
 
 		for i := 0; i < len(j); i++ {
 			require.Equal(t, j[i], gotJobRows[i])
@@ -3020,9 +4177,10 @@ func requireBodyMatchJobs(t *testing.T, body *bytes.Buffer, jobs interface{}) {
 			require.Equal(t, j[i].JobSkills, gotJobRows[i].JobSkills)
 		}
 	case []db.ListJobsForEmployerRow:
-		var gotJobRows []db.ListJobsForEmployerRow
-		err = json.Unmarshal(data, &gotJobRows)
+		var got listResponse[db.ListJobsForEmployerRow]
+		err = json.Unmarshal(data, &got)
 		require.NoError(t, err)
+		gotJobRows := got.Items
 
 		for i := 0; i < len(j); i++ {
 			require.Equal(t, j[i].ID, gotJobRows[i].ID)
@@ -0,0 +1,295 @@
+package api
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	zerolog "github.com/rs/zerolog/log"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// stats of the last 30 days are returned by default
+	defaultJobStatsDays = 30
+	// the stats can be requested for at most a year at once
+	maxJobStatsDays = 366
+	// stats are grouped by day by default
+	defaultJobStatsPeriod = "day"
+)
+
+var (
+	invalidStatsRangeError = errors.New("from must not be after to")
+	statsRangeTooLongError = fmt.Errorf("stats can be requested for at most %d days", maxJobStatsDays)
+)
+
+// jobViewer identifies the viewer of a job, so views are counted once per viewer and day.
+// Signed in users and employers are identified by their account, anonymous viewers
+// by a hash of the IP address and the user agent, so the address is not stored.
+// The routes of the views are public, so the token is only verified, not checked for revocation.
+func (server *Server) jobViewer(ctx *gin.Context) string {
+	fields := strings.Fields(ctx.GetHeader(authorizationHeaderKey))
+	if len(fields) == 2 && strings.ToLower(fields[0]) == authorizationTypeBearer && !strings.HasPrefix(fields[1], apiKeyPrefix) {
+		if payload, err := server.tokenMaker.VerifyToken(fields[1]); err == nil && payload.TokenType == token.TokenTypeAccess {
+			return fmt.Sprintf("%s:%d", payload.Role, payload.SubjectID)
+		}
+	}
+
+	sum := sha256.Sum256([]byte(ctx.ClientIP() + "|" + ctx.Request.UserAgent()))
+	return "anonymous:" + hex.EncodeToString(sum[:16])
+}
+
+// recordJobView records that the details of the job were viewed,
+// the view is only counted, so errors do not fail the request
+func (server *Server) recordJobView(ctx *gin.Context, jobID int32) {
+	err := server.store.RecordJobView(ctx, db.RecordJobViewParams{
+		JobID:  jobID,
+		Viewer: server.jobViewer(ctx),
+	})
+	if err != nil {
+		zerolog.Error().Err(err).Int32("job_id", jobID).Msg("cannot record job view")
+	}
+}
+
+// recordJobImpressions records that the jobs were shown in search results,
+// the impressions are only counted, so errors do not fail the request
+func (server *Server) recordJobImpressions(ctx *gin.Context, jobIDs []int32) {
+	if len(jobIDs) == 0 {
+		return
+	}
+
+	err := server.store.RecordJobImpressions(ctx, db.RecordJobImpressionsParams{
+		Viewer: server.jobViewer(ctx),
+		JobIds: jobIDs,
+	})
+	if err != nil {
+		zerolog.Error().Err(err).Msg("cannot record job impressions")
+	}
+}
+
+type getJobStatsQuery struct {
+	From   time.Time `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To     time.Time `form:"to" time_format:"2006-01-02" time_utc:"1"`
+	Period string    `form:"period" binding:"omitempty,oneof=day week month"`
+}
+
+type jobStatsPoint struct {
+	// PeriodStart is the first day of the period, weeks start on Monday
+	PeriodStart   string `json:"period_start"`
+	Views         int64  `json:"views"`
+	UniqueViewers int64  `json:"unique_viewers"`
+	Impressions   int64  `json:"impressions"`
+	Applications  int64  `json:"applications"`
+	// Conversion is the number of applications per view
+	Conversion float64 `json:"conversion"`
+}
+
+type jobStatsResponse struct {
+	JobID  int32  `json:"job_id"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Period string `json:"period"`
+	// Views of the details of the job, every viewer is counted once per day
+	Views int64 `json:"views"`
+	// UniqueViewers are counted once for the whole range
+	UniqueViewers int64 `json:"unique_viewers"`
+	// Impressions are the appearances of the job in search results, once per viewer and day
+	Impressions  int64           `json:"impressions"`
+	Applications int64           `json:"applications"`
+	Conversion   float64         `json:"conversion"`
+	Points       []jobStatsPoint `json:"points"`
+}
+
+// @Schemes
+// @Summary Get stats of a job
+// @Description Get views, unique viewers, search impressions, applications and the view-to-apply conversion of a job, in total and over time. Views and impressions are counted once per viewer and day. Only employers of the company of the job can access this endpoint.
+// @Tags jobs
+// @Param id path integer true "Job ID"
+// @Param from query string false "First day of the stats, 29 days before to by default" Format(date)
+// @Param to query string false "Last day of the stats, today by default" Format(date)
+// @Param period query string false "Length of the periods of the points, day by default" Enums(day, week, month)
+// @Success 200 {object} jobStatsResponse
+// @Failure 400 {object} ErrorResponse "Invalid job ID, dates or period, or the range is longer than a year"
+// @Failure 401 {object} ErrorResponse "Unauthorized. Only employers can access, not users."
+// @Failure 403 {object} ErrorResponse "Employer is trying to access job that does not belong to them."
+// @Failure 404 {object} ErrorResponse "Job with given ID does not exist"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /jobs/{id}/stats [get]
+// getJobStats returns the stats of a job of the authenticated employer
+func (server *Server) getJobStats(ctx *gin.Context) {
+	var request getJobRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var query getJobStatsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if query.Period == "" {
+		query.Period = defaultJobStatsPeriod
+	}
+	if query.To.IsZero() {
+		query.To = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+	if query.From.IsZero() {
+		query.From = query.To.AddDate(0, 0, -(defaultJobStatsDays - 1))
+	}
+	if query.From.After(query.To) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(invalidStatsRangeError))
+		return
+	}
+	if query.To.Sub(query.From) >= maxJobStatsDays*24*time.Hour {
+		ctx.JSON(http.StatusBadRequest, errorResponse(statsRangeTooLongError))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// get the job to check if it exists and is owned by the employer
+	companyID, err := server.store.GetCompanyIDOfJob(ctx, request.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = fmt.Errorf("job with ID %d does not exist", request.ID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// check if the job belongs to the employer
+	if companyID != authEmployer.CompanyID {
+		err = fmt.Errorf("job with ID %d does not belong to employer with ID %d", request.ID, authEmployer.CompanyID)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	totals, err := server.store.GetJobViewTotals(ctx, db.GetJobViewTotalsParams{
+		JobID:    request.ID,
+		FromDate: query.From,
+		ToDate:   query.To,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	views, err := server.store.ListJobViewsByPeriod(ctx, db.ListJobViewsByPeriodParams{
+		JobID:    request.ID,
+		Period:   query.Period,
+		FromDate: query.From,
+		ToDate:   query.To,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	applications, err := server.store.ListJobApplicationCountsByPeriod(ctx, db.ListJobApplicationCountsByPeriodParams{
+		JobID:    request.ID,
+		Period:   query.Period,
+		FromDate: query.From,
+		ToDate:   query.To,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// every period of the range has a point, also the ones without any views
+	response := jobStatsResponse{
+		JobID:         request.ID,
+		From:          query.From.Format(time.DateOnly),
+		To:            query.To.Format(time.DateOnly),
+		Period:        query.Period,
+		Views:         totals.Views,
+		UniqueViewers: totals.UniqueViewers,
+		Impressions:   totals.Impressions,
+	}
+	points := map[string]*jobStatsPoint{}
+	for start := periodStart(query.From, query.Period); !start.After(query.To); start = nextPeriod(start, query.Period) {
+		response.Points = append(response.Points, jobStatsPoint{PeriodStart: start.Format(time.DateOnly)})
+	}
+	for i := range response.Points {
+		points[response.Points[i].PeriodStart] = &response.Points[i]
+	}
+
+	for _, row := range views {
+		if point, ok := points[row.PeriodStart.Format(time.DateOnly)]; ok {
+			point.Views = row.Views
+			point.UniqueViewers = row.UniqueViewers
+			point.Impressions = row.Impressions
+		}
+	}
+	for _, row := range applications {
+		if point, ok := points[row.PeriodStart.Format(time.DateOnly)]; ok {
+			point.Applications = row.Applications
+		}
+		response.Applications += row.Applications
+	}
+
+	for i := range response.Points {
+		point := &response.Points[i]
+		point.Conversion = conversion(point.Applications, point.Views)
+	}
+	response.Conversion = conversion(response.Applications, response.Views)
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// periodStart returns the first day of the period of the date,
+// weeks start on Monday as in date_trunc of postgres
+func periodStart(date time.Time, period string) time.Time {
+	switch period {
+	case "week":
+		return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	case "month":
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return date
+	}
+}
+
+// nextPeriod returns the first day of the period after the one starting on start
+func nextPeriod(start time.Time, period string) time.Time {
+	switch period {
+	case "week":
+		return start.AddDate(0, 0, 7)
+	case "month":
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// conversion returns the number of applications per view rounded to 4 decimal places,
+// applications of viewers who viewed the job before the range can make it greater than 1
+func conversion(applications, views int64) float64 {
+	if views == 0 {
+		return 0
+	}
+	return math.Round(float64(applications)/float64(views)*10000) / 10000
+}
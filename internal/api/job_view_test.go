@@ -0,0 +1,348 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetJobStatsAPI(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+	user, _ := generateRandomUser(t)
+	job := generateRandomJob()
+	job.CompanyID = company.ID
+
+	date := func(value string) time.Time {
+		d, err := time.Parse(time.DateOnly, value)
+		require.NoError(t, err)
+		return d
+	}
+	from, to := date("2026-01-01"), date("2026-01-31")
+
+	type Query struct {
+		from   string
+		to     string
+		period string
+	}
+
+	testCases := []struct {
+		name          string
+		jobID         int32
+		query         Query
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK By Week",
+			jobID: job.ID,
+			query: Query{from: "2026-01-01", to: "2026-01-31", period: "week"},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(company.ID, nil)
+				store.EXPECT().
+					GetJobViewTotals(gomock.Any(), gomock.Eq(db.GetJobViewTotalsParams{JobID: job.ID, FromDate: from, ToDate: to})).
+					Times(1).
+					Return(db.GetJobViewTotalsRow{Views: 40, UniqueViewers: 25, Impressions: 300}, nil)
+				store.EXPECT().
+					ListJobViewsByPeriod(gomock.Any(), gomock.Eq(db.ListJobViewsByPeriodParams{JobID: job.ID, Period: "week", FromDate: from, ToDate: to})).
+					Times(1).
+					Return([]db.ListJobViewsByPeriodRow{
+						{PeriodStart: date("2025-12-29"), Views: 10, UniqueViewers: 8, Impressions: 100},
+						{PeriodStart: date("2026-01-12"), Views: 30, UniqueViewers: 20, Impressions: 200},
+					}, nil)
+				store.EXPECT().
+					ListJobApplicationCountsByPeriod(gomock.Any(), gomock.Eq(db.ListJobApplicationCountsByPeriodParams{JobID: job.ID, Period: "week", FromDate: from, ToDate: to})).
+					Times(1).
+					Return([]db.ListJobApplicationCountsByPeriodRow{
+						{PeriodStart: date("2026-01-12"), Applications: 3},
+						{PeriodStart: date("2026-01-19"), Applications: 1},
+					}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				stats := requireBodyJobStats(t, recorder.Body)
+				require.Equal(t, job.ID, stats.JobID)
+				require.Equal(t, "week", stats.Period)
+				require.Equal(t, int64(40), stats.Views)
+				require.Equal(t, int64(25), stats.UniqueViewers)
+				require.Equal(t, int64(300), stats.Impressions)
+				require.Equal(t, int64(4), stats.Applications)
+				require.Equal(t, 0.1, stats.Conversion)
+
+				require.Equal(t, []jobStatsPoint{
+					{PeriodStart: "2025-12-29", Views: 10, UniqueViewers: 8, Impressions: 100},
+					{PeriodStart: "2026-01-05"},
+					{PeriodStart: "2026-01-12", Views: 30, UniqueViewers: 20, Impressions: 200, Applications: 3, Conversion: 0.1},
+					{PeriodStart: "2026-01-19", Applications: 1},
+					{PeriodStart: "2026-01-26"},
+				}, stats.Points)
+			},
+		},
+		{
+			name:  "OK Default Range",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				today := time.Now().UTC().Truncate(24 * time.Hour)
+				params := db.GetJobViewTotalsParams{
+					JobID:    job.ID,
+					FromDate: today.AddDate(0, 0, -(defaultJobStatsDays - 1)),
+					ToDate:   today,
+				}
+
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(company.ID, nil)
+				store.EXPECT().
+					GetJobViewTotals(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.GetJobViewTotalsRow{}, nil)
+				store.EXPECT().
+					ListJobViewsByPeriod(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListJobViewsByPeriodRow{}, nil)
+				store.EXPECT().
+					ListJobApplicationCountsByPeriod(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListJobApplicationCountsByPeriodRow{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				stats := requireBodyJobStats(t, recorder.Body)
+				require.Equal(t, defaultJobStatsPeriod, stats.Period)
+				require.Len(t, stats.Points, defaultJobStatsDays)
+				require.Equal(t, stats.From, stats.Points[0].PeriodStart)
+				require.Equal(t, stats.To, stats.Points[defaultJobStatsDays-1].PeriodStart)
+				require.Zero(t, stats.Conversion)
+			},
+		},
+		{
+			name:  "Invalid Period",
+			jobID: job.ID,
+			query: Query{period: "year"},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "From After To",
+			jobID: job.ID,
+			query: Query{from: "2026-02-01", to: "2026-01-31"},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Range Too Long",
+			jobID: job.ID,
+			query: Query{from: "2024-01-01", to: "2026-01-31"},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid Job ID",
+			jobID: 0,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Unauthorized Only Employer Access",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "Job Not Found",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(int32(0), sql.ErrNoRows)
+				store.EXPECT().
+					GetJobViewTotals(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "Job Of Another Company",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(company.ID+1, nil)
+				store.EXPECT().
+					GetJobViewTotals(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal Server Error ListJobViewsByPeriod",
+			jobID: job.ID,
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetCompanyIDOfJob(gomock.Any(), gomock.Eq(job.ID)).
+					Times(1).
+					Return(company.ID, nil)
+				store.EXPECT().
+					GetJobViewTotals(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetJobViewTotalsRow{}, nil)
+				store.EXPECT().
+					ListJobViewsByPeriod(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListJobViewsByPeriodRow{}, sql.ErrConnDone)
+				store.EXPECT().
+					ListJobApplicationCountsByPeriod(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("%s/jobs/%d/stats", BaseUrl, tc.jobID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			q := req.URL.Query()
+			if tc.query.from != "" {
+				q.Add("from", tc.query.from)
+			}
+			if tc.query.to != "" {
+				q.Add("to", tc.query.to)
+			}
+			if tc.query.period != "" {
+				q.Add("period", tc.query.period)
+			}
+			req.URL.RawQuery = q.Encode()
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func requireBodyJobStats(t *testing.T, body *bytes.Buffer) jobStatsResponse {
+	var stats jobStatsResponse
+	err := json.NewDecoder(body).Decode(&stats)
+	require.NoError(t, err)
+	return stats
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/grannnsacker/job-finder-back/internal/lockout"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// invalidCredentialsError is returned for both unknown emails and incorrect passwords,
+	// so it cannot be used to find out which emails are registered
+	invalidCredentialsError   = errors.New("incorrect email or password")
+	tooManyLoginAttemptsError = errors.New("too many failed login attempts, try again later")
+)
+
+var (
+	// loginAccountPolicy limits failed logins to a single account
+	loginAccountPolicy = lockout.Policy{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        5 * time.Minute,
+		LockoutAttempts: 10,
+		LockoutDuration: 15 * time.Minute,
+		Window:          time.Hour,
+	}
+	// loginIPPolicy limits failed logins from a single IP address, it is more
+	// lenient, because many clients can share one address (e.g. behind NAT)
+	loginIPPolicy = lockout.Policy{
+		FreeAttempts:    20,
+		BaseDelay:       time.Second,
+		MaxDelay:        5 * time.Minute,
+		LockoutAttempts: 100,
+		LockoutDuration: time.Hour,
+		Window:          time.Hour,
+	}
+)
+
+// dummyPasswordHash is compared with the password when the account does not exist,
+// so the response takes about as long as for an existing account
+var (
+	dummyPasswordHash     string
+	dummyPasswordHashOnce sync.Once
+)
+
+// loginAccountKey returns the key of failed logins of an account
+func loginAccountKey(role string, email string) string {
+	return fmt.Sprintf("login:%s:%s", role, strings.ToLower(email))
+}
+
+// loginIPKey returns the key of failed logins from the client IP address
+func loginIPKey(ctx *gin.Context) string {
+	return "login:ip:" + ctx.ClientIP()
+}
+
+// checkLoginAttempts checks if logins to the account and from the client IP address
+// are not locked. If they are, it responds with 429 and returns false.
+func (server *Server) checkLoginAttempts(ctx *gin.Context, accountKey string) bool {
+	var wait time.Duration
+	for _, limit := range []struct {
+		limiter *lockout.Limiter
+		key     string
+	}{
+		{server.loginAccountLimiter, accountKey},
+		{server.loginIPLimiter, loginIPKey(ctx)},
+	} {
+		keyWait, err := limit.limiter.Check(ctx, limit.key)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return false
+		}
+		if keyWait > wait {
+			wait = keyWait
+		}
+	}
+
+	if wait > 0 {
+		ctx.Header("Retry-After", fmt.Sprintf("%d", int(math.Ceil(wait.Seconds()))))
+		ctx.JSON(http.StatusTooManyRequests, errorResponse(tooManyLoginAttemptsError))
+		return false
+	}
+
+	return true
+}
+
+// failLogin records a failed login of the account and from the client IP address
+// and responds with the given error (the uniform invalid credentials error for passwords)
+func (server *Server) failLogin(ctx *gin.Context, accountKey string, loginErr error) {
+	err := server.loginAccountLimiter.Fail(ctx, accountKey)
+	if err == nil {
+		err = server.loginIPLimiter.Fail(ctx, loginIPKey(ctx))
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusUnauthorized, errorResponse(loginErr))
+}
+
+// checkPasswordOfMissingAccount spends the same time as checking the password
+// of an existing account, the result is always invalid credentials
+func checkPasswordOfMissingAccount(password string) {
+	dummyPasswordHashOnce.Do(func() {
+		dummyPasswordHash, _ = utils.HashPassword(utils.RandomString(16))
+	})
+	_ = utils.CheckPassword(password, dummyPasswordHash)
+}
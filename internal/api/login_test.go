@@ -0,0 +1,121 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLoginUserLockout(t *testing.T) {
+	user, password := generateRandomUser(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	// the attempt after the free ones starts the backoff, the next one is locked
+	store.EXPECT().
+		GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+		Times(loginAccountPolicy.FreeAttempts + 1).
+		Return(user, nil)
+	store.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	server := newTestServer(t, store, nil)
+
+	for i := 0; i <= loginAccountPolicy.FreeAttempts; i++ {
+		recorder := sendLoginRequest(t, server, "/users/login", user.Email, password+"wrong")
+		requireInvalidCredentials(t, recorder)
+	}
+
+	// even the correct password is rejected while locked
+	recorder := sendLoginRequest(t, server, "/users/login", user.Email, password)
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.NotEmpty(t, recorder.Header().Get("Retry-After"))
+}
+
+func TestLoginLockoutUnknownAccount(t *testing.T) {
+	email := utils.RandomEmail()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetEmployerByEmail(gomock.Any(), gomock.Eq(email)).
+		Times(loginAccountPolicy.FreeAttempts + 1).
+		Return(db.Employer{}, sql.ErrNoRows)
+
+	server := newTestServer(t, store, nil)
+
+	// unknown accounts are locked the same way, so lockouts do not reveal registered emails
+	for i := 0; i <= loginAccountPolicy.FreeAttempts; i++ {
+		recorder := sendLoginRequest(t, server, "/employers/login", email, utils.RandomString(8))
+		requireInvalidCredentials(t, recorder)
+	}
+
+	recorder := sendLoginRequest(t, server, "/employers/login", email, utils.RandomString(8))
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+}
+
+func TestLoginIPLockout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetUserByEmail(gomock.Any(), gomock.Any()).
+		Times(loginIPPolicy.FreeAttempts + 1).
+		Return(db.User{}, sql.ErrNoRows)
+
+	server := newTestServer(t, store, nil)
+
+	// every attempt uses a different account, but they all come from the same IP address
+	for i := 0; i <= loginIPPolicy.FreeAttempts; i++ {
+		recorder := sendLoginRequest(t, server, "/users/login", utils.RandomEmail(), utils.RandomString(8))
+		requireInvalidCredentials(t, recorder)
+	}
+
+	recorder := sendLoginRequest(t, server, "/users/login", utils.RandomEmail(), utils.RandomString(8))
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+}
+
+func sendLoginRequest(t *testing.T, server *Server, path string, email string, password string) *httptest.ResponseRecorder {
+	data, err := json.Marshal(gin.H{
+		"email":    email,
+		"password": password,
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, BaseUrl+path, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+// requireInvalidCredentials checks if the response is the uniform login error
+func requireInvalidCredentials(t *testing.T, recorder *httptest.ResponseRecorder) {
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	data, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+
+	var res ErrorResponse
+	err = json.Unmarshal(data, &res)
+	require.NoError(t, err)
+	require.Equal(t, invalidCredentialsError.Error(), res.Error)
+}
@@ -15,17 +15,14 @@ import (
 
 func newTestServer(t *testing.T, store db.Store, client esearch.ESearchClient) *Server {
 	cfg := config.Config{
-		TokenSymmetricKey:   utils.RandomString(32),
-		AccessTokenDuration: time.Minute,
+		TokenSymmetricKey:    utils.RandomString(32),
+		AccessTokenDuration:  time.Minute,
+		RefreshTokenDuration: time.Minute,
 	}
 	q := rabbitmq.Queue{}
 	server, err := NewServer(cfg, store, client, nil, q)
 	require.NoError(t, err)
 
-	if client != nil {
-		server.esDetails.lastDocumentIndex = 1
-	}
-
 	return server
 }
 
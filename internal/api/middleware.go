@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/internal/revocation"
 	"github.com/grannnsacker/job-finder-back/pkg/token"
 	"net/http"
 	"strings"
@@ -15,35 +17,61 @@ const (
 	authorizationPayloadKey = "authorization_payload"
 )
 
+// accountNotFoundError is returned when the token is valid,
+// but the account it was issued for does not exist anymore
+var accountNotFoundError = errors.New("account of the authenticated token does not exist")
+
+var revokedTokenError = errors.New("token has been revoked")
+
+var notAccessTokenError = errors.New("only access tokens can be used for authorization")
+
+var twoFactorChallengeTokenError = errors.New("two-factor challenge token cannot be used for authorization")
+
+// revocationSubject returns the subject used to revoke all tokens of an account
+func revocationSubject(role string, subjectID int32) string {
+	return fmt.Sprintf("%s:%d", role, subjectID)
+}
+
 // AuthMiddleware creates a gin middleware for authorization
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+func authMiddleware(tokenMaker token.Maker, revocationStore revocation.Store) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
+		accessToken, ok := bearerToken(ctx)
+		if !ok {
+			return
+		}
 
-		if len(authorizationHeader) == 0 {
-			err := errors.New("authorization header was not provided")
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		// company API keys are only accepted by routes using apiKeyAuthMiddleware
+		if strings.HasPrefix(accessToken, apiKeyPrefix) {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(apiKeyNotAllowedError))
 			return
 		}
 
-		fields := strings.Fields(authorizationHeader)
-		if len(fields) < 2 {
-			err := errors.New("invalid authorization header format")
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		payload, ok := verifyAccessToken(ctx, tokenMaker, revocationStore, accessToken)
+		if !ok {
 			return
 		}
 
-		authorizationType := strings.ToLower(fields[0])
-		if authorizationType != authorizationTypeBearer {
-			err := fmt.Errorf("unsupported authorization type %s", authorizationType)
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		ctx.Set(authorizationPayloadKey, payload)
+		ctx.Next()
+	}
+}
+
+// apiKeyAuthMiddleware creates a gin middleware for authorization
+// that accepts company API keys in place of access tokens
+func apiKeyAuthMiddleware(tokenMaker token.Maker, revocationStore revocation.Store, store db.Store) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		accessToken, ok := bearerToken(ctx)
+		if !ok {
 			return
 		}
 
-		accessToken := fields[1]
-		payload, err := tokenMaker.VerifyToken(accessToken)
-		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		var payload *token.Payload
+		if strings.HasPrefix(accessToken, apiKeyPrefix) {
+			payload, ok = verifyAPIKey(ctx, store, accessToken)
+		} else {
+			payload, ok = verifyAccessToken(ctx, tokenMaker, revocationStore, accessToken)
+		}
+		if !ok {
 			return
 		}
 
@@ -51,3 +79,124 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// bearerToken gets the token from the authorization header,
+// if the header is missing or invalid the request is aborted
+func bearerToken(ctx *gin.Context) (string, bool) {
+	authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
+
+	if len(authorizationHeader) == 0 {
+		err := errors.New("authorization header was not provided")
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		return "", false
+	}
+
+	fields := strings.Fields(authorizationHeader)
+	if len(fields) < 2 {
+		err := errors.New("invalid authorization header format")
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		return "", false
+	}
+
+	authorizationType := strings.ToLower(fields[0])
+	if authorizationType != authorizationTypeBearer {
+		err := fmt.Errorf("unsupported authorization type %s", authorizationType)
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		return "", false
+	}
+
+	return fields[1], true
+}
+
+// verifyAccessToken verifies the access token and checks that it was not revoked,
+// if the token cannot be used the request is aborted
+func verifyAccessToken(ctx *gin.Context, tokenMaker token.Maker, revocationStore revocation.Store, accessToken string) (*token.Payload, bool) {
+	payload, err := tokenMaker.VerifyToken(accessToken)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+		return nil, false
+	}
+
+	// refresh tokens can only be used to renew the access token
+	if payload.TokenType != token.TokenTypeAccess {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(notAccessTokenError))
+		return nil, false
+	}
+
+	// challenge tokens only prove the password, not the second factor
+	if payload.Role == token.RoleEmployerTwoFactor {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(twoFactorChallengeTokenError))
+		return nil, false
+	}
+
+	// check if the token was not revoked, e.g. on logout
+	revoked, err := revocationStore.IsRevoked(ctx, payload.ID)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+		return nil, false
+	}
+	if revoked {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(revokedTokenError))
+		return nil, false
+	}
+
+	// check if all tokens of the account were not revoked, e.g. on password reset
+	revoked, err = revocationStore.IsSubjectRevoked(ctx, revocationSubject(payload.Role, payload.SubjectID), payload.IssuedAt)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
+		return nil, false
+	}
+	if revoked {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(revokedTokenError))
+		return nil, false
+	}
+
+	return payload, true
+}
+
+// requireRole creates a gin middleware that only lets through requests
+// authenticated with a token issued for one of the given roles.
+// It has to be used after authMiddleware.
+func requireRole(err error, roles ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		for _, role := range roles {
+			if authPayload.Role == role {
+				ctx.Next()
+				return
+			}
+		}
+
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
+	}
+}
+
+// requireUser creates a gin middleware that rejects requests not made by users
+func requireUser() gin.HandlerFunc {
+	return requireRole(onlyUsersAccessError, token.RoleUser)
+}
+
+// requireEmployer creates a gin middleware that rejects requests not made by employers
+func requireEmployer() gin.HandlerFunc {
+	return requireRole(onlyEmployersAccessError, token.RoleEmployer)
+}
+
+// requireEmployerScope creates a gin middleware that rejects requests not made by employers,
+// requests made with company API keys also need the given scope.
+// It has to be used after apiKeyAuthMiddleware.
+func requireEmployerScope(scope string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		if authPayload.Role != token.RoleEmployer {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(onlyEmployersAccessError))
+			return
+		}
+
+		if !authPayload.HasScope(scope) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(missingAPIKeyScopeError(scope)))
+			return
+		}
+
+		ctx.Next()
+	}
+}
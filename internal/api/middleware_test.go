@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/grannnsacker/job-finder-back/pkg/token"
@@ -17,10 +18,13 @@ func addAuthorization(
 	tokenMaker token.Maker,
 	authorizationType string,
 	email string,
+	role string,
+	subjectID int32,
 	duration time.Duration,
 ) {
-	tkn, err := tokenMaker.CreateToken(email, duration)
+	tkn, payload, err := tokenMaker.CreateToken(email, role, subjectID, duration)
 	require.NoError(t, err)
+	require.NotEmpty(t, payload)
 
 	authorizationHeader := fmt.Sprintf("%s %s", authorizationType, tkn)
 	request.Header.Set(authorizationHeaderKey, authorizationHeader)
@@ -35,7 +39,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "OK",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", token.RoleUser, 1, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -51,7 +55,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "Unsupported authorization type",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, "unsupported auth type", "user@example.com", time.Minute)
+				addAuthorization(t, r, maker, "unsupported auth type", "user@example.com", token.RoleUser, 1, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -60,7 +64,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "Invalid authorization format",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, "", "user@example.com", time.Minute)
+				addAuthorization(t, r, maker, "", "user@example.com", token.RoleUser, 1, time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -69,7 +73,7 @@ func TestAuthMiddleware(t *testing.T) {
 		{
 			name: "Expired token",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", -time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, "user@example.com", token.RoleUser, 1, -time.Minute)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -83,7 +87,7 @@ func TestAuthMiddleware(t *testing.T) {
 			authPath := "/auth"
 			server.router.GET(
 				authPath,
-				authMiddleware(server.tokenMaker),
+				authMiddleware(server.tokenMaker, server.revocationStore),
 				func(ctx *gin.Context) {
 					ctx.JSON(http.StatusOK, gin.H{})
 				},
@@ -99,3 +103,126 @@ func TestAuthMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMiddlewareRevokedToken(t *testing.T) {
+	server := newTestServer(t, nil, nil) // nil because for middleware tests db is not needed
+	authPath := "/auth"
+	server.router.GET(
+		authPath,
+		authMiddleware(server.tokenMaker, server.revocationStore),
+		func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gin.H{})
+		},
+	)
+
+	tkn, payload, err := server.tokenMaker.CreateToken("user@example.com", token.RoleUser, 1, time.Minute)
+	require.NoError(t, err)
+
+	err = server.revocationStore.Revoke(context.Background(), payload.ID, payload.ExpiredAt)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, authPath, nil)
+	require.NoError(t, err)
+	req.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, tkn))
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestAuthMiddlewareRefreshToken(t *testing.T) {
+	server := newTestServer(t, nil, nil) // nil because for middleware tests db is not needed
+	authPath := "/auth"
+	server.router.GET(
+		authPath,
+		authMiddleware(server.tokenMaker, server.revocationStore),
+		func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gin.H{})
+		},
+	)
+
+	// refresh tokens live longer than access tokens, so they cannot be used for authorization
+	tkn, _, err := server.tokenMaker.CreateRefreshToken("user@example.com", token.RoleUser, 1, time.Minute)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, authPath, nil)
+	require.NoError(t, err)
+	req.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, tkn))
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestRequireRoleMiddleware(t *testing.T) {
+	testCases := []struct {
+		name          string
+		middleware    gin.HandlerFunc
+		role          string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "User OK",
+			middleware: requireUser(),
+			role:       token.RoleUser,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "Employer OK",
+			middleware: requireEmployer(),
+			role:       token.RoleEmployer,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "Employer Accessing User Route",
+			middleware: requireUser(),
+			role:       token.RoleEmployer,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:       "User Accessing Employer Route",
+			middleware: requireEmployer(),
+			role:       token.RoleUser,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:       "Admin Accessing User Route",
+			middleware: requireUser(),
+			role:       token.RoleAdmin,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil, nil) // nil because for middleware tests db is not needed
+			authPath := "/auth"
+			server.router.GET(
+				authPath,
+				authMiddleware(server.tokenMaker, server.revocationStore),
+				tc.middleware,
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+
+			recorder := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, "user@example.com", tc.role, 1, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"time"
+)
+
+var invalidCursorError = errors.New("cursor is invalid, use next_cursor of the previous page with the same sort")
+
+// listResponse is the response of the list endpoints. Items are paginated
+// with a cursor, next_cursor is passed as the cursor query parameter
+// to get the next page and is empty on the last page.
+type listResponse[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int64  `json:"total"`
+	NextCursor string `json:"next_cursor"`
+}
+
+// pageCursor points at the last item of a page,
+// the next page starts right after it
+type pageCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int32     `json:"id"`
+	// Sort is the order of the list the cursor was created for,
+	// the cursor cannot be used to get a page of the list in another order
+	Sort string `json:"sort,omitempty"`
+	// the value of the item in the sort by salary or company, the next page
+	// starts after this value even if the item was changed or deleted since
+	Salary         int32           `json:"salary,omitempty"`
+	SalaryCurrency string          `json:"salary_currency,omitempty"`
+	SalaryPeriod   db.SalaryPeriod `json:"salary_period,omitempty"`
+	CompanyName    string          `json:"company_name,omitempty"`
+}
+
+// encode encodes the cursor as an opaque string that is safe to use in a query
+func (cursor pageCursor) encode() string {
+	// marshalling a struct of a time, ints and strings cannot fail
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor decodes the cursor from the request of a list in the given sort,
+// an empty cursor means the first page and is returned as a zero cursor
+func decodeCursor(cursor string, sort string) (pageCursor, error) {
+	if cursor == "" {
+		return pageCursor{}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return pageCursor{}, invalidCursorError
+	}
+
+	var c pageCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.CreatedAt.IsZero() || c.ID < 1 || c.Sort != sort {
+		return pageCursor{}, invalidCursorError
+	}
+
+	// the next page cannot be found without the value of the sort
+	switch sort {
+	case "salary-desc", "salary-asc":
+		if c.SalaryCurrency == "" || c.SalaryPeriod == "" {
+			return pageCursor{}, invalidCursorError
+		}
+	case "company":
+		if c.CompanyName == "" {
+			return pageCursor{}, invalidCursorError
+		}
+	}
+
+	return c, nil
+}
+
+// createdAt returns CreatedAt for a query, it is not valid for the first page
+func (cursor pageCursor) createdAt() sql.NullTime {
+	return sql.NullTime{Time: cursor.CreatedAt, Valid: !cursor.CreatedAt.IsZero()}
+}
+
+// salary returns Salary for a query, it is only valid in the sorts by salary
+func (cursor pageCursor) salary() sql.NullInt32 {
+	return sql.NullInt32{Int32: cursor.Salary, Valid: cursor.SalaryCurrency != ""}
+}
+
+// salaryCurrency returns SalaryCurrency for a query, it is only valid in the sorts by salary
+func (cursor pageCursor) salaryCurrency() sql.NullString {
+	return sql.NullString{String: cursor.SalaryCurrency, Valid: cursor.SalaryCurrency != ""}
+}
+
+// salaryPeriod returns SalaryPeriod for a query, it is only valid in the sorts by salary
+func (cursor pageCursor) salaryPeriod() db.NullSalaryPeriod {
+	return db.NullSalaryPeriod{SalaryPeriod: cursor.SalaryPeriod, Valid: cursor.SalaryPeriod != ""}
+}
+
+// companyName returns CompanyName for a query, it is only valid in the sort by company
+func (cursor pageCursor) companyName() sql.NullString {
+	return sql.NullString{String: cursor.CompanyName, Valid: cursor.CompanyName != ""}
+}
+
+// newListResponse creates a list response from rows in the given sort that were fetched
+// with a limit of pageSize + 1, the extra row only tells that there is a next page
+func newListResponse[T any](rows []T, pageSize int32, total int64, sort string, cursorOf func(T) pageCursor) listResponse[T] {
+	res := listResponse[T]{
+		Items: rows,
+		Total: total,
+	}
+
+	if int32(len(rows)) > pageSize {
+		res.Items = rows[:pageSize]
+		cursor := cursorOf(res.Items[pageSize-1])
+		cursor.Sort = sort
+		res.NextCursor = cursor.encode()
+	}
+
+	return res
+}
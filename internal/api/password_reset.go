@@ -0,0 +1,232 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"net/http"
+	"time"
+)
+
+var invalidPasswordResetError = errors.New("reset code is invalid, already used or expired")
+
+const (
+	passwordResetCodeLength  = 32
+	passwordResetSentMessage = "if an account with this email exists, a reset code has been sent to it"
+)
+
+type forgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type resetPasswordRequest struct {
+	Email       string `json:"email" binding:"required,email"`
+	Code        string `json:"code" binding:"required,len=32"`
+	NewPassword string `json:"new_password" binding:"required,min=6"`
+}
+
+type passwordResetResponse struct {
+	Message string `json:"message"`
+}
+
+// createPasswordReset creates a new reset code for the account and sends it by email
+func (server *Server) createPasswordReset(ctx *gin.Context, accountType string, accountID int32, email string, fullName string) error {
+	code, err := utils.RandomSecret(passwordResetCodeLength)
+	if err != nil {
+		return err
+	}
+
+	params := db.CreatePasswordResetTxParams{
+		CreatePasswordResetParams: db.CreatePasswordResetParams{
+			Email:       email,
+			AccountType: accountType,
+			AccountID:   accountID,
+			HashedCode:  utils.HashSecret(code),
+		},
+		AfterCreate: func(passwordReset db.PasswordReset) error {
+			return server.sendPasswordResetEmail(fullName, email, code)
+		},
+	}
+
+	_, err = server.store.CreatePasswordResetTx(ctx, params)
+	return err
+}
+
+// sendPasswordResetEmail sends an email with the password reset code
+func (server *Server) sendPasswordResetEmail(fullName string, email string, code string) error {
+	subject := "Password reset"
+	content := fmt.Sprintf(`Hello %s,<br/>
+	We received a request to reset your password.<br/>
+	Your reset code is <b>%s</b>, it is valid for 15 minutes.<br/>
+	If you did not request it, you can ignore this email.<br/>
+	`, fullName, code)
+
+	err := server.emailSender.SendEmail(subject, content, []string{email})
+	if err != nil {
+		return fmt.Errorf("cannot send password reset email: %w", err)
+	}
+
+	return nil
+}
+
+// resetPassword sets the new password using the reset code and revokes
+// all existing tokens (sessions and access tokens) of the account
+func (server *Server) resetPassword(ctx *gin.Context, accountType string, role string) {
+	var request resetPasswordRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	hashedPassword, err := utils.HashPassword(request.NewPassword)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	issuedBefore := time.Now()
+	txResult, err := server.store.ResetPasswordTx(ctx, db.ResetPasswordTxParams{
+		UsePasswordResetParams: db.UsePasswordResetParams{
+			Email:       request.Email,
+			AccountType: accountType,
+			HashedCode:  utils.HashSecret(request.Code),
+		},
+		HashedPassword: hashedPassword,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(invalidPasswordResetError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// refresh tokens are blocked together with the sessions,
+	// access tokens issued before the reset have to be revoked too
+	subject := revocationSubject(role, txResult.PasswordReset.AccountID)
+	err = server.revocationStore.RevokeSubject(ctx, subject, issuedBefore, server.config.AccessTokenDuration)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, passwordResetResponse{Message: "password has been reset successfully"})
+}
+
+// @Schemes
+// @Summary Forgot user password
+// @Description Send a one-time password reset code to the email of the user. The response is the same whether the user exists or not.
+// @Tags users
+// @Accept json
+// @Produce json
+// @param ForgotPasswordRequest body forgotPasswordRequest true "User email"
+// @Success 200 {object} passwordResetResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Router /users/password/forgot [post]
+// forgotUserPassword handles sending a password reset code to a user
+func (server *Server) forgotUserPassword(ctx *gin.Context) {
+	var request forgotPasswordRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	user, err := server.store.GetUserByEmail(ctx, request.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusOK, passwordResetResponse{Message: passwordResetSentMessage})
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = server.createPasswordReset(ctx, db.AccountTypeUser, user.ID, user.Email, user.FullName)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, passwordResetResponse{Message: passwordResetSentMessage})
+}
+
+// @Schemes
+// @Summary Reset user password
+// @Description Set a new password of the user using the code sent by email. All sessions and tokens of the user are revoked.
+// @Tags users
+// @Accept json
+// @Produce json
+// @param ResetPasswordRequest body resetPasswordRequest true "Email, reset code and new password"
+// @Success 200 {object} passwordResetResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 404 {object} ErrorResponse "Invalid, already used or expired code"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Router /users/password/reset [post]
+// resetUserPassword handles resetting the password of a user
+func (server *Server) resetUserPassword(ctx *gin.Context) {
+	server.resetPassword(ctx, db.AccountTypeUser, token.RoleUser)
+}
+
+// @Schemes
+// @Summary Forgot employer password
+// @Description Send a one-time password reset code to the email of the employer. The response is the same whether the employer exists or not.
+// @Tags employers
+// @Accept json
+// @Produce json
+// @param ForgotPasswordRequest body forgotPasswordRequest true "Employer email"
+// @Success 200 {object} passwordResetResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Router /employers/password/forgot [post]
+// forgotEmployerPassword handles sending a password reset code to an employer
+func (server *Server) forgotEmployerPassword(ctx *gin.Context) {
+	var request forgotPasswordRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	employer, err := server.store.GetEmployerByEmail(ctx, request.Email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusOK, passwordResetResponse{Message: passwordResetSentMessage})
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = server.createPasswordReset(ctx, db.AccountTypeEmployer, employer.ID, employer.Email, employer.FullName)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, passwordResetResponse{Message: passwordResetSentMessage})
+}
+
+// @Schemes
+// @Summary Reset employer password
+// @Description Set a new password of the employer using the code sent by email. All sessions and tokens of the employer are revoked.
+// @Tags employers
+// @Accept json
+// @Produce json
+// @param ResetPasswordRequest body resetPasswordRequest true "Email, reset code and new password"
+// @Success 200 {object} passwordResetResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 404 {object} ErrorResponse "Invalid, already used or expired code"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Router /employers/password/reset [post]
+// resetEmployerPassword handles resetting the password of an employer
+func (server *Server) resetEmployerPassword(ctx *gin.Context) {
+	server.resetPassword(ctx, db.AccountTypeEmployer, token.RoleEmployer)
+}
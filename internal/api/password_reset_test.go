@@ -0,0 +1,511 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type eqCreatePasswordResetTxParamsMatcher struct {
+	email       string
+	accountType string
+	accountID   int32
+}
+
+func (e eqCreatePasswordResetTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.CreatePasswordResetTxParams)
+	if !ok {
+		return false
+	}
+
+	if actualArg.Email != e.email || actualArg.AccountType != e.accountType || actualArg.AccountID != e.accountID {
+		return false
+	}
+
+	// the code is never stored in plain text, only its hash
+	if len(actualArg.HashedCode) != 64 {
+		return false
+	}
+
+	err := actualArg.AfterCreate(db.PasswordReset{
+		ID:          int64(utils.RandomInt(1, 1000)),
+		Email:       actualArg.Email,
+		AccountType: actualArg.AccountType,
+		AccountID:   actualArg.AccountID,
+		HashedCode:  actualArg.HashedCode,
+	})
+	return err == nil
+}
+
+func (e eqCreatePasswordResetTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches email %v, account type %v and account ID %v", e.email, e.accountType, e.accountID)
+}
+
+func EqCreatePasswordResetTxParams(email string, accountType string, accountID int32) gomock.Matcher {
+	return eqCreatePasswordResetTxParamsMatcher{email, accountType, accountID}
+}
+
+type eqResetPasswordTxParamsMatcher struct {
+	arg      db.UsePasswordResetParams
+	password string
+}
+
+func (e eqResetPasswordTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.ResetPasswordTxParams)
+	if !ok {
+		return false
+	}
+
+	if actualArg.UsePasswordResetParams != e.arg {
+		return false
+	}
+
+	err := utils.CheckPassword(e.password, actualArg.HashedPassword)
+	return err == nil
+}
+
+func (e eqResetPasswordTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v and password %v", e.arg, e.password)
+}
+
+func EqResetPasswordTxParams(arg db.UsePasswordResetParams, password string) gomock.Matcher {
+	return eqResetPasswordTxParamsMatcher{arg, password}
+}
+
+func TestForgotUserPasswordAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"email": user.Email,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					CreatePasswordResetTx(gomock.Any(), EqCreatePasswordResetTxParams(user.Email, db.AccountTypeUser, user.ID)).
+					Times(1).
+					Return(db.CreatePasswordResetTxResult{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "User Not Found",
+			body: gin.H{
+				"email": user.Email,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreatePasswordResetTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				// the same response as for an existing user
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error CreatePasswordResetTx",
+			body: gin.H{
+				"email": user.Email,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					CreatePasswordResetTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreatePasswordResetTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Email",
+			body: gin.H{
+				"email": "invalid",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreatePasswordResetTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := BaseUrl + "/users/password/forgot"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestResetUserPasswordAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+	code := utils.RandomString(32)
+	newPassword := utils.RandomString(8)
+
+	useParams := db.UsePasswordResetParams{
+		Email:       user.Email,
+		AccountType: db.AccountTypeUser,
+		HashedCode:  utils.HashSecret(code),
+	}
+	passwordReset := db.PasswordReset{
+		ID:          int64(utils.RandomInt(1, 1000)),
+		Email:       user.Email,
+		AccountType: db.AccountTypeUser,
+		AccountID:   user.ID,
+		HashedCode:  useParams.HashedCode,
+		IsUsed:      true,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"email":        user.Email,
+				"code":         code,
+				"new_password": newPassword,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResetPasswordTx(gomock.Any(), EqResetPasswordTxParams(useParams, newPassword)).
+					Times(1).
+					Return(db.ResetPasswordTxResult{PasswordReset: passwordReset}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Or Expired Code",
+			body: gin.H{
+				"email":        user.Email,
+				"code":         code,
+				"new_password": newPassword,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResetPasswordTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResetPasswordTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			body: gin.H{
+				"email":        user.Email,
+				"code":         code,
+				"new_password": newPassword,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResetPasswordTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResetPasswordTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Code Length",
+			body: gin.H{
+				"email":        user.Email,
+				"code":         utils.RandomString(31),
+				"new_password": newPassword,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResetPasswordTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Password Too Short",
+			body: gin.H{
+				"email":        user.Email,
+				"code":         code,
+				"new_password": "12345",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ResetPasswordTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := BaseUrl + "/users/password/reset"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestResetUserPasswordRevokesTokens(t *testing.T) {
+	user, _ := generateRandomUser(t)
+	code := utils.RandomString(32)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ResetPasswordTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.ResetPasswordTxResult{
+			PasswordReset: db.PasswordReset{
+				Email:       user.Email,
+				AccountType: db.AccountTypeUser,
+				AccountID:   user.ID,
+			},
+		}, nil)
+	store.EXPECT().
+		GetUserDetailsByEmail(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	server := newTestServer(t, store, nil)
+
+	// token issued before the reset
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Email, token.RoleUser, user.ID, time.Minute)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(gin.H{
+		"email":        user.Email,
+		"code":         code,
+		"new_password": utils.RandomString(8),
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, BaseUrl+"/users/password/reset", bytes.NewReader(data))
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodGet, BaseUrl+"/users", nil)
+	require.NoError(t, err)
+	req.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken))
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestForgotEmployerPasswordAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					CreatePasswordResetTx(gomock.Any(), EqCreatePasswordResetTxParams(employer.Email, db.AccountTypeEmployer, employer.ID)).
+					Times(1).
+					Return(db.CreatePasswordResetTxResult{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Employer Not Found",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreatePasswordResetTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error GetEmployerByEmail",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrConnDone)
+				store.EXPECT().
+					CreatePasswordResetTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"email": employer.Email})
+			require.NoError(t, err)
+
+			url := BaseUrl + "/employers/password/forgot"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestResetEmployerPasswordAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	code := utils.RandomString(32)
+	newPassword := utils.RandomString(8)
+
+	useParams := db.UsePasswordResetParams{
+		Email:       employer.Email,
+		AccountType: db.AccountTypeEmployer,
+		HashedCode:  utils.HashSecret(code),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ResetPasswordTx(gomock.Any(), EqResetPasswordTxParams(useParams, newPassword)).
+		Times(1).
+		Return(db.ResetPasswordTxResult{
+			PasswordReset: db.PasswordReset{
+				Email:       employer.Email,
+				AccountType: db.AccountTypeEmployer,
+				AccountID:   employer.ID,
+			},
+		}, nil)
+
+	server := newTestServer(t, store, nil)
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{
+		"email":        employer.Email,
+		"code":         code,
+		"new_password": newPassword,
+	})
+	require.NoError(t, err)
+
+	url := BaseUrl + "/employers/password/reset"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	revoked, err := server.revocationStore.IsSubjectRevoked(req.Context(), revocationSubject(token.RoleEmployer, employer.ID), time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	require.True(t, revoked)
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/grannnsacker/job-finder-back/internal/config"
 	"github.com/grannnsacker/job-finder-back/internal/db/sqlc"
 	"github.com/grannnsacker/job-finder-back/internal/esearch"
+	"github.com/grannnsacker/job-finder-back/internal/lockout"
+	"github.com/grannnsacker/job-finder-back/internal/mail"
+	"github.com/grannnsacker/job-finder-back/internal/revocation"
 	"github.com/grannnsacker/job-finder-back/pkg/token"
 	rabbitmq "github.com/streadway/amqp"
 	swaggerfiles "github.com/swaggo/files"
@@ -19,19 +22,23 @@ var BaseUrl string
 
 // Server serves HTTP  requests for the service
 type Server struct {
-	config     config.Config
-	store      db.Store
-	tokenMaker token.Maker
-	router     *gin.Engine
-	esDetails  elasticSearchDetails
-	ch         *rabbitmq.Channel
-	q          rabbitmq.Queue
+	config          config.Config
+	store           db.Store
+	tokenMaker      token.Maker
+	revocationStore revocation.Store
+	emailSender     mail.EmailSender
+	// failed logins are limited per account and per client IP address
+	loginAccountLimiter *lockout.Limiter
+	loginIPLimiter      *lockout.Limiter
+	router              *gin.Engine
+	esDetails           elasticSearchDetails
+	ch                  *rabbitmq.Channel
+	q                   rabbitmq.Queue
 }
 
 type elasticSearchDetails struct {
-	client            esearch.ESearchClient
-	jobs              []esearch.Job
-	lastDocumentIndex int64
+	client esearch.ESearchClient
+	jobs   []esearch.Job
 }
 
 // NewServer creates a new HTTP server and setups routing
@@ -39,23 +46,52 @@ func NewServer(config config.Config, store db.Store, client esearch.ESearchClien
 	BaseUrl = config.BaseUrl
 
 	// === tokens ===
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+	tokenMaker, err := newTokenMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	// === revoked tokens ===
+	// Redis is shared by all instances of the app, without it
+	// revoked tokens are only kept in memory of this instance
+	var revocationStore revocation.Store
+	if config.RedisAddress != "" {
+		revocationStore = revocation.NewRedisStore(config.RedisAddress)
+	} else {
+		revocationStore = revocation.NewMemoryStore()
+	}
+
+	// === failed logins ===
+	// the same as revoked tokens, without Redis every instance counts failed logins on its own
+	var failedAttemptsStore lockout.Store
+	if config.RedisAddress != "" {
+		failedAttemptsStore = lockout.NewRedisStore(config.RedisAddress)
+	} else {
+		failedAttemptsStore = lockout.NewMemoryStore()
+	}
+
+	// === emails ===
+	emailSender, err := newEmailSender(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create email sender: %w", err)
+	}
+
 	// === elasticsearch ===
 	esDetails := elasticSearchDetails{
 		client: client,
 	}
 
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
-		esDetails:  esDetails,
-		ch:         ch,
-		q:          q,
+		config:              config,
+		store:               store,
+		tokenMaker:          tokenMaker,
+		revocationStore:     revocationStore,
+		emailSender:         emailSender,
+		loginAccountLimiter: lockout.NewLimiter(failedAttemptsStore, loginAccountPolicy),
+		loginIPLimiter:      lockout.NewLimiter(failedAttemptsStore, loginIPPolicy),
+		esDetails:           esDetails,
+		ch:                  ch,
+		q:                   q,
 	}
 
 	server.setupRouter()
@@ -63,6 +99,36 @@ func NewServer(config config.Config, store db.Store, client esearch.ESearchClien
 	return server, nil
 }
 
+// newTokenMaker creates a PASETO v2.public token maker if the private key is configured,
+// otherwise a v2.local token maker using the symmetric key
+func newTokenMaker(config config.Config) (token.Maker, error) {
+	if config.TokenPrivateKey == "" {
+		return token.NewPasetoMaker(config.TokenSymmetricKey)
+	}
+
+	privateKey, err := token.ParsePrivateKey(config.TokenPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationKeys, err := token.ParseVerificationKeys(config.TokenVerificationKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return token.NewPasetoPublicMaker(config.TokenKeyID, privateKey, verificationKeys)
+}
+
+// newEmailSender creates an SMTP email sender if the SMTP server is configured,
+// otherwise a local sender that logs emails and saves them to EmailOutputDir
+func newEmailSender(config config.Config) (mail.EmailSender, error) {
+	if config.SMTPAddress != "" {
+		return mail.NewSMTPSender(config.SMTPAddress, config.EmailSenderAddress, config.EmailSenderPassword)
+	}
+
+	return mail.NewLocalSender(config.EmailSenderAddress, config.EmailOutputDir)
+}
+
 // setupRouter sets up the HTTP routing
 func (server *Server) setupRouter() {
 	router := gin.Default()
@@ -87,58 +153,150 @@ func (server *Server) setupRouter() {
 	// === users ===
 	routerV1.POST("/users", server.createUser)
 	routerV1.POST("/users/login", server.loginUser)
+	routerV1.POST("/users/password/forgot", server.forgotUserPassword)
+	routerV1.POST("/users/password/reset", server.resetUserPassword)
 
 	// === employers ===
 	routerV1.POST("/employers", server.createEmployer)
 	routerV1.POST("/employers/login", server.loginEmployer)
+	routerV1.POST("/employers/login/2fa", server.verifyEmployerLogin)
+	routerV1.POST("/employers/password/forgot", server.forgotEmployerPassword)
+	routerV1.POST("/employers/password/reset", server.resetEmployerPassword)
 
 	routerV1.GET("/employers/employer-company-details/:email", server.getEmployerAndCompanyDetails)
 
+	// === admin ===
+	routerV1.POST("/admin/login", server.loginAdmin)
+
+	// === tokens ===
+	routerV1.POST("/tokens/renew_access", server.renewAccessToken)
+	routerV1.GET("/tokens/keys", server.listTokenPublicKeys)
+
+	// === verify email ===
+	routerV1.GET("/verify_email", server.verifyEmail)
+
 	// === jobs ===
 	routerV1.GET("/jobs/:id", server.getJob)
 	routerV1.GET("/jobs", server.filterAndListJobs)
 	routerV1.GET("/jobs/company", server.listJobsByCompany)
 	routerV1.GET("/jobs/search", server.searchJobs)
 
+	// === exchange rates ===
+	routerV1.GET("/exchange-rates", server.listExchangeRates)
+
 	// ===== routes that require authentication =====
-	authRoutesV1 := routerV1.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRoutesV1 := routerV1.Group("/")
+	authRoutesV1.Use(authMiddleware(server.tokenMaker, server.revocationStore))
+
+	// routes that can only be accessed by users
+	userRoutesV1 := authRoutesV1.Group("/")
+	userRoutesV1.Use(requireUser())
+
+	// routes that can only be accessed by employers
+	employerRoutesV1 := authRoutesV1.Group("/")
+	employerRoutesV1.Use(requireEmployer())
+
+	// routes that can only be accessed by admins
+	adminRoutesV1 := authRoutesV1.Group("/admin")
+	adminRoutesV1.Use(requireAdmin())
+
+	// routes that can be accessed by employers and with company API keys,
+	// API keys also need the scope required by the route
+	companyRoutesV1 := routerV1.Group("/")
+	companyRoutesV1.Use(apiKeyAuthMiddleware(server.tokenMaker, server.revocationStore, server.store))
 
 	// === users ===
-	authRoutesV1.GET("/users", server.getUser)
-	authRoutesV1.PATCH("/users", server.updateUser)
-	authRoutesV1.PATCH("/users/password", server.updateUserPassword)
-	authRoutesV1.DELETE("/users", server.deleteUser)
+	userRoutesV1.GET("/users", server.getUser)
+	userRoutesV1.PATCH("/users", server.updateUser)
+	userRoutesV1.PATCH("/users/password", server.updateUserPassword)
+	userRoutesV1.DELETE("/users", server.deleteUser)
+	userRoutesV1.POST("/users/logout", server.logoutUser)
 
 	// === employers ===
-	authRoutesV1.GET("/employers", server.getEmployer)
-	authRoutesV1.PATCH("/employers", server.updateEmployer)
-	authRoutesV1.PATCH("/employers/password", server.updateEmployerPassword)
-	authRoutesV1.DELETE("/employers", server.deleteEmployer)
-	authRoutesV1.GET("/employers/user-details/:email", server.getUserAsEmployer)
+	employerRoutesV1.GET("/employers", server.getEmployer)
+	employerRoutesV1.PATCH("/employers", server.updateEmployer)
+	employerRoutesV1.PATCH("/employers/password", server.updateEmployerPassword)
+	employerRoutesV1.DELETE("/employers", server.deleteEmployer)
+	employerRoutesV1.POST("/employers/logout", server.logoutEmployer)
+	employerRoutesV1.POST("/employers/2fa/enroll", server.enrollEmployerTOTP)
+	employerRoutesV1.POST("/employers/2fa/enable", server.enableEmployerTOTP)
+	employerRoutesV1.POST("/employers/2fa/disable", server.disableEmployerTOTP)
+	employerRoutesV1.GET("/employers/user-details/:email", server.getUserAsEmployer)
+
+	// === company members ===
+	employerRoutesV1.GET("/employers/members", server.listCompanyMembers)
+	employerRoutesV1.PATCH("/employers/members/:id/role", server.updateCompanyMemberRole)
+	employerRoutesV1.POST("/employers/invitations", server.inviteEmployer)
+	employerRoutesV1.GET("/employers/invitations", server.listInvitations)
+	employerRoutesV1.DELETE("/employers/invitations/:id", server.deleteInvitation)
+
+	// === api keys ===
+	employerRoutesV1.POST("/employers/api-keys", server.createAPIKey)
+	employerRoutesV1.GET("/employers/api-keys", server.listAPIKeys)
+	employerRoutesV1.DELETE("/employers/api-keys/:id", server.revokeAPIKey)
+
+	// === admin ===
+	adminRoutesV1.GET("/users", server.searchUsersAsAdmin)
+	adminRoutesV1.POST("/users/:id/suspend", server.suspendUser)
+	adminRoutesV1.POST("/users/:id/restore", server.restoreUser)
+	adminRoutesV1.GET("/employers", server.searchEmployersAsAdmin)
+	adminRoutesV1.POST("/employers/:id/suspend", server.suspendEmployer)
+	adminRoutesV1.POST("/employers/:id/restore", server.restoreEmployer)
+	adminRoutesV1.GET("/companies", server.searchCompaniesAsAdmin)
+	adminRoutesV1.POST("/companies/:id/suspend", server.suspendCompany)
+	adminRoutesV1.POST("/companies/:id/restore", server.restoreCompany)
+	adminRoutesV1.POST("/jobs/:id/unpublish", server.unpublishJob)
+	adminRoutesV1.GET("/job-applications/:id", server.getJobApplicationAsAdmin)
+	adminRoutesV1.GET("/audit-logs", server.listAdminAuditLogs)
+	adminRoutesV1.PUT("/exchange-rates/:currency", server.setExchangeRate)
+
+	// === sessions ===
+	authRoutesV1.GET("/sessions", server.listSessions)
+	authRoutesV1.DELETE("/sessions/:id", server.revokeSession)
 
 	// === jobs ===
-	// for employers, jobs CRUD
-	authRoutesV1.POST("/jobs", server.createJob)
-	authRoutesV1.GET("/jobs/employer", server.listEmployerJobs)
-	authRoutesV1.PATCH("/jobs/:id", server.updateJob)
-	authRoutesV1.DELETE("/jobs/:id", server.deleteJob)
+	// for employers and company API keys, jobs CRUD
+	companyRoutesV1.POST("/jobs", requireEmployerScope(apiKeyScopeJobsWrite), server.createJob)
+	companyRoutesV1.POST("/jobs/import", requireEmployerScope(apiKeyScopeJobsWrite), server.importJobs)
+	companyRoutesV1.GET("/jobs/employer", requireEmployerScope(apiKeyScopeJobsRead), server.listEmployerJobs)
+	companyRoutesV1.GET("/jobs/employer/export", requireEmployerScope(apiKeyScopeJobsRead), server.exportEmployerJobs)
+	companyRoutesV1.PATCH("/jobs/:id", requireEmployerScope(apiKeyScopeJobsWrite), server.updateJob)
+	companyRoutesV1.DELETE("/jobs/:id", requireEmployerScope(apiKeyScopeJobsWrite), server.deleteJob)
+	companyRoutesV1.POST("/jobs/:id/publish", requireEmployerScope(apiKeyScopeJobsWrite), server.publishJob)
+	companyRoutesV1.POST("/jobs/:id/close", requireEmployerScope(apiKeyScopeJobsWrite), server.closeJob)
+	companyRoutesV1.POST("/jobs/:id/reopen", requireEmployerScope(apiKeyScopeJobsWrite), server.reopenJob)
+	companyRoutesV1.GET("/jobs/:id/stats", requireEmployerScope(apiKeyScopeJobsRead), server.getJobStats)
+	companyRoutesV1.POST("/jobs/:id/clone", requireEmployerScope(apiKeyScopeJobsWrite), server.cloneJob)
+	companyRoutesV1.GET("/jobs/:id/revisions", requireEmployerScope(apiKeyScopeJobsRead), server.listJobRevisions)
+	companyRoutesV1.GET("/jobs/:id/revisions/diff", requireEmployerScope(apiKeyScopeJobsRead), server.diffJobRevisionsOfEmployer)
+	companyRoutesV1.GET("/jobs/:id/revisions/:revision", requireEmployerScope(apiKeyScopeJobsRead), server.getJobRevision)
+
+	// job templates
+	companyRoutesV1.POST("/job-templates", requireEmployerScope(apiKeyScopeJobsWrite), server.createJobTemplate)
+	companyRoutesV1.GET("/job-templates", requireEmployerScope(apiKeyScopeJobsRead), server.listJobTemplates)
+	companyRoutesV1.GET("/job-templates/:id", requireEmployerScope(apiKeyScopeJobsRead), server.getJobTemplate)
+	companyRoutesV1.PATCH("/job-templates/:id", requireEmployerScope(apiKeyScopeJobsWrite), server.updateJobTemplate)
+	companyRoutesV1.DELETE("/job-templates/:id", requireEmployerScope(apiKeyScopeJobsWrite), server.deleteJobTemplate)
+	companyRoutesV1.POST("/job-templates/:id/jobs", requireEmployerScope(apiKeyScopeJobsWrite), server.createJobFromTemplate)
 
 	// for users, listing jobs that use user details
-	authRoutesV1.GET("/jobs/match-skills", server.listJobsByMatchingSkills)
+	userRoutesV1.GET("/jobs/match-skills", server.listJobsByMatchingSkills)
 
 	// === job applications ===
 	// for users, job applications CRUD
-	authRoutesV1.POST("/job-applications", server.createJobApplication)
-	authRoutesV1.GET("/job-applications/user/:id", server.getJobApplicationForUser)
-	authRoutesV1.PATCH("/job-applications/user/:id", server.updateJobApplication)
-	authRoutesV1.POST("/job-applications/user/notifications/", server.changeNotifyJobApplication)
-	authRoutesV1.DELETE("/job-applications/user/:id", server.deleteJobApplication)
-	authRoutesV1.GET("/job-applications/user", server.listJobApplicationsForUser)
-
-	// for employers, reading, changing statuses (rejecting, offering)
-	authRoutesV1.GET("/job-applications/employer/:id", server.getJobApplicationForEmployer)
-	authRoutesV1.PATCH("/job-applications/employer/:id/status", server.changeJobApplicationStatus)
-	authRoutesV1.GET("/job-applications/employer", server.listJobApplicationsForEmployer)
+	userRoutesV1.POST("/job-applications", server.createJobApplication)
+	userRoutesV1.GET("/job-applications/user/:id", server.getJobApplicationForUser)
+	userRoutesV1.GET("/job-applications/user/:id/job-revision", server.getJobRevisionOfJobApplication)
+	userRoutesV1.PATCH("/job-applications/user/:id", server.updateJobApplication)
+	userRoutesV1.POST("/job-applications/user/notifications/", server.changeNotifyJobApplication)
+	userRoutesV1.DELETE("/job-applications/user/:id", server.deleteJobApplication)
+	userRoutesV1.GET("/job-applications/user", server.listJobApplicationsForUser)
+
+	// for employers and company API keys, reading, changing statuses (rejecting, offering)
+	companyRoutesV1.GET("/job-applications/employer/:id", requireEmployerScope(apiKeyScopeApplicationsRead), server.getJobApplicationForEmployer)
+	companyRoutesV1.PATCH("/job-applications/employer/:id/status", requireEmployerScope(apiKeyScopeApplicationsWrite), server.changeJobApplicationStatus)
+	companyRoutesV1.GET("/job-applications/employer", requireEmployerScope(apiKeyScopeApplicationsRead), server.listJobApplicationsForEmployer)
+	companyRoutesV1.GET("/job-applications/employer/export", requireEmployerScope(apiKeyScopeApplicationsRead), server.exportJobApplicationsForEmployer)
 
 	authRoutesV1.POST("/job-applications/notification", server.notifyJobApplication)
 	server.router = router
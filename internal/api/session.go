@@ -0,0 +1,151 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"net/http"
+	"time"
+)
+
+var (
+	sessionNotFoundError  = errors.New("session does not exist")
+	sessionOwnershipError = errors.New("session does not belong to this account")
+)
+
+// createSession creates a refresh token for the given account
+// and stores it as a new session together with the client details.
+func (server *Server) createSession(ctx *gin.Context, email string, role string, subjectID int32) (db.Session, string, *token.Payload, error) {
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateRefreshToken(email, role, subjectID, server.config.RefreshTokenDuration)
+	if err != nil {
+		return db.Session{}, "", nil, err
+	}
+
+	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
+		ID:           refreshPayload.ID,
+		Email:        email,
+		RefreshToken: refreshToken,
+		UserAgent:    ctx.Request.UserAgent(),
+		ClientIp:     ctx.ClientIP(),
+		IsBlocked:    false,
+		ExpiresAt:    refreshPayload.ExpiredAt,
+		Role:         role,
+		SubjectID:    subjectID,
+	})
+	if err != nil {
+		return db.Session{}, "", nil, err
+	}
+
+	return session, refreshToken, refreshPayload, nil
+}
+
+type sessionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	ClientIp  string    `json:"client_ip"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// newSessionResponse converts db.Session to sessionResponse,
+// the refresh token itself is never returned
+func newSessionResponse(session db.Session) sessionResponse {
+	return sessionResponse{
+		ID:        session.ID,
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		ExpiresAt: session.ExpiresAt,
+		CreatedAt: session.CreatedAt,
+	}
+}
+
+// @Schemes
+// @Summary List sessions
+// @Description List active (not blocked and not expired) sessions of the logged-in user or employer
+// @Tags sessions
+// @Produce json
+// @Success 200 {array} sessionResponse
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /sessions [get]
+// listSessions lists active sessions of the authenticated account
+func (server *Server) listSessions(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	sessions, err := server.store.ListActiveSessionsBySubject(ctx, db.ListActiveSessionsBySubjectParams{
+		Role:      authPayload.Role,
+		SubjectID: authPayload.SubjectID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := make([]sessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		res = append(res, newSessionResponse(session))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type revokeSessionRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// @Schemes
+// @Summary Revoke session
+// @Description Revoke (block) a session of the logged-in user or employer. The refresh token of this session can no longer be used.
+// @Tags sessions
+// @param id path string true "Session ID"
+// @Success 204 {null} null
+// @Failure 400 {object} ErrorResponse "Invalid session ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Session does not belong to the account making the request"
+// @Failure 404 {object} ErrorResponse "Session not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /sessions/{id} [delete]
+// revokeSession blocks a session of the authenticated account
+func (server *Server) revokeSession(ctx *gin.Context) {
+	var request revokeSessionRequest
+	if err := ctx.ShouldBindUri(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	sessionID, err := uuid.Parse(request.ID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	session, err := server.store.GetSession(ctx, sessionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(sessionNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	// a user and an employer can have the same email, so the account is compared
+	if session.Role != authPayload.Role || session.SubjectID != authPayload.SubjectID {
+		ctx.JSON(http.StatusForbidden, errorResponse(sessionOwnershipError))
+		return
+	}
+
+	err = server.store.BlockSession(ctx, session.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"net/http"
+	"time"
+)
+
+var (
+	blockedSessionError   = errors.New("session is blocked")
+	incorrectSessionError = errors.New("session does not match the refresh token")
+	expiredSessionError   = errors.New("session has expired")
+	notRefreshTokenError  = errors.New("only refresh tokens can be used to renew the access token")
+	symmetricTokensError  = errors.New("tokens are signed with a symmetric key, there are no public keys")
+)
+
+type renewAccessTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type renewAccessTokenResponse struct {
+	AccessToken          string    `json:"access_token"`
+	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+}
+
+// @Schemes
+// @Summary Renew access token
+// @Description Create a new access token using the refresh token received at login
+// @Tags tokens
+// @Accept json
+// @Produce json
+// @param RenewAccessTokenRequest body renewAccessTokenRequest true "Refresh token"
+// @Success 200 {object} renewAccessTokenResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Invalid, expired or blocked refresh token or an access token instead of the refresh token"
+// @Failure 404 {object} ErrorResponse "Session not found"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Router /tokens/renew_access [post]
+// renewAccessToken handles creating a new access token from a refresh token
+func (server *Server) renewAccessToken(ctx *gin.Context) {
+	var request renewAccessTokenRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(request.RefreshToken)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	if refreshPayload.TokenType != token.TokenTypeRefresh {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(notRefreshTokenError))
+		return
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(sessionNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if session.IsBlocked {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(blockedSessionError))
+		return
+	}
+
+	if session.Role != refreshPayload.Role || session.SubjectID != refreshPayload.SubjectID ||
+		session.RefreshToken != request.RefreshToken {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(incorrectSessionError))
+		return
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(expiredSessionError))
+		return
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
+		refreshPayload.Email,
+		refreshPayload.Role,
+		refreshPayload.SubjectID,
+		server.config.AccessTokenDuration,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := renewAccessTokenResponse{
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: accessPayload.ExpiredAt,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type logoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// logout blocks the session of the refresh token, so the access token cannot be renewed,
+// and adds the access token of the request to the revocation store,
+// so it cannot be used anymore, even though it has not expired yet
+func (server *Server) logout(ctx *gin.Context) {
+	var request logoutRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(request.RefreshToken)
+	switch {
+	case errors.Is(err, token.ErrExpiredToken):
+		// the session has expired, so its refresh token cannot be used anyway
+	case err != nil:
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	case refreshPayload.TokenType != token.TokenTypeRefresh:
+		ctx.JSON(http.StatusUnauthorized, errorResponse(notRefreshTokenError))
+		return
+	case refreshPayload.Role != authPayload.Role || refreshPayload.SubjectID != authPayload.SubjectID:
+		ctx.JSON(http.StatusForbidden, errorResponse(sessionOwnershipError))
+		return
+	default:
+		err = server.store.BlockSession(ctx, refreshPayload.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	err = server.revocationStore.Revoke(ctx, authPayload.ID, authPayload.ExpiredAt)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+type tokenPublicKey struct {
+	KeyID     string `json:"kid"`
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	X         string `json:"x"`
+}
+
+type listTokenPublicKeysResponse struct {
+	Keys []tokenPublicKey `json:"keys"`
+}
+
+// @Schemes
+// @Summary List token public keys
+// @Description List public keys (JWKS-style) that can be used to verify PASETO v2.public tokens offline. The key ID is stored in the "kid" field of the token footer.
+// @Tags tokens
+// @Produce json
+// @Success 200 {object} listTokenPublicKeysResponse
+// @Failure 404 {object} ErrorResponse "Tokens are not signed with public key cryptography"
+// @Router /tokens/keys [get]
+// listTokenPublicKeys lists public keys used to verify tokens
+func (server *Server) listTokenPublicKeys(ctx *gin.Context) {
+	provider, ok := server.tokenMaker.(token.PublicKeyProvider)
+	if !ok {
+		ctx.JSON(http.StatusNotFound, errorResponse(symmetricTokensError))
+		return
+	}
+
+	res := listTokenPublicKeysResponse{
+		Keys: []tokenPublicKey{},
+	}
+	for _, key := range provider.PublicKeys() {
+		res.Keys = append(res.Keys, tokenPublicKey{
+			KeyID:     key.KeyID,
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			Use:       "sig",
+			Algorithm: "EdDSA",
+			X:         base64.RawURLEncoding.EncodeToString(key.Key),
+		})
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
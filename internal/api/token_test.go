@@ -0,0 +1,315 @@
+package api
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/config"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/o1egl/paseto"
+	rabbitmq "github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRenewAccessTokenAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+
+	testCases := []struct {
+		name          string
+		body          func(refreshToken string) gin.H
+		buildStubs    func(store *mockdb.MockStore, refreshToken string, payload *token.Payload)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					Times(1).
+					Return(generateSession(refreshToken, payload), nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res renewAccessTokenResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.AccessToken)
+				require.WithinDuration(t, time.Now().Add(time.Minute), res.AccessTokenExpiresAt, time.Second)
+			},
+		},
+		{
+			name: "Invalid Refresh Token",
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": utils.RandomString(32)}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Refresh Token",
+			body: func(refreshToken string) gin.H {
+				return gin.H{}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Session Not Found",
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					Times(1).
+					Return(db.Session{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error GetSession",
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, payload *token.Payload) {
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					Times(1).
+					Return(db.Session{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Blocked Session",
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, payload *token.Payload) {
+				session := generateSession(refreshToken, payload)
+				session.IsBlocked = true
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					Times(1).
+					Return(session, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Mismatched Refresh Token",
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, payload *token.Payload) {
+				session := generateSession(refreshToken, payload)
+				session.RefreshToken = utils.RandomString(32)
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					Times(1).
+					Return(session, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Expired Session",
+			body: func(refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, payload *token.Payload) {
+				session := generateSession(refreshToken, payload)
+				session.ExpiresAt = time.Now().Add(-time.Minute)
+				store.EXPECT().
+					GetSession(gomock.Any(), gomock.Eq(payload.ID)).
+					Times(1).
+					Return(session, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			server := newTestServer(t, store, nil)
+
+			refreshToken, payload, err := server.tokenMaker.CreateRefreshToken(user.Email, token.RoleUser, user.ID, time.Minute)
+			require.NoError(t, err)
+
+			tc.buildStubs(store, refreshToken, payload)
+
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body(refreshToken))
+			require.NoError(t, err)
+
+			url := BaseUrl + "/tokens/renew_access"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestRenewAccessTokenWithAccessTokenAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetSession(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	server := newTestServer(t, store, nil)
+
+	// access tokens cannot be used in place of the refresh token
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Email, token.RoleUser, user.ID, time.Minute)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(gin.H{"refresh_token": accessToken})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	url := BaseUrl + "/tokens/renew_access"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func generateSession(refreshToken string, payload *token.Payload) db.Session {
+	return db.Session{
+		ID:           payload.ID,
+		Email:        payload.Email,
+		RefreshToken: refreshToken,
+		UserAgent:    "Go-http-client/1.1",
+		ClientIp:     "127.0.0.1",
+		IsBlocked:    false,
+		ExpiresAt:    payload.ExpiredAt,
+		CreatedAt:    payload.IssuedAt,
+		Role:         payload.Role,
+		SubjectID:    payload.SubjectID,
+	}
+}
+
+func TestListTokenPublicKeysAPI(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	oldPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		config        config.Config
+		checkResponse func(server *Server, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			config: config.Config{
+				TokenKeyID:            "key-2",
+				TokenPrivateKey:       hex.EncodeToString(privateKey.Seed()),
+				TokenVerificationKeys: "key-1:" + hex.EncodeToString(oldPublicKey),
+				AccessTokenDuration:   time.Minute,
+			},
+			checkResponse: func(server *Server, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res listTokenPublicKeysResponse
+				err := json.NewDecoder(recorder.Body).Decode(&res)
+				require.NoError(t, err)
+				require.Len(t, res.Keys, 2)
+				require.Equal(t, "key-1", res.Keys[0].KeyID)
+				require.Equal(t, "key-2", res.Keys[1].KeyID)
+
+				// tokens of the server can be verified with the published key only
+				publicKey, err := base64.RawURLEncoding.DecodeString(res.Keys[1].X)
+				require.NoError(t, err)
+
+				tkn, _, err := server.tokenMaker.CreateToken(utils.RandomEmail(), token.RoleUser, 1, time.Minute)
+				require.NoError(t, err)
+
+				var payload token.Payload
+				err = paseto.NewV2().Verify(tkn, ed25519.PublicKey(publicKey), &payload, nil)
+				require.NoError(t, err)
+				require.Equal(t, token.RoleUser, payload.Role)
+			},
+		},
+		{
+			name: "Symmetric Tokens",
+			config: config.Config{
+				TokenSymmetricKey:   utils.RandomString(32),
+				AccessTokenDuration: time.Minute,
+			},
+			checkResponse: func(server *Server, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server, err := NewServer(tc.config, nil, nil, nil, rabbitmq.Queue{})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/tokens/keys"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(server, recorder)
+		})
+	}
+}
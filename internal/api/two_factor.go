@@ -0,0 +1,434 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/totp"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	twoFactorAlreadyEnabledError = errors.New("two-factor authentication is already enabled")
+	twoFactorNotEnrolledError    = errors.New("two-factor authentication has not been enrolled")
+	twoFactorNotEnabledError     = errors.New("two-factor authentication is not enabled")
+	invalidTwoFactorCodeError    = errors.New("invalid two-factor authentication code")
+	invalidChallengeTokenError   = errors.New("challenge token is invalid, expired or already used")
+)
+
+const (
+	totpIssuer                 = "Job Finder"
+	twoFactorChallengeDuration = 5 * time.Minute
+	recoveryCodesCount         = 10
+	recoveryCodeLength         = 10
+)
+
+type twoFactorChallengeResponse struct {
+	TwoFactorRequired       bool      `json:"two_factor_required"`
+	ChallengeToken          string    `json:"challenge_token"`
+	ChallengeTokenExpiresAt time.Time `json:"challenge_token_expires_at"`
+}
+
+// createTwoFactorChallenge responds with a short-lived challenge token,
+// which is exchanged for the access token after the second factor is verified
+func (server *Server) createTwoFactorChallenge(ctx *gin.Context, employer db.Employer) {
+	challengeToken, challengePayload, err := server.tokenMaker.CreateToken(
+		employer.Email,
+		token.RoleEmployerTwoFactor,
+		employer.ID,
+		twoFactorChallengeDuration,
+	)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := twoFactorChallengeResponse{
+		TwoFactorRequired:       true,
+		ChallengeToken:          challengeToken,
+		ChallengeTokenExpiresAt: challengePayload.ExpiredAt,
+	}
+
+	ctx.JSON(http.StatusAccepted, res)
+}
+
+// verifySecondFactor checks the TOTP code or, if it is not a TOTP code, the recovery code
+// of the employer. Both can be used only once.
+func (server *Server) verifySecondFactor(ctx *gin.Context, employerTotp db.EmployerTotp, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(employerTotp.Secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+
+		// the code of this step (or an older one) has already been used
+		_, err := server.store.UseEmployerTOTPStep(ctx, db.UseEmployerTOTPStepParams{
+			EmployerID:   employerTotp.EmployerID,
+			LastUsedStep: step,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	_, err := server.store.UseEmployerRecoveryCode(ctx, db.UseEmployerRecoveryCodeParams{
+		EmployerID: employerTotp.EmployerID,
+		HashedCode: utils.HashSecret(strings.ToLower(code)),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+type verifyEmployerLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
+
+// @Schemes
+// @Summary Verify employer login
+// @Description Complete the login of an employer with two-factor authentication. The code is either the TOTP code from the authenticator app or one of the recovery codes.
+// @Tags employers
+// @Accept json
+// @Produce json
+// @param VerifyEmployerLoginRequest body verifyEmployerLoginRequest true "Challenge token from /employers/login and the code"
+// @Success 200 {object} loginEmployerResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Invalid challenge token or code"
+// @Failure 403 {object} ErrorResponse "Account or company of the employer has been suspended"
+// @Failure 429 {object} ErrorResponse "Too many failed login attempts, see the Retry-After header"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Router /employers/login/2fa [post]
+// verifyEmployerLogin handles the second step of the employer login
+func (server *Server) verifyEmployerLogin(ctx *gin.Context) {
+	var request verifyEmployerLoginRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	challengePayload, err := server.tokenMaker.VerifyToken(request.ChallengeToken)
+	if err != nil || challengePayload.Role != token.RoleEmployerTwoFactor {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(invalidChallengeTokenError))
+		return
+	}
+
+	revoked, err := server.revocationStore.IsRevoked(ctx, challengePayload.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if revoked {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(invalidChallengeTokenError))
+		return
+	}
+
+	// the challenge token is no longer valid after the password reset or the suspension of the employer
+	revoked, err = server.revocationStore.IsSubjectRevoked(ctx, revocationSubject(token.RoleEmployer, challengePayload.SubjectID), challengePayload.IssuedAt)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if revoked {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(invalidChallengeTokenError))
+		return
+	}
+
+	accountKey := loginAccountKey(token.RoleEmployer, challengePayload.Email)
+	if !server.checkLoginAttempts(ctx, accountKey) {
+		return
+	}
+
+	employer, err := server.store.GetEmployerByID(ctx, challengePayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if employer.SuspendedAt.Valid {
+		ctx.JSON(http.StatusForbidden, errorResponse(accountSuspendedError))
+		return
+	}
+
+	employerTotp, err := server.store.GetEmployerTOTP(ctx, employer.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(invalidChallengeTokenError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if !employerTotp.IsEnabled {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(invalidChallengeTokenError))
+		return
+	}
+
+	ok, err := server.verifySecondFactor(ctx, employerTotp, request.Code)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if !ok {
+		server.failLogin(ctx, accountKey, invalidTwoFactorCodeError)
+		return
+	}
+
+	// the challenge token can be exchanged only once
+	err = server.revocationStore.Revoke(ctx, challengePayload.ID, challengePayload.ExpiredAt)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = server.loginAccountLimiter.Reset(ctx, accountKey)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	server.completeEmployerLogin(ctx, employer)
+}
+
+type enrollEmployerTOTPResponse struct {
+	Secret string `json:"secret"`
+	KeyURI string `json:"key_uri"`
+}
+
+// @Schemes
+// @Summary Enroll two-factor authentication
+// @Description Generate a new TOTP secret for the logged-in employer. It has to be added to an authenticator app (e.g. by a QR code of the key URI) and confirmed at /employers/2fa/enable.
+// @Tags employers
+// @Produce json
+// @Success 200 {object} enrollEmployerTOTPResponse
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Two-factor authentication is already enabled"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/2fa/enroll [post]
+// enrollEmployerTOTP handles generating a TOTP secret for an employer
+func (server *Server) enrollEmployerTOTP(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	employerTotp, err := server.store.GetEmployerTOTP(ctx, authPayload.SubjectID)
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err == nil && employerTotp.IsEnabled {
+		ctx.JSON(http.StatusForbidden, errorResponse(twoFactorAlreadyEnabledError))
+		return
+	}
+
+	// the email of the token is outdated after the email was changed
+	employer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	employerTotp, err = server.store.UpsertEmployerTOTP(ctx, db.UpsertEmployerTOTPParams{
+		EmployerID: authPayload.SubjectID,
+		Secret:     secret,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := enrollEmployerTOTPResponse{
+		Secret: employerTotp.Secret,
+		KeyURI: totp.KeyURI(totpIssuer, employer.Email, employerTotp.Secret),
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type enableEmployerTOTPRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric"`
+}
+
+type enableEmployerTOTPResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// @Schemes
+// @Summary Enable two-factor authentication
+// @Description Confirm the enrolled TOTP secret with a code from the authenticator app and enable two-factor authentication. Recovery codes are returned only once, each of them can be used instead of a TOTP code once.
+// @Tags employers
+// @Accept json
+// @Produce json
+// @param EnableEmployerTOTPRequest body enableEmployerTOTPRequest true "TOTP code"
+// @Success 200 {object} enableEmployerTOTPResponse
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Unauthorized or invalid code"
+// @Failure 403 {object} ErrorResponse "Two-factor authentication is already enabled"
+// @Failure 404 {object} ErrorResponse "Two-factor authentication has not been enrolled"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/2fa/enable [post]
+// enableEmployerTOTP handles enabling two-factor authentication of an employer
+func (server *Server) enableEmployerTOTP(ctx *gin.Context) {
+	var request enableEmployerTOTPRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	employerTotp, err := server.store.GetEmployerTOTP(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(twoFactorNotEnrolledError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if employerTotp.IsEnabled {
+		ctx.JSON(http.StatusForbidden, errorResponse(twoFactorAlreadyEnabledError))
+		return
+	}
+
+	step, ok := totp.Validate(employerTotp.Secret, request.Code, time.Now())
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(invalidTwoFactorCodeError))
+		return
+	}
+
+	recoveryCodes := make([]string, 0, recoveryCodesCount)
+	hashedRecoveryCodes := make([]string, 0, recoveryCodesCount)
+	for i := 0; i < recoveryCodesCount; i++ {
+		code, err := utils.RandomSecret(recoveryCodeLength)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		recoveryCodes = append(recoveryCodes, code)
+		hashedRecoveryCodes = append(hashedRecoveryCodes, utils.HashSecret(code))
+	}
+
+	_, err = server.store.EnableEmployerTOTPTx(ctx, db.EnableEmployerTOTPTxParams{
+		EnableEmployerTOTPParams: db.EnableEmployerTOTPParams{
+			EmployerID:   employerTotp.EmployerID,
+			LastUsedStep: step,
+		},
+		HashedRecoveryCodes: hashedRecoveryCodes,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, enableEmployerTOTPResponse{RecoveryCodes: recoveryCodes})
+}
+
+type disableEmployerTOTPRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+// @Schemes
+// @Summary Disable two-factor authentication
+// @Description Disable two-factor authentication of the logged-in employer. Both the password and a TOTP or recovery code are required.
+// @Tags employers
+// @Accept json
+// @param DisableEmployerTOTPRequest body disableEmployerTOTPRequest true "Password and code"
+// @Success 204 {null} null
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Unauthorized, incorrect password or invalid code"
+// @Failure 404 {object} ErrorResponse "Two-factor authentication is not enabled"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /employers/2fa/disable [post]
+// disableEmployerTOTP handles disabling two-factor authentication of an employer
+func (server *Server) disableEmployerTOTP(ctx *gin.Context) {
+	var request disableEmployerTOTPRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	authEmployer, err := server.store.GetEmployerByID(ctx, authPayload.SubjectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = utils.CheckPassword(request.Password, authEmployer.HashedPassword)
+	if err != nil {
+		err = fmt.Errorf("incorrect password")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	employerTotp, err := server.store.GetEmployerTOTP(ctx, authEmployer.ID)
+	if err != nil && err != sql.ErrNoRows {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err == sql.ErrNoRows || !employerTotp.IsEnabled {
+		ctx.JSON(http.StatusNotFound, errorResponse(twoFactorNotEnabledError))
+		return
+	}
+
+	ok, err := server.verifySecondFactor(ctx, employerTotp, request.Code)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(invalidTwoFactorCodeError))
+		return
+	}
+
+	err = server.store.DisableEmployerTOTPTx(ctx, authEmployer.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
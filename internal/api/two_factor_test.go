@@ -0,0 +1,829 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/token"
+	"github.com/grannnsacker/job-finder-back/pkg/totp"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func generateEmployerTotp(t *testing.T, employerID int32, enabled bool) db.EmployerTotp {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	return db.EmployerTotp{
+		EmployerID: employerID,
+		Secret:     secret,
+		IsEnabled:  enabled,
+		CreatedAt:  time.Now(),
+	}
+}
+
+func TestLoginEmployerTwoFactorChallenge(t *testing.T) {
+	employer, password, _ := generateRandomEmployerAndCompany(t)
+	employerTotp := generateEmployerTotp(t, employer.ID, true)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetEmployerByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+		Times(1).
+		Return(employer, nil)
+	store.EXPECT().
+		GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+		Times(1).
+		Return(employerTotp, nil)
+	// no tokens are issued before the second factor is verified
+	store.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	server := newTestServer(t, store, nil)
+	recorder := sendLoginRequest(t, server, "/employers/login", employer.Email, password)
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+
+	var res twoFactorChallengeResponse
+	err := json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.True(t, res.TwoFactorRequired)
+	require.NotEmpty(t, res.ChallengeToken)
+
+	// the challenge token cannot be used as an access token
+	recorder = httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, BaseUrl+"/employers", nil)
+	require.NoError(t, err)
+	req.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, res.ChallengeToken))
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestVerifyEmployerLoginAPI(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+	employerTotp := generateEmployerTotp(t, employer.ID, true)
+	recoveryCode := utils.RandomString(recoveryCodeLength)
+
+	suspendedEmployer := employer
+	suspendedEmployer.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		code          func(t *testing.T) string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK TOTP Code",
+			code: func(t *testing.T) string {
+				code, err := totp.GenerateCode(employerTotp.Secret, totp.Step(time.Now()))
+				require.NoError(t, err)
+				return code
+			},
+			role: token.RoleEmployerTwoFactor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					UseEmployerTOTPStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(company, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res loginEmployerResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.AccessToken)
+				require.Equal(t, employer.Email, res.Employer.Email)
+			},
+		},
+		{
+			name: "OK Recovery Code",
+			code: func(t *testing.T) string {
+				return recoveryCode
+			},
+			role: token.RoleEmployerTwoFactor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				params := db.UseEmployerRecoveryCodeParams{
+					EmployerID: employer.ID,
+					HashedCode: utils.HashSecret(recoveryCode),
+				}
+				store.EXPECT().
+					UseEmployerRecoveryCode(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.EmployerRecoveryCode{}, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
+				store.EXPECT().
+					GetCompanyByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
+					Times(1).
+					Return(company, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid TOTP Code",
+			code: func(t *testing.T) string {
+				code, err := totp.GenerateCode(employerTotp.Secret, totp.Step(time.Now())-10)
+				require.NoError(t, err)
+				return code
+			},
+			role: token.RoleEmployerTwoFactor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					UseEmployerTOTPStep(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "TOTP Code Already Used",
+			code: func(t *testing.T) string {
+				code, err := totp.GenerateCode(employerTotp.Secret, totp.Step(time.Now()))
+				require.NoError(t, err)
+				return code
+			},
+			role: token.RoleEmployerTwoFactor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					UseEmployerTOTPStep(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Recovery Code",
+			code: func(t *testing.T) string {
+				return utils.RandomString(recoveryCodeLength)
+			},
+			role: token.RoleEmployerTwoFactor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					UseEmployerRecoveryCode(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EmployerRecoveryCode{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Access Token Instead Of Challenge Token",
+			code: func(t *testing.T) string {
+				return recoveryCode
+			},
+			role: token.RoleEmployer,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Employer Suspended",
+			code: func(t *testing.T) string {
+				return recoveryCode
+			},
+			role: token.RoleEmployerTwoFactor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(suspendedEmployer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					UseEmployerRecoveryCode(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Two-Factor Disabled",
+			code: func(t *testing.T) string {
+				return recoveryCode
+			},
+			role: token.RoleEmployerTwoFactor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			challengeToken, _, err := server.tokenMaker.CreateToken(employer.Email, tc.role, employer.ID, time.Minute)
+			require.NoError(t, err)
+
+			data, err := json.Marshal(gin.H{
+				"challenge_token": challengeToken,
+				"code":            tc.code(t),
+			})
+			require.NoError(t, err)
+
+			url := BaseUrl + "/employers/login/2fa"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestVerifyEmployerLoginChallengeUsedOnce(t *testing.T) {
+	employer, _, company := generateRandomEmployerAndCompany(t)
+	employerTotp := generateEmployerTotp(t, employer.ID, true)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+		Times(1).
+		Return(employer, nil)
+	store.EXPECT().
+		GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+		Times(1).
+		Return(employerTotp, nil)
+	store.EXPECT().
+		UseEmployerRecoveryCode(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.EmployerRecoveryCode{}, nil)
+	store.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		Times(1)
+	store.EXPECT().
+		GetCompanyByID(gomock.Any(), gomock.Eq(employer.CompanyID)).
+		Times(1).
+		Return(company, nil)
+
+	server := newTestServer(t, store, nil)
+	challengeToken, _, err := server.tokenMaker.CreateToken(employer.Email, token.RoleEmployerTwoFactor, employer.ID, time.Minute)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(gin.H{
+		"challenge_token": challengeToken,
+		"code":            utils.RandomString(recoveryCodeLength),
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, BaseUrl+"/employers/login/2fa", bytes.NewReader(data))
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	req, err = http.NewRequest(http.MethodPost, BaseUrl+"/employers/login/2fa", bytes.NewReader(data))
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestVerifyEmployerLoginRevokedAccount(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetEmployerByID(gomock.Any(), gomock.Any()).
+		Times(0)
+	store.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	server := newTestServer(t, store, nil)
+	challengeToken, _, err := server.tokenMaker.CreateToken(employer.Email, token.RoleEmployerTwoFactor, employer.ID, time.Minute)
+	require.NoError(t, err)
+
+	// e.g. the password was reset after the challenge token was issued
+	subject := revocationSubject(token.RoleEmployer, employer.ID)
+	err = server.revocationStore.RevokeSubject(context.Background(), subject, time.Now().Add(time.Second), time.Minute)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(gin.H{
+		"challenge_token": challengeToken,
+		"code":            utils.RandomString(recoveryCodeLength),
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, BaseUrl+"/employers/login/2fa", bytes.NewReader(data))
+	require.NoError(t, err)
+	server.router.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestEnrollEmployerTOTPAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	// the email was changed after the token was issued
+	updatedEmployer := employer
+	updatedEmployer.Email = utils.RandomEmail()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(updatedEmployer, nil)
+				store.EXPECT().
+					UpsertEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.UpsertEmployerTOTPParams) (db.EmployerTotp, error) {
+						return db.EmployerTotp{EmployerID: arg.EmployerID, Secret: arg.Secret}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res enrollEmployerTOTPResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.NotEmpty(t, res.Secret)
+				require.Contains(t, res.KeyURI, "secret="+res.Secret)
+				require.Contains(t, res.KeyURI, url.PathEscape(updatedEmployer.Email))
+			},
+		},
+		{
+			name: "Employer Not Found",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.Employer{}, sql.ErrNoRows)
+				store.EXPECT().
+					UpsertEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Already Enabled",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(generateEmployerTotp(t, employer.ID, true), nil)
+				store.EXPECT().
+					UpsertEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrConnDone)
+				store.EXPECT().
+					UpsertEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/employers/2fa/enroll"
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestEnableEmployerTOTPAPI(t *testing.T) {
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+	employerTotp := generateEmployerTotp(t, employer.ID, false)
+
+	validCode := func(t *testing.T) string {
+		code, err := totp.GenerateCode(employerTotp.Secret, totp.Step(time.Now()))
+		require.NoError(t, err)
+		return code
+	}
+
+	testCases := []struct {
+		name          string
+		code          func(t *testing.T) string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			code: validCode,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					EnableEmployerTOTPTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.EnableEmployerTOTPTxParams) (db.EnableEmployerTOTPTxResult, error) {
+						require.Equal(t, employer.ID, arg.EmployerID)
+						require.Equal(t, totp.Step(time.Now()), arg.LastUsedStep)
+						require.Len(t, arg.HashedRecoveryCodes, recoveryCodesCount)
+						return db.EnableEmployerTOTPTxResult{}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res enableEmployerTOTPResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Len(t, res.RecoveryCodes, recoveryCodesCount)
+			},
+		},
+		{
+			name: "Invalid Code",
+			code: func(t *testing.T) string {
+				code, err := totp.GenerateCode(employerTotp.Secret, totp.Step(time.Now())-10)
+				require.NoError(t, err)
+				return code
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					EnableEmployerTOTPTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Not Enrolled",
+			code: validCode,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					EnableEmployerTOTPTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Already Enabled",
+			code: validCode,
+			buildStubs: func(store *mockdb.MockStore) {
+				enabled := employerTotp
+				enabled.IsEnabled = true
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(enabled, nil)
+				store.EXPECT().
+					EnableEmployerTOTPTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Code Format",
+			code: func(t *testing.T) string {
+				return "abcdef"
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"code": tc.code(t)})
+			require.NoError(t, err)
+
+			url := BaseUrl + "/employers/2fa/enable"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestDisableEmployerTOTPAPI(t *testing.T) {
+	employer, password, _ := generateRandomEmployerAndCompany(t)
+	employerTotp := generateEmployerTotp(t, employer.ID, true)
+	recoveryCode := utils.RandomString(recoveryCodeLength)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"password": password,
+				"code":     recoveryCode,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					UseEmployerRecoveryCode(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EmployerRecoveryCode{}, nil)
+				store.EXPECT().
+					DisableEmployerTOTPTx(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Incorrect Password",
+			body: gin.H{
+				"password": password + "wrong",
+				"code":     recoveryCode,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					DisableEmployerTOTPTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Code",
+			body: gin.H{
+				"password": password,
+				"code":     recoveryCode,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employerTotp, nil)
+				store.EXPECT().
+					UseEmployerRecoveryCode(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.EmployerRecoveryCode{}, sql.ErrNoRows)
+				store.EXPECT().
+					DisableEmployerTOTPTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Not Enabled",
+			body: gin.H{
+				"password": password,
+				"code":     recoveryCode,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetEmployerByID(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(employer, nil)
+				store.EXPECT().
+					GetEmployerTOTP(gomock.Any(), gomock.Eq(employer.ID)).
+					Times(1).
+					Return(db.EmployerTotp{}, sql.ErrNoRows)
+				store.EXPECT().
+					DisableEmployerTOTPTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := BaseUrl + "/employers/2fa/disable"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
 	"github.com/grannnsacker/job-finder-back/pkg/token"
 	"github.com/grannnsacker/job-finder-back/pkg/utils"
@@ -22,33 +23,39 @@ type Skill struct {
 }
 
 type createUserRequest struct {
-	Email             string  `json:"email" binding:"required,email"`
-	Password          string  `json:"password" binding:"required,min=6"`
-	FullName          string  `json:"full_name" binding:"required"`
-	Location          string  `json:"location" binding:"required"`
-	DesiredJobTitle   string  `json:"desired_job_title" binding:"required"`
-	DesiredIndustry   string  `json:"desired_industry" binding:"required"`
-	DesiredSalaryMin  int32   `json:"desired_salary_min" binding:"required,min=0"`
-	DesiredSalaryMax  int32   `json:"desired_salary_max" binding:"required,min=0"`
-	SkillsDescription string  `json:"skills_description"`
-	Experience        string  `json:"experience"`
-	TelegramId        string  `json:"telegram_id"`
-	Skills            []Skill `json:"skills"`
+	Email            string `json:"email" binding:"required,email"`
+	Password         string `json:"password" binding:"required,min=6"`
+	FullName         string `json:"full_name" binding:"required"`
+	Location         string `json:"location" binding:"required"`
+	DesiredJobTitle  string `json:"desired_job_title" binding:"required"`
+	DesiredIndustry  string `json:"desired_industry" binding:"required"`
+	DesiredSalaryMin int32  `json:"desired_salary_min" binding:"required,min=0"`
+	DesiredSalaryMax int32  `json:"desired_salary_max" binding:"required,min=0"`
+	// the desired salary is in USD per month by default
+	DesiredSalaryCurrency string          `json:"desired_salary_currency" binding:"omitempty,iso4217"`
+	DesiredSalaryPeriod   db.SalaryPeriod `json:"desired_salary_period" binding:"omitempty,oneof=hourly monthly yearly"`
+	SkillsDescription     string          `json:"skills_description"`
+	Experience            string          `json:"experience"`
+	TelegramId            string          `json:"telegram_id"`
+	Skills                []Skill         `json:"skills"`
 }
 
 type userResponse struct {
-	Email             string    `json:"email"`
-	FullName          string    `json:"full_name"`
-	Location          string    `json:"location"`
-	DesiredJobTitle   string    `json:"desired_job_title"`
-	DesiredIndustry   string    `json:"desired_industry"`
-	DesiredSalaryMin  int32     `json:"desired_salary_min"`
-	DesiredSalaryMax  int32     `json:"desired_salary_max"`
-	SkillsDescription string    `json:"skills_description"`
-	Experience        string    `json:"experience"`
-	TelegramId        string    `json:"telegram_id"`
-	Skills            []Skill   `json:"skills"`
-	CreatedAt         time.Time `json:"created_at"`
+	Email                 string          `json:"email"`
+	IsEmailVerified       bool            `json:"is_email_verified"`
+	FullName              string          `json:"full_name"`
+	Location              string          `json:"location"`
+	DesiredJobTitle       string          `json:"desired_job_title"`
+	DesiredIndustry       string          `json:"desired_industry"`
+	DesiredSalaryMin      int32           `json:"desired_salary_min"`
+	DesiredSalaryMax      int32           `json:"desired_salary_max"`
+	DesiredSalaryCurrency string          `json:"desired_salary_currency"`
+	DesiredSalaryPeriod   db.SalaryPeriod `json:"desired_salary_period"`
+	SkillsDescription     string          `json:"skills_description"`
+	Experience            string          `json:"experience"`
+	TelegramId            string          `json:"telegram_id"`
+	Skills                []Skill         `json:"skills"`
+	CreatedAt             time.Time       `json:"created_at"`
 }
 
 // newUserResponse converts db.User to userResponse
@@ -63,18 +70,21 @@ func newUserResponse(user db.User, skills []db.UserSkill) userResponse {
 	}
 
 	return userResponse{
-		Email:             user.Email,
-		FullName:          user.FullName,
-		Location:          user.Location,
-		DesiredJobTitle:   user.DesiredJobTitle,
-		DesiredIndustry:   user.DesiredIndustry,
-		DesiredSalaryMin:  user.DesiredSalaryMin,
-		DesiredSalaryMax:  user.DesiredSalaryMax,
-		SkillsDescription: user.Skills,
-		Experience:        user.Experience,
-		TelegramId:        user.TelegramID,
-		Skills:            userSkills,
-		CreatedAt:         user.CreatedAt,
+		Email:                 user.Email,
+		IsEmailVerified:       user.IsEmailVerified,
+		FullName:              user.FullName,
+		Location:              user.Location,
+		DesiredJobTitle:       user.DesiredJobTitle,
+		DesiredIndustry:       user.DesiredIndustry,
+		DesiredSalaryMin:      user.DesiredSalaryMin,
+		DesiredSalaryMax:      user.DesiredSalaryMax,
+		DesiredSalaryCurrency: user.DesiredSalaryCurrency,
+		DesiredSalaryPeriod:   user.DesiredSalaryPeriod,
+		SkillsDescription:     user.Skills,
+		Experience:            user.Experience,
+		TelegramId:            user.TelegramID,
+		Skills:                userSkills,
+		CreatedAt:             user.CreatedAt,
 	}
 }
 
@@ -86,7 +96,7 @@ func newUserResponse(user db.User, skills []db.UserSkill) userResponse {
 // @Produce json
 // @param CreateUserRequest body createUserRequest true "User details"
 // @Success 201 {object} userResponse
-// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 400 {object} ErrorResponse "Invalid request body or there is no exchange rate for the currency"
 // @Failure 403 {object} ErrorResponse "User with given email already exists"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Router /users [post]
@@ -103,6 +113,12 @@ func (server *Server) createUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
+	if request.DesiredSalaryCurrency == "" {
+		request.DesiredSalaryCurrency = defaultSalaryCurrency
+	}
+	if request.DesiredSalaryPeriod == "" {
+		request.DesiredSalaryPeriod = defaultSalaryPeriod
+	}
 	hashedPassword, err := utils.HashPassword(request.Password)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -110,20 +126,22 @@ func (server *Server) createUser(ctx *gin.Context) {
 	}
 	params := db.CreateUserTxParams{
 		CreateUserParams: db.CreateUserParams{
-			FullName:         request.FullName,
-			Email:            request.Email,
-			HashedPassword:   hashedPassword,
-			Location:         request.Location,
-			DesiredJobTitle:  request.DesiredJobTitle,
-			DesiredIndustry:  request.DesiredIndustry,
-			DesiredSalaryMin: request.DesiredSalaryMin,
-			DesiredSalaryMax: request.DesiredSalaryMax,
-			Skills:           request.SkillsDescription,
-			Experience:       request.Experience,
-			TelegramID:       request.TelegramId,
+			FullName:              request.FullName,
+			Email:                 request.Email,
+			HashedPassword:        hashedPassword,
+			Location:              request.Location,
+			DesiredJobTitle:       request.DesiredJobTitle,
+			DesiredIndustry:       request.DesiredIndustry,
+			DesiredSalaryMin:      request.DesiredSalaryMin,
+			DesiredSalaryMax:      request.DesiredSalaryMax,
+			DesiredSalaryCurrency: request.DesiredSalaryCurrency,
+			DesiredSalaryPeriod:   request.DesiredSalaryPeriod,
+			Skills:                request.SkillsDescription,
+			Experience:            request.Experience,
+			TelegramID:            request.TelegramId,
 		},
-		AfterCreate: func(user db.User) error {
-			return nil
+		AfterCreate: func(user db.User, verifyEmail db.VerifyEmail) error {
+			return server.sendVerifyEmail(user.FullName, verifyEmail)
 		},
 	}
 	// Create user
@@ -137,6 +155,10 @@ func (server *Server) createUser(ctx *gin.Context) {
 				return
 			}
 		}
+		if isUnsupportedCurrencyError(err) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(unsupportedCurrencyError))
+			return
+		}
 
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -169,8 +191,12 @@ type loginUserRequest struct {
 }
 
 type loginUserResponse struct {
-	AccessToken string       `json:"access_token"`
-	User        userResponse `json:"user"`
+	SessionID             uuid.UUID    `json:"session_id"`
+	AccessToken           string       `json:"access_token"`
+	AccessTokenExpiresAt  time.Time    `json:"access_token_expires_at"`
+	RefreshToken          string       `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time    `json:"refresh_token_expires_at"`
+	User                  userResponse `json:"user"`
 }
 
 // @Schemes
@@ -182,9 +208,9 @@ type loginUserResponse struct {
 // @param LoginUserRequest body loginUserRequest true "User credentials"
 // @Success 200 {object} loginUserResponse
 // @Failure 400 {object} ErrorResponse "Invalid request body"
-// @Failure 401 {object} ErrorResponse "Incorrect password"
-// @Failure 403 {object} ErrorResponse "Email not verified"
-// @Failure 404 {object} ErrorResponse "User with given email does not exist"
+// @Failure 401 {object} ErrorResponse "Incorrect email or password"
+// @Failure 403 {object} ErrorResponse "Account has been suspended"
+// @Failure 429 {object} ErrorResponse "Too many failed login attempts, see the Retry-After header"
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Router /users/login [post]
 // loginUser handles user login
@@ -195,11 +221,16 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
+	accountKey := loginAccountKey(token.RoleUser, request.Email)
+	if !server.checkLoginAttempts(ctx, accountKey) {
+		return
+	}
+
 	user, err := server.store.GetUserByEmail(ctx, request.Email)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			err = fmt.Errorf("user with this email does not exist")
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			checkPasswordOfMissingAccount(request.Password)
+			server.failLogin(ctx, accountKey, invalidCredentialsError)
 			return
 		}
 
@@ -209,12 +240,28 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	err = utils.CheckPassword(request.Password, user.HashedPassword)
 	if err != nil {
-		err = fmt.Errorf("incorrect password")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		server.failLogin(ctx, accountKey, invalidCredentialsError)
 		return
 	}
 
-	accessToken, err := server.tokenMaker.CreateToken(user.Email, server.config.AccessTokenDuration)
+	if user.SuspendedAt.Valid {
+		ctx.JSON(http.StatusForbidden, errorResponse(accountSuspendedError))
+		return
+	}
+
+	err = server.loginAccountLimiter.Reset(ctx, accountKey)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Email, token.RoleUser, user.ID, server.config.AccessTokenDuration)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	session, refreshToken, refreshPayload, err := server.createSession(ctx, user.Email, token.RoleUser, user.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -233,8 +280,12 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	}
 
 	res := loginUserResponse{
-		AccessToken: accessToken,
-		User:        newUserResponse(user, userSkills),
+		SessionID:             session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshPayload.ExpiredAt,
+		User:                  newUserResponse(user, userSkills),
 	}
 
 	ctx.JSON(http.StatusOK, res)
@@ -253,12 +304,11 @@ func (server *Server) loginUser(ctx *gin.Context) {
 // getUser handles getting user details
 func (server *Server) getUser(ctx *gin.Context) {
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	user, userSkills, err := server.store.GetUserDetailsByEmail(ctx, authPayload.Email)
+	// the email of the token is outdated after the email was changed, so the user is found by ID
+	user, userSkills, err := server.store.GetUserDetailsByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by an employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -270,17 +320,19 @@ func (server *Server) getUser(ctx *gin.Context) {
 }
 
 type updateUserRequest struct {
-	Email             string  `json:"email"`
-	FullName          string  `json:"full_name"`
-	Location          string  `json:"location"`
-	DesiredJobTitle   string  `json:"desired_job_title"`
-	DesiredIndustry   string  `json:"desired_industry"`
-	DesiredSalaryMin  int32   `json:"desired_salary_min"`
-	DesiredSalaryMax  int32   `json:"desired_salary_max"`
-	SkillsDescription string  `json:"skills_description"`
-	Experience        string  `json:"experience"`
-	SkillsToAdd       []Skill `json:"skills_to_add"`
-	SkillsToRemove    []int32 `json:"skill_ids_to_remove"`
+	Email                 string          `json:"email"`
+	FullName              string          `json:"full_name"`
+	Location              string          `json:"location"`
+	DesiredJobTitle       string          `json:"desired_job_title"`
+	DesiredIndustry       string          `json:"desired_industry"`
+	DesiredSalaryMin      int32           `json:"desired_salary_min"`
+	DesiredSalaryMax      int32           `json:"desired_salary_max"`
+	DesiredSalaryCurrency string          `json:"desired_salary_currency"`
+	DesiredSalaryPeriod   db.SalaryPeriod `json:"desired_salary_period"`
+	SkillsDescription     string          `json:"skills_description"`
+	Experience            string          `json:"experience"`
+	SkillsToAdd           []Skill         `json:"skills_to_add"`
+	SkillsToRemove        []int32         `json:"skill_ids_to_remove"`
 }
 
 // @Schemes
@@ -291,7 +343,7 @@ type updateUserRequest struct {
 // @Produce json
 // @param UpdateUserRequest body updateUserRequest true "User details to update"
 // @Success 200 {object} userResponse
-// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 400 {object} ErrorResponse "Invalid request body or there is no exchange rate for the currency"
 // @Failure 401 {object} ErrorResponse "Only users can update their details using this endpoint."
 // @Failure 500 {object} ErrorResponse "Any other error"
 // @Security ApiKeyAuth
@@ -312,13 +364,23 @@ func (server *Server) updateUser(ctx *gin.Context) {
 		}
 	}
 
+	if request.DesiredSalaryCurrency != "" {
+		if err := validation.ValidateCurrency(request.DesiredSalaryCurrency); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+
+	if request.DesiredSalaryPeriod != "" && !isSalaryPeriod(request.DesiredSalaryPeriod) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(invalidSalaryPeriodError))
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
+	authUser, err := server.store.GetUserByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by an employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -343,16 +405,18 @@ func (server *Server) updateUser(ctx *gin.Context) {
 	}
 
 	params := db.UpdateUserParams{
-		ID:               authUser.ID,
-		FullName:         request.FullName,
-		Email:            request.Email,
-		Location:         request.Location,
-		DesiredJobTitle:  request.DesiredJobTitle,
-		DesiredIndustry:  request.DesiredIndustry,
-		DesiredSalaryMin: salaryMin,
-		DesiredSalaryMax: salaryMax,
-		Skills:           request.SkillsDescription,
-		Experience:       request.Experience,
+		ID:                    authUser.ID,
+		FullName:              request.FullName,
+		Email:                 request.Email,
+		Location:              request.Location,
+		DesiredJobTitle:       request.DesiredJobTitle,
+		DesiredIndustry:       request.DesiredIndustry,
+		DesiredSalaryMin:      salaryMin,
+		DesiredSalaryMax:      salaryMax,
+		Skills:                request.SkillsDescription,
+		Experience:            request.Experience,
+		DesiredSalaryCurrency: request.DesiredSalaryCurrency,
+		DesiredSalaryPeriod:   request.DesiredSalaryPeriod,
 	}
 
 	if request.Email == "" {
@@ -376,10 +440,21 @@ func (server *Server) updateUser(ctx *gin.Context) {
 	if request.Experience == "" {
 		params.Experience = authUser.Experience
 	}
+	if request.DesiredSalaryCurrency == "" {
+		params.DesiredSalaryCurrency = authUser.DesiredSalaryCurrency
+	}
+	if request.DesiredSalaryPeriod == "" {
+		params.DesiredSalaryPeriod = authUser.DesiredSalaryPeriod
+	}
 
 	// Update user
 	updatedUser, err := server.store.UpdateUser(ctx, params)
 	if err != nil {
+		if isUnsupportedCurrencyError(err) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(unsupportedCurrencyError))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -454,12 +529,10 @@ func (server *Server) updateUserPassword(ctx *gin.Context) {
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
+	authUser, err := server.store.GetUserByID(ctx, authPayload.SubjectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by an employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
+			ctx.JSON(http.StatusUnauthorized, errorResponse(accountNotFoundError))
 			return
 		}
 
@@ -506,27 +579,16 @@ func (server *Server) updateUserPassword(ctx *gin.Context) {
 // deleteUser handles deleting users
 func (server *Server) deleteUser(ctx *gin.Context) {
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	authUser, err := server.store.GetUserByEmail(ctx, authPayload.Email)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			// but middleware did not stop the request, so we assume
-			// that the request was made by an employer
-			ctx.JSON(http.StatusUnauthorized, errorResponse(onlyUsersAccessError))
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
-	}
 
 	// delete all user skills
-	err = server.store.DeleteAllUserSkills(ctx, authUser.ID)
+	err := server.store.DeleteAllUserSkills(ctx, authPayload.SubjectID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
 	// delete the user
-	err = server.store.DeleteUser(ctx, authUser.ID)
+	err = server.store.DeleteUser(ctx, authPayload.SubjectID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -534,3 +596,21 @@ func (server *Server) deleteUser(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusNoContent, nil)
 }
+
+// @Schemes
+// @Summary Logout user
+// @Description Logout the user - block the session of the refresh token and revoke the access token used to make this request
+// @Tags users
+// @Accept json
+// @param LogoutRequest body logoutRequest true "Refresh token received at login"
+// @Success 204 {null} null
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Only users can access this endpoint or the refresh token is invalid."
+// @Failure 403 {object} ErrorResponse "Refresh token belongs to another account"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Security ApiKeyAuth
+// @Router /users/logout [post]
+// logoutUser handles logging out users
+func (server *Server) logoutUser(ctx *gin.Context) {
+	server.logout(ctx)
+}
@@ -2,11 +2,13 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/grannnsacker/job-finder-back/internal/db/mock"
 	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
 	"github.com/grannnsacker/job-finder-back/pkg/token"
@@ -43,7 +45,11 @@ func (e eqCreateUserTxParamsMatcher) Matches(x interface{}) bool {
 		return false
 	}
 
-	err = actualArg.AfterCreate(e.user)
+	err = actualArg.AfterCreate(e.user, db.VerifyEmail{
+		ID:         int64(utils.RandomInt(1, 1000)),
+		Email:      e.user.Email,
+		SecretCode: utils.RandomString(32),
+	})
 	return err == nil
 }
 
@@ -89,16 +95,18 @@ func TestCreateUserAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				params := db.CreateUserTxParams{
 					CreateUserParams: db.CreateUserParams{
-						FullName:         user.FullName,
-						Email:            user.Email,
-						HashedPassword:   user.HashedPassword,
-						Location:         user.Location,
-						DesiredJobTitle:  user.DesiredJobTitle,
-						DesiredIndustry:  user.DesiredIndustry,
-						DesiredSalaryMin: user.DesiredSalaryMin,
-						DesiredSalaryMax: user.DesiredSalaryMax,
-						Skills:           user.Skills,
-						Experience:       user.Experience,
+						FullName:              user.FullName,
+						Email:                 user.Email,
+						HashedPassword:        user.HashedPassword,
+						Location:              user.Location,
+						DesiredJobTitle:       user.DesiredJobTitle,
+						DesiredIndustry:       user.DesiredIndustry,
+						DesiredSalaryMin:      user.DesiredSalaryMin,
+						DesiredSalaryMax:      user.DesiredSalaryMax,
+						DesiredSalaryCurrency: user.DesiredSalaryCurrency,
+						DesiredSalaryPeriod:   user.DesiredSalaryPeriod,
+						Skills:                user.Skills,
+						Experience:            user.Experience,
 					},
 				}
 				store.EXPECT().
@@ -268,6 +276,9 @@ func TestLoginUserAPI(t *testing.T) {
 					Limit:  10,
 					Offset: 0,
 				}
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
 				store.EXPECT().
 					ListUserSkills(gomock.Any(), gomock.Eq(params)).
 					Times(1).
@@ -277,6 +288,27 @@ func TestLoginUserAPI(t *testing.T) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 			},
 		},
+		{
+			name: "User Suspended",
+			body: gin.H{
+				"email":    user.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				suspendedUser := user
+				suspendedUser.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(suspendedUser, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name: "Not Found",
 			body: gin.H{
@@ -288,12 +320,15 @@ func TestLoginUserAPI(t *testing.T) {
 					GetUserByEmail(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					ListUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				requireInvalidCredentials(t, recorder)
 			},
 		},
 		{
@@ -307,6 +342,9 @@ func TestLoginUserAPI(t *testing.T) {
 					GetUserByEmail(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.User{}, sql.ErrConnDone)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					ListUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -326,6 +364,9 @@ func TestLoginUserAPI(t *testing.T) {
 					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
 					Times(1).
 					Return(user, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
 				store.EXPECT().
 					ListUserSkills(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -335,6 +376,29 @@ func TestLoginUserAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "Internal Server Error CreateSession",
+			body: gin.H{
+				"email":    user.Email,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.Session{}, sql.ErrConnDone)
+				store.EXPECT().
+					ListUserSkills(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
 		{
 			name: "Invalid Email",
 			body: gin.H{
@@ -345,6 +409,9 @@ func TestLoginUserAPI(t *testing.T) {
 				store.EXPECT().
 					GetUserByEmail(gomock.Any(), gomock.Any()).
 					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					ListUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -364,12 +431,15 @@ func TestLoginUserAPI(t *testing.T) {
 					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
 					Times(1).
 					Return(user, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					ListUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				requireInvalidCredentials(t, recorder)
 			},
 		},
 		{
@@ -382,6 +452,9 @@ func TestLoginUserAPI(t *testing.T) {
 				store.EXPECT().
 					GetUserByEmail(gomock.Any(), gomock.Any()).
 					Times(0)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					ListUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -431,11 +504,11 @@ func TestGetUserAPI(t *testing.T) {
 		{
 			name: "OK",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserDetailsByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserDetailsByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, userSkills, nil)
 			},
@@ -445,15 +518,33 @@ func TestGetUserAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Unauthorized Only Users Access",
+			name: "OK Email Changed After Login",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, utils.RandomEmail(), token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserDetailsByEmail(gomock.Any(), gomock.Eq(employer.Email)).
+					GetUserDetailsByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
-					Return(db.User{}, []db.UserSkill{}, sql.ErrNoRows)
+					Return(user, userSkills, nil)
+				store.EXPECT().
+					GetUserDetailsByEmail(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchUser(t, recorder.Body, user, userSkills)
+			},
+		},
+		{
+			name: "Unauthorized Only Users Access",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserDetailsByID(gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -462,11 +553,11 @@ func TestGetUserAPI(t *testing.T) {
 		{
 			name: "Internal Server Error",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserDetailsByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserDetailsByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(db.User{}, []db.UserSkill{}, sql.ErrConnDone)
 			},
@@ -551,11 +642,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				"skill_ids_to_remove": skillIDsToRemove,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -595,13 +686,12 @@ func TestUpdateUserAPI(t *testing.T) {
 				"skill_ids_to_remove": skillIDsToRemove,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(db.User{}, sql.ErrNoRows)
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					UpdateUser(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -620,7 +710,7 @@ func TestUpdateUserAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetUserByEmail",
+			name: "Internal Server Error GetUserByID",
 			body: gin.H{
 				"location":            newDetails.Location,
 				"desired_job_title":   newDetails.DesiredJobTitle,
@@ -629,11 +719,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				"skill_ids_to_remove": skillIDsToRemove,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(db.User{}, sql.ErrConnDone)
 				store.EXPECT().
@@ -663,11 +753,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				"skill_ids_to_remove": skillIDsToRemove,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -698,11 +788,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				"skill_ids_to_remove": skillIDsToRemove,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -733,11 +823,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				"skill_ids_to_remove": skillIDsToRemove,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -770,11 +860,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				"desired_salary_max":  2000,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -803,17 +893,57 @@ func TestUpdateUserAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "Invalid Salary Currency",
+			body: gin.H{
+				"desired_salary_currency": "usd",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Salary Period",
+			body: gin.H{
+				"desired_salary_period": "weekly",
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name: "Invalid Email",
 			body: gin.H{
 				"email": "invalid",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
+					GetUserByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					UpdateUser(gomock.Any(), gomock.Any()).
@@ -838,11 +968,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				"location": 123,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
+					GetUserByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					UpdateUser(gomock.Any(), gomock.Any()).
@@ -868,11 +998,11 @@ func TestUpdateUserAPI(t *testing.T) {
 				"desired_salary_max": 100,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
+					GetUserByID(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -940,11 +1070,11 @@ func TestUpdateUserPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -963,11 +1093,11 @@ func TestUpdateUserPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -985,11 +1115,11 @@ func TestUpdateUserPasswordAPI(t *testing.T) {
 				"new_password": "123",
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Any()).
+					GetUserByID(gomock.Any(), gomock.Any()).
 					Times(0)
 				store.EXPECT().
 					UpdatePassword(gomock.Any(), gomock.Any()).
@@ -1006,11 +1136,11 @@ func TestUpdateUserPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -1028,13 +1158,12 @@ func TestUpdateUserPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(db.User{}, sql.ErrNoRows)
+					GetUserByID(gomock.Any(), gomock.Any()).
+					Times(0)
 				store.EXPECT().
 					UpdatePassword(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1044,17 +1173,17 @@ func TestUpdateUserPasswordAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetUserByEmail",
+			name: "Internal Server Error GetUserByID",
 			body: gin.H{
 				"old_password": oldPassword,
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(db.User{}, sql.ErrConnDone)
 				store.EXPECT().
@@ -1065,6 +1194,28 @@ func TestUpdateUserPasswordAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "Account Not Found",
+			body: gin.H{
+				"old_password": oldPassword,
+				"new_password": newPassword,
+			},
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().
+					UpdatePassword(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
 		{
 			name: "Internal Server Error UpdatePassword",
 			body: gin.H{
@@ -1072,11 +1223,11 @@ func TestUpdateUserPasswordAPI(t *testing.T) {
 				"new_password": newPassword,
 			},
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(user, nil)
 				store.EXPECT().
@@ -1130,13 +1281,9 @@ func TestDeleteUserAPI(t *testing.T) {
 		{
 			name: "OK",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
-					Times(1).
-					Return(user, nil)
 				store.EXPECT().
 					DeleteAllUserSkills(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
@@ -1153,13 +1300,9 @@ func TestDeleteUserAPI(t *testing.T) {
 		{
 			name: "Unauthorized Only User Access",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
-				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(employer.Email)).
-					Times(1).
-					Return(db.User{}, sql.ErrNoRows)
 				store.EXPECT().
 					DeleteAllUserSkills(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1172,18 +1315,15 @@ func TestDeleteUserAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "Internal Server Error GetUserByEmail",
+			name: "Internal Server Error DeleteAllUserSkills",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					DeleteAllUserSkills(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
-					Return(db.User{}, sql.ErrConnDone)
-				store.EXPECT().
-					DeleteAllUserSkills(gomock.Any(), gomock.Any()).
-					Times(0)
+					Return(sql.ErrConnDone)
 				store.EXPECT().
 					DeleteUser(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -1195,49 +1335,271 @@ func TestDeleteUserAPI(t *testing.T) {
 		{
 			name: "Internal Server Error DeleteAllUserSkills",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					DeleteAllUserSkills(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
-					Return(user, nil)
+					Return(nil)
 				store.EXPECT().
-					DeleteAllUserSkills(gomock.Any(), gomock.Eq(user.ID)).
+					DeleteUser(gomock.Any(), gomock.Eq(user.ID)).
 					Times(1).
 					Return(sql.ErrConnDone)
-				store.EXPECT().
-					DeleteUser(gomock.Any(), gomock.Any()).
-					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/users"
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, req, server.tokenMaker)
+
+			server.router.ServeHTTP(recorder, req)
+
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestLogoutUserAPI(t *testing.T) {
+	user, _ := generateRandomUser(t)
+	employer, _, _ := generateRandomEmployerAndCompany(t)
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, r *http.Request, maker token.Maker)
+		body          func(t *testing.T, maker token.Maker, refreshToken string) gin.H
+		buildStubs    func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload)
+		checkResponse func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string)
+	}{
 		{
-			name: "Internal Server Error DeleteAllUserSkills",
+			name: "OK",
 			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
-				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, time.Minute)
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
 				store.EXPECT().
-					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					BlockSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
 					Times(1).
-					Return(user, nil)
+					Return(nil)
 				store.EXPECT().
-					DeleteAllUserSkills(gomock.Any(), gomock.Eq(user.ID)).
+					GetUserDetailsByEmail(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+
+				// the same token cannot be used anymore
+				req, err := http.NewRequest(http.MethodGet, BaseUrl+"/users", nil)
+				require.NoError(t, err)
+				req.Header.Set(authorizationHeaderKey, r.Header.Get(authorizationHeaderKey))
+
+				recorder = httptest.NewRecorder()
+				server.router.ServeHTTP(recorder, req)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Renew Access Token After Logout",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				session := generateSession(refreshToken, refreshPayload)
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
 					Times(1).
-					Return(nil)
+					DoAndReturn(func(_ context.Context, _ uuid.UUID) error {
+						session.IsBlocked = true
+						return nil
+					})
 				store.EXPECT().
-					DeleteUser(gomock.Any(), gomock.Eq(user.ID)).
+					GetSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID) (db.Session, error) {
+						return session, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+
+				// the refresh token of the session cannot be used to get a new access token
+				data, err := json.Marshal(gin.H{"refresh_token": refreshToken})
+				require.NoError(t, err)
+				req, err := http.NewRequest(http.MethodPost, BaseUrl+"/tokens/renew_access", bytes.NewReader(data))
+				require.NoError(t, err)
+
+				recorder = httptest.NewRecorder()
+				server.router.ServeHTTP(recorder, req)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "OK Expired Refresh Token",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				expiredToken, _, err := maker.CreateRefreshToken(user.Email, token.RoleUser, user.ID, -time.Minute)
+				require.NoError(t, err)
+				return gin.H{"refresh_token": expiredToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Missing Refresh Token",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				return gin.H{}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Refresh Token",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				return gin.H{"refresh_token": utils.RandomString(32)}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Access Token Instead Of Refresh Token",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				accessToken, _, err := maker.CreateToken(user.Email, token.RoleUser, user.ID, time.Minute)
+				require.NoError(t, err)
+				return gin.H{"refresh_token": accessToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Refresh Token Of Employer With Same Email",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				otherToken, _, err := maker.CreateRefreshToken(user.Email, token.RoleEmployer, employer.ID, time.Minute)
+				require.NoError(t, err)
+				return gin.H{"refresh_token": otherToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Server Error BlockSession",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, user.Email, token.RoleUser, user.ID, time.Minute)
+			},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Eq(refreshPayload.ID)).
 					Times(1).
 					Return(sql.ErrConnDone)
 			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "Unauthorized Only User Access",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {
+				addAuthorization(t, r, maker, authorizationTypeBearer, employer.Email, token.RoleEmployer, employer.ID, time.Minute)
+			},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "No Authorization",
+			setupAuth: func(t *testing.T, r *http.Request, maker token.Maker) {},
+			body: func(t *testing.T, maker token.Maker, refreshToken string) gin.H {
+				return gin.H{"refresh_token": refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, refreshToken string, refreshPayload *token.Payload) {
+				store.EXPECT().
+					BlockSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server, r *http.Request, refreshToken string) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
 	}
+
 	for i := range testCases {
 		tc := testCases[i]
 
@@ -1246,20 +1608,26 @@ func TestDeleteUserAPI(t *testing.T) {
 			defer ctrl.Finish()
 
 			store := mockdb.NewMockStore(ctrl)
-			tc.buildStubs(store)
-
 			server := newTestServer(t, store, nil)
-			recorder := httptest.NewRecorder()
 
-			url := BaseUrl + "/users"
-			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			refreshToken, refreshPayload, err := server.tokenMaker.CreateRefreshToken(user.Email, token.RoleUser, user.ID, time.Minute)
+			require.NoError(t, err)
+
+			tc.buildStubs(store, refreshToken, refreshPayload)
+
+			data, err := json.Marshal(tc.body(t, server.tokenMaker, refreshToken))
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			url := BaseUrl + "/users/logout"
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
 			require.NoError(t, err)
 
 			tc.setupAuth(t, req, server.tokenMaker)
 
 			server.router.ServeHTTP(recorder, req)
 
-			tc.checkResponse(recorder)
+			tc.checkResponse(recorder, server, req, refreshToken)
 		})
 	}
 }
@@ -1350,18 +1718,20 @@ func generateRandomUser(t *testing.T) (db.User, string) {
 	require.NoError(t, err)
 
 	user := db.User{
-		ID:               utils.RandomInt(1, 1000),
-		FullName:         utils.RandomString(6),
-		Email:            utils.RandomEmail(),
-		HashedPassword:   hashedPassword,
-		Location:         utils.RandomString(4),
-		DesiredJobTitle:  utils.RandomString(3),
-		DesiredIndustry:  utils.RandomString(2),
-		DesiredSalaryMin: utils.RandomInt(1000, 1100),
-		DesiredSalaryMax: utils.RandomInt(1100, 1200),
-		Skills:           utils.RandomString(5),
-		Experience:       utils.RandomString(5),
-		CreatedAt:        time.Now(),
+		ID:                    utils.RandomInt(1, 1000),
+		FullName:              utils.RandomString(6),
+		Email:                 utils.RandomEmail(),
+		HashedPassword:        hashedPassword,
+		Location:              utils.RandomString(4),
+		DesiredJobTitle:       utils.RandomString(3),
+		DesiredIndustry:       utils.RandomString(2),
+		DesiredSalaryMin:      utils.RandomInt(1000, 1100),
+		DesiredSalaryMax:      utils.RandomInt(1100, 1200),
+		DesiredSalaryCurrency: "USD",
+		DesiredSalaryPeriod:   db.SalaryPeriodMonthly,
+		Skills:                utils.RandomString(5),
+		Experience:            utils.RandomString(5),
+		CreatedAt:             time.Now(),
 	}
 
 	return user, password
@@ -1383,6 +1753,8 @@ func requireBodyMatchUser(t *testing.T, body *bytes.Buffer, user db.User, skills
 	require.Equal(t, user.DesiredIndustry, gotUser.DesiredIndustry)
 	require.Equal(t, user.DesiredSalaryMin, gotUser.DesiredSalaryMin)
 	require.Equal(t, user.DesiredSalaryMax, gotUser.DesiredSalaryMax)
+	require.Equal(t, user.DesiredSalaryCurrency, gotUser.DesiredSalaryCurrency)
+	require.Equal(t, user.DesiredSalaryPeriod, gotUser.DesiredSalaryPeriod)
 	require.Equal(t, user.Skills, gotUser.SkillsDescription)
 	require.Equal(t, user.Experience, gotUser.Experience)
 	require.WithinDuration(t, user.CreatedAt, gotUser.CreatedAt, time.Second)
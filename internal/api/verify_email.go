@@ -0,0 +1,89 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"net/http"
+	"net/url"
+)
+
+var (
+	emailNotVerifiedError   = errors.New("email address has not been verified")
+	invalidVerifyEmailError = errors.New("verification code is invalid, already used or expired")
+)
+
+// sendVerifyEmail sends an email with the link that verifies the email address
+func (server *Server) sendVerifyEmail(fullName string, verifyEmail db.VerifyEmail) error {
+	query := url.Values{}
+	query.Set("id", fmt.Sprintf("%d", verifyEmail.ID))
+	query.Set("secret_code", verifyEmail.SecretCode)
+	verifyUrl := fmt.Sprintf("%s%s/verify_email?%s", server.config.PublicURL, BaseUrl, query.Encode())
+
+	subject := "Welcome to Job Finder"
+	content := fmt.Sprintf(`Hello %s,<br/>
+	Thank you for registering with us!<br/>
+	Please <a href="%s">click here</a> to verify your email address.<br/>
+	`, fullName, verifyUrl)
+
+	err := server.emailSender.SendEmail(subject, content, []string{verifyEmail.Email})
+	if err != nil {
+		return fmt.Errorf("cannot send verify email: %w", err)
+	}
+
+	return nil
+}
+
+type verifyEmailRequest struct {
+	ID         int64  `form:"id" binding:"required,min=1"`
+	SecretCode string `form:"secret_code" binding:"required,len=32"`
+}
+
+type verifyEmailResponse struct {
+	Email           string `json:"email"`
+	IsEmailVerified bool   `json:"is_email_verified"`
+}
+
+// @Schemes
+// @Summary Verify email
+// @Description Verify the email address of a user or employer with the code sent after registration
+// @Tags verify email
+// @Produce json
+// @param id query int true "Verify email ID"
+// @param secret_code query string true "Secret code"
+// @Success 200 {object} verifyEmailResponse
+// @Failure 400 {object} ErrorResponse "Invalid query"
+// @Failure 404 {object} ErrorResponse "Invalid, already used or expired code"
+// @Failure 500 {object} ErrorResponse "Any other error"
+// @Router /verify_email [get]
+// verifyEmail handles verifying the email address of users and employers
+func (server *Server) verifyEmail(ctx *gin.Context) {
+	var request verifyEmailRequest
+	if err := ctx.ShouldBindQuery(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	txResult, err := server.store.VerifyEmailTx(ctx, db.VerifyEmailTxParams{
+		EmailID:    request.ID,
+		SecretCode: request.SecretCode,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(invalidVerifyEmailError))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := verifyEmailResponse{
+		Email:           txResult.VerifyEmail.Email,
+		IsEmailVerified: true,
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
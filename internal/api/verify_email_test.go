@@ -0,0 +1,144 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/grannnsacker/job-finder-back/internal/db/mock"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestVerifyEmailAPI(t *testing.T) {
+	verifyEmail := db.VerifyEmail{
+		ID:         int64(utils.RandomInt(1, 1000)),
+		Email:      utils.RandomEmail(),
+		SecretCode: utils.RandomString(32),
+		IsUsed:     true,
+		CreatedAt:  time.Now(),
+		ExpiredAt:  time.Now().Add(15 * time.Minute),
+	}
+
+	testCases := []struct {
+		name          string
+		id            int64
+		secretCode    string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			id:         verifyEmail.ID,
+			secretCode: verifyEmail.SecretCode,
+			buildStubs: func(store *mockdb.MockStore) {
+				params := db.VerifyEmailTxParams{
+					EmailID:    verifyEmail.ID,
+					SecretCode: verifyEmail.SecretCode,
+				}
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.VerifyEmailTxResult{
+						VerifyEmail: verifyEmail,
+						IsUser:      true,
+					}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res verifyEmailResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				require.Equal(t, verifyEmail.Email, res.Email)
+				require.True(t, res.IsEmailVerified)
+			},
+		},
+		{
+			name:       "Invalid Or Expired Code",
+			id:         verifyEmail.ID,
+			secretCode: verifyEmail.SecretCode,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "Internal Server Error",
+			id:         verifyEmail.ID,
+			secretCode: verifyEmail.SecretCode,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:       "Invalid Code Length",
+			id:         verifyEmail.ID,
+			secretCode: utils.RandomString(31),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "Invalid ID",
+			id:         0,
+			secretCode: verifyEmail.SecretCode,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			url := BaseUrl + "/verify_email"
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			q := req.URL.Query()
+			q.Add("id", fmt.Sprintf("%d", tc.id))
+			q.Add("secret_code", tc.secretCode)
+			req.URL.RawQuery = q.Encode()
+
+			server.router.ServeHTTP(recorder, req)
+			tc.checkResponse(recorder)
+		})
+	}
+}
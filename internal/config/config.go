@@ -7,15 +7,26 @@ import (
 
 // Config stores configuration of the application
 type Config struct {
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	ServerAddress        string        `mapstructure:"SERVER_ADDRESS"`
-	BaseUrl              string        `mapstructure:"BASE_URL"`
-	ElasticSearchAddress string        `mapstructure:"ELASTICSEARCH_ADDRESS"`
-	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	DBDriver              string        `mapstructure:"DB_DRIVER"`
+	DBSource              string        `mapstructure:"DB_SOURCE"`
+	ServerAddress         string        `mapstructure:"SERVER_ADDRESS"`
+	BaseUrl               string        `mapstructure:"BASE_URL"`
+	ElasticSearchAddress  string        `mapstructure:"ELASTICSEARCH_ADDRESS"`
+	RedisAddress          string        `mapstructure:"REDIS_ADDRESS"`
+	TokenSymmetricKey     string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TokenKeyID            string        `mapstructure:"TOKEN_KEY_ID"`
+	TokenPrivateKey       string        `mapstructure:"TOKEN_PRIVATE_KEY"`
+	TokenVerificationKeys string        `mapstructure:"TOKEN_VERIFICATION_KEYS"`
+	AccessTokenDuration   time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration  time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	EmailSenderAddress    string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailSenderPassword   string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	SMTPAddress           string        `mapstructure:"SMTP_ADDRESS"`
+	EmailOutputDir        string        `mapstructure:"EMAIL_OUTPUT_DIR"`
+	PublicURL             string        `mapstructure:"PUBLIC_URL"`
+	AdminEmail            string        `mapstructure:"ADMIN_EMAIL"`
+	AdminPassword         string        `mapstructure:"ADMIN_PASSWORD"`
+	JobSchedulerInterval  time.Duration `mapstructure:"JOB_SCHEDULER_INTERVAL"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -10,22 +10,30 @@ import (
 func TestLoadConfig(t *testing.T) {
 	// Define test environment variables
 	const (
-		DBDriver             = "test_db_driver"
-		DBSource             = "test_db_source"
-		ServerAddress        = "test_server_address"
-		ElasticSearchAddress = "test_elasticsearch_address"
-		TokenSymmetricKey    = "test_token_symmetric_key"
-		AccessTokenDuration  = "1h"
+		DBDriver              = "test_db_driver"
+		DBSource              = "test_db_source"
+		ServerAddress         = "test_server_address"
+		ElasticSearchAddress  = "test_elasticsearch_address"
+		TokenSymmetricKey     = "test_token_symmetric_key"
+		TokenKeyID            = "test_token_key_id"
+		TokenPrivateKey       = "test_token_private_key"
+		TokenVerificationKeys = "test_key_id:test_public_key"
+		AccessTokenDuration   = "1h"
+		RefreshTokenDuration  = "24h"
 	)
 
 	// Set the environment variables for testing
 	setEnvVariables(t, map[string]string{
-		"DB_DRIVER":             DBDriver,
-		"DB_SOURCE":             DBSource,
-		"SERVER_ADDRESS":        ServerAddress,
-		"ELASTICSEARCH_ADDRESS": ElasticSearchAddress,
-		"TOKEN_SYMMETRIC_KEY":   TokenSymmetricKey,
-		"ACCESS_TOKEN_DURATION": AccessTokenDuration,
+		"DB_DRIVER":               DBDriver,
+		"DB_SOURCE":               DBSource,
+		"SERVER_ADDRESS":          ServerAddress,
+		"ELASTICSEARCH_ADDRESS":   ElasticSearchAddress,
+		"TOKEN_SYMMETRIC_KEY":     TokenSymmetricKey,
+		"TOKEN_KEY_ID":            TokenKeyID,
+		"TOKEN_PRIVATE_KEY":       TokenPrivateKey,
+		"TOKEN_VERIFICATION_KEYS": TokenVerificationKeys,
+		"ACCESS_TOKEN_DURATION":   AccessTokenDuration,
+		"REFRESH_TOKEN_DURATION":  RefreshTokenDuration,
 	})
 
 	// Load the config
@@ -38,10 +46,16 @@ func TestLoadConfig(t *testing.T) {
 	require.Equal(t, ServerAddress, config.ServerAddress)
 	require.Equal(t, ElasticSearchAddress, config.ElasticSearchAddress)
 	require.Equal(t, TokenSymmetricKey, config.TokenSymmetricKey)
+	require.Equal(t, TokenKeyID, config.TokenKeyID)
+	require.Equal(t, TokenPrivateKey, config.TokenPrivateKey)
+	require.Equal(t, TokenVerificationKeys, config.TokenVerificationKeys)
 
 	expectedAccessTokenDuration, _ := time.ParseDuration(AccessTokenDuration)
 	require.Equal(t, expectedAccessTokenDuration, config.AccessTokenDuration)
 
+	expectedRefreshTokenDuration, _ := time.ParseDuration(RefreshTokenDuration)
+	require.Equal(t, expectedRefreshTokenDuration, config.RefreshTokenDuration)
+
 	// Reset the environment variables after the test
 	resetEnvVariables()
 }
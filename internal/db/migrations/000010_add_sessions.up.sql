@@ -0,0 +1,16 @@
+CREATE TABLE "sessions"
+(
+    "id"            uuid PRIMARY KEY,
+    "email"         varchar     NOT NULL,
+    "role"          varchar     NOT NULL,
+    "subject_id"    integer     NOT NULL,
+    "refresh_token" varchar     NOT NULL,
+    "user_agent"    varchar     NOT NULL,
+    "client_ip"     varchar     NOT NULL,
+    "is_blocked"    boolean     NOT NULL DEFAULT false,
+    "expires_at"    timestamptz NOT NULL,
+    "created_at"    timestamptz NOT NULL DEFAULT (now())
+);
+
+-- a user and an employer can have the same email, so the sessions are identified by the account
+CREATE INDEX idx_sessions_role_subject_id ON sessions (role, subject_id);
@@ -0,0 +1,13 @@
+CREATE TABLE "password_resets"
+(
+    "id"           bigserial PRIMARY KEY,
+    "email"        varchar     NOT NULL,
+    "account_type" varchar     NOT NULL,
+    "account_id"   integer     NOT NULL,
+    "hashed_code"  varchar     NOT NULL,
+    "is_used"      bool        NOT NULL DEFAULT false,
+    "created_at"   timestamptz NOT NULL DEFAULT (now()),
+    "expired_at"   timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
+);
+
+CREATE INDEX idx_password_resets_email_account_type ON password_resets (email, account_type);
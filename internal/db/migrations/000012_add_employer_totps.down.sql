@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "employer_recovery_codes";
+DROP TABLE IF EXISTS "employer_totps";
@@ -0,0 +1,19 @@
+CREATE TABLE "employer_totps"
+(
+    "employer_id"    integer PRIMARY KEY REFERENCES "employers" ("id") ON DELETE CASCADE,
+    "secret"         varchar     NOT NULL,
+    "is_enabled"     bool        NOT NULL DEFAULT false,
+    "last_used_step" bigint      NOT NULL DEFAULT 0,
+    "created_at"     timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "employer_recovery_codes"
+(
+    "id"          bigserial PRIMARY KEY,
+    "employer_id" integer     NOT NULL REFERENCES "employers" ("id") ON DELETE CASCADE,
+    "hashed_code" varchar     NOT NULL,
+    "is_used"     bool        NOT NULL DEFAULT false,
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_employer_recovery_codes_employer_id ON employer_recovery_codes (employer_id);
@@ -0,0 +1,15 @@
+CREATE TABLE "company_api_keys"
+(
+    "id"           bigserial PRIMARY KEY,
+    "company_id"   integer        NOT NULL REFERENCES "companies" ("id") ON DELETE CASCADE,
+    "employer_id"  integer        NOT NULL REFERENCES "employers" ("id") ON DELETE CASCADE,
+    "name"         varchar        NOT NULL,
+    "key_prefix"   varchar        NOT NULL,
+    "hashed_key"   varchar UNIQUE NOT NULL,
+    "scopes"       varchar[]      NOT NULL,
+    "last_used_at" timestamptz,
+    "revoked_at"   timestamptz,
+    "created_at"   timestamptz    NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_company_api_keys_company_id ON company_api_keys (company_id);
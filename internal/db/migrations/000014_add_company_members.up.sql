@@ -0,0 +1,22 @@
+CREATE TYPE employer_role AS ENUM ('owner', 'recruiter', 'viewer');
+
+-- every employer registered so far has created their own company
+ALTER TABLE "employers" ADD COLUMN "role" employer_role NOT NULL DEFAULT 'owner';
+
+CREATE INDEX idx_employers_company_id ON employers (company_id);
+
+CREATE TABLE "company_invitations"
+(
+    "id"          bigserial PRIMARY KEY,
+    "company_id"  integer        NOT NULL REFERENCES "companies" ("id") ON DELETE CASCADE,
+    "invited_by"  integer        NOT NULL REFERENCES "employers" ("id") ON DELETE CASCADE,
+    "email"       varchar        NOT NULL,
+    "role"        employer_role  NOT NULL,
+    "hashed_code" varchar UNIQUE NOT NULL,
+    "is_accepted" bool           NOT NULL DEFAULT false,
+    "created_at"  timestamptz    NOT NULL DEFAULT (now()),
+    "expired_at"  timestamptz    NOT NULL DEFAULT (now() + interval '7 days')
+);
+
+CREATE INDEX idx_company_invitations_company_id ON company_invitations (company_id);
+CREATE INDEX idx_company_invitations_email ON company_invitations (email);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "admin_audit_logs";
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "unpublished_at";
+ALTER TABLE "companies" DROP COLUMN IF EXISTS "suspended_at";
+ALTER TABLE "employers" DROP COLUMN IF EXISTS "suspended_at";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "suspended_at";
+DROP TABLE IF EXISTS "admins";
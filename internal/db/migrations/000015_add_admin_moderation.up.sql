@@ -0,0 +1,30 @@
+CREATE TABLE "admins"
+(
+    "id"              serial PRIMARY KEY,
+    "full_name"       varchar        NOT NULL,
+    "email"           varchar UNIQUE NOT NULL,
+    "hashed_password" varchar        NOT NULL,
+    "created_at"      timestamptz    NOT NULL DEFAULT (now())
+);
+
+-- suspended accounts cannot log in, jobs of suspended companies are not listed
+ALTER TABLE "users" ADD COLUMN "suspended_at" timestamptz;
+ALTER TABLE "employers" ADD COLUMN "suspended_at" timestamptz;
+ALTER TABLE "companies" ADD COLUMN "suspended_at" timestamptz;
+
+-- jobs taken down by an admin are not listed
+ALTER TABLE "jobs" ADD COLUMN "unpublished_at" timestamptz;
+
+CREATE TABLE "admin_audit_logs"
+(
+    "id"          bigserial PRIMARY KEY,
+    "admin_id"    integer     NOT NULL REFERENCES "admins" ("id"),
+    "action"      varchar     NOT NULL,
+    "target_type" varchar     NOT NULL,
+    "target_id"   bigint,
+    "details"     text        NOT NULL DEFAULT '',
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_admin_audit_logs_admin_id ON admin_audit_logs (admin_id);
+CREATE INDEX idx_admin_audit_logs_target ON admin_audit_logs (target_type, target_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_jobs_status_expires_at;
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "expires_at";
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "status";
+DROP TYPE IF EXISTS job_status;
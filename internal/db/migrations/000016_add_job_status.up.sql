@@ -0,0 +1,8 @@
+CREATE TYPE job_status AS ENUM ('draft', 'published', 'closed', 'expired');
+
+-- existing jobs are already live, so they are published
+ALTER TABLE "jobs" ADD COLUMN "status" job_status NOT NULL DEFAULT 'published';
+-- published jobs are not listed after expires_at, NULL means the job does not expire
+ALTER TABLE "jobs" ADD COLUMN "expires_at" timestamptz;
+
+CREATE INDEX idx_jobs_status_expires_at ON jobs (status, expires_at);
@@ -0,0 +1,2 @@
+-- deleted jobs are kept, so applicants keep the history of their applications
+ALTER TABLE "jobs" ADD COLUMN "deleted_at" timestamptz;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_jobs_seniority_level;
+DROP INDEX IF EXISTS idx_jobs_work_mode;
+DROP INDEX IF EXISTS idx_jobs_employment_type;
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "seniority_level";
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "work_mode";
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "employment_type";
+DROP TYPE IF EXISTS seniority_level;
+DROP TYPE IF EXISTS work_mode;
+DROP TYPE IF EXISTS employment_type;
@@ -0,0 +1,17 @@
+CREATE TYPE employment_type AS ENUM ('full_time', 'part_time', 'contract', 'internship', 'temporary');
+CREATE TYPE work_mode AS ENUM ('on_site', 'remote', 'hybrid');
+CREATE TYPE seniority_level AS ENUM ('intern', 'junior', 'middle', 'senior', 'lead');
+
+ALTER TABLE "jobs" ADD COLUMN "employment_type" employment_type NOT NULL DEFAULT 'full_time';
+ALTER TABLE "jobs" ADD COLUMN "work_mode" work_mode NOT NULL DEFAULT 'on_site';
+ALTER TABLE "jobs" ADD COLUMN "seniority_level" seniority_level NOT NULL DEFAULT 'middle';
+
+-- seniority of existing jobs was only a part of their titles, e.g. "Senior Go Developer"
+UPDATE "jobs" SET "seniority_level" = 'intern' WHERE "title" ILIKE 'intern %';
+UPDATE "jobs" SET "seniority_level" = 'junior' WHERE "title" ILIKE 'junior %';
+UPDATE "jobs" SET "seniority_level" = 'senior' WHERE "title" ILIKE 'senior %';
+UPDATE "jobs" SET "seniority_level" = 'lead' WHERE "title" ILIKE 'lead %';
+
+CREATE INDEX idx_jobs_employment_type ON jobs (employment_type);
+CREATE INDEX idx_jobs_work_mode ON jobs (work_mode);
+CREATE INDEX idx_jobs_seniority_level ON jobs (seniority_level);
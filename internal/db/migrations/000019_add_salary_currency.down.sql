@@ -0,0 +1,7 @@
+DROP FUNCTION IF EXISTS normalize_salary(INTEGER, CHAR(3), salary_period);
+ALTER TABLE "users" DROP COLUMN IF EXISTS "desired_salary_period";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "desired_salary_currency";
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "salary_period";
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "salary_currency";
+DROP TABLE IF EXISTS "exchange_rates";
+DROP TYPE IF EXISTS salary_period;
@@ -0,0 +1,44 @@
+CREATE TYPE salary_period AS ENUM ('hourly', 'monthly', 'yearly');
+
+-- rate_to_usd is the value of one unit of the currency in USD
+CREATE TABLE "exchange_rates"
+(
+    "currency"    CHAR(3) PRIMARY KEY,
+    "rate_to_usd" NUMERIC(20, 10) NOT NULL CHECK ("rate_to_usd" > 0),
+    "updated_at"  TIMESTAMPTZ     NOT NULL DEFAULT (now())
+);
+
+INSERT INTO "exchange_rates" ("currency", "rate_to_usd")
+VALUES ('USD', 1),
+       ('EUR', 1.08),
+       ('GBP', 1.27),
+       ('CHF', 1.13),
+       ('JPY', 0.0067),
+       ('CNY', 0.14),
+       ('CAD', 0.73),
+       ('AUD', 0.66),
+       ('PLN', 0.25),
+       ('RUB', 0.011),
+       ('KZT', 0.0021),
+       ('UAH', 0.024),
+       ('INR', 0.012);
+
+-- existing salaries were entered without a currency or a period, they are treated as USD per month
+ALTER TABLE "jobs" ADD COLUMN "salary_currency" CHAR(3) NOT NULL DEFAULT 'USD' REFERENCES "exchange_rates" ("currency");
+ALTER TABLE "jobs" ADD COLUMN "salary_period" salary_period NOT NULL DEFAULT 'monthly';
+
+ALTER TABLE "users" ADD COLUMN "desired_salary_currency" CHAR(3) NOT NULL DEFAULT 'USD' REFERENCES "exchange_rates" ("currency");
+ALTER TABLE "users" ADD COLUMN "desired_salary_period" salary_period NOT NULL DEFAULT 'monthly';
+
+-- normalize_salary converts the salary to USD per year,
+-- so salaries in different currencies and periods can be compared
+CREATE FUNCTION normalize_salary(amount INTEGER, currency CHAR(3), period salary_period) RETURNS NUMERIC AS
+$$
+SELECT amount * er.rate_to_usd * CASE period
+                                     WHEN 'hourly' THEN 2080
+                                     WHEN 'monthly' THEN 12
+                                     ELSE 1
+    END
+FROM exchange_rates er
+WHERE er.currency = normalize_salary.currency
+$$ LANGUAGE SQL STABLE;
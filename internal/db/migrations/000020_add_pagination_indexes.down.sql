@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_job_applications_job_id_applied_at_id;
+DROP INDEX IF EXISTS idx_job_applications_user_id_applied_at_id;
+DROP INDEX IF EXISTS idx_jobs_company_id_created_at_id;
+DROP INDEX IF EXISTS idx_jobs_created_at_id;
@@ -0,0 +1,5 @@
+-- list endpoints are paginated with a cursor on (created_at, id) or (applied_at, id)
+CREATE INDEX idx_jobs_created_at_id ON jobs (created_at, id);
+CREATE INDEX idx_jobs_company_id_created_at_id ON jobs (company_id, created_at, id);
+CREATE INDEX idx_job_applications_user_id_applied_at_id ON job_applications (user_id, applied_at, id);
+CREATE INDEX idx_job_applications_job_id_applied_at_id ON job_applications (job_id, applied_at, id);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_job_skills_skill_job_id;
@@ -0,0 +1,3 @@
+-- jobs are filtered by the skills they require, the unique (job_id, skill)
+-- constraint cannot be used to find the jobs of a skill
+CREATE INDEX idx_job_skills_skill_job_id ON job_skills (skill, job_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "job_views";
+DROP TYPE IF EXISTS job_view_source;
@@ -0,0 +1,14 @@
+CREATE TYPE job_view_source AS ENUM ('detail', 'search');
+
+-- a viewer is counted once per job, source and day (UTC), repeated views are not stored
+CREATE TABLE "job_views"
+(
+    "id"         bigserial PRIMARY KEY,
+    "job_id"     integer         NOT NULL REFERENCES "jobs" ("id") ON DELETE CASCADE,
+    "viewer"     varchar         NOT NULL,
+    "source"     job_view_source NOT NULL,
+    "viewed_on"  date            NOT NULL DEFAULT ((now() AT TIME ZONE 'UTC')::date),
+    "created_at" timestamptz     NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX idx_job_views_job_id_viewed_on_source_viewer ON job_views (job_id, viewed_on, source, viewer);
@@ -0,0 +1,13 @@
+-- templates of the jobs a company posts often, a job can be created from a template
+CREATE TABLE "job_templates"
+(
+    "id"              serial PRIMARY KEY,
+    "company_id"      integer     NOT NULL REFERENCES "companies" ("id") ON DELETE CASCADE,
+    "name"            varchar     NOT NULL,
+    "description"     text        NOT NULL,
+    "requirements"    text        NOT NULL,
+    "required_skills" varchar[]   NOT NULL DEFAULT '{}',
+    "created_at"      timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"      timestamptz NOT NULL DEFAULT (now()),
+    UNIQUE ("company_id", "name")
+);
@@ -0,0 +1,3 @@
+ALTER TABLE "job_applications" DROP COLUMN IF EXISTS "job_revision_id";
+DROP TABLE IF EXISTS "job_revisions";
+DROP FUNCTION IF EXISTS prevent_job_revision_update();
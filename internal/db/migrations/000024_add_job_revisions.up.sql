@@ -0,0 +1,68 @@
+-- every create and update of a job is stored as a revision, revisions are never changed
+CREATE TABLE "job_revisions"
+(
+    "id"              serial PRIMARY KEY,
+    "job_id"          integer         NOT NULL REFERENCES "jobs" ("id") ON DELETE CASCADE,
+    -- revisions of a job are numbered from 1
+    "revision"        integer         NOT NULL,
+    -- the employer who made the change, null for the revisions created by the migration
+    "author_id"       integer REFERENCES "employers" ("id") ON DELETE SET NULL,
+    "title"           text            NOT NULL,
+    "industry"        text            NOT NULL,
+    "description"     text            NOT NULL,
+    "location"        text            NOT NULL,
+    "salary_min"      integer         NOT NULL,
+    "salary_max"      integer         NOT NULL,
+    "salary_currency" char(3)         NOT NULL,
+    "salary_period"   salary_period   NOT NULL,
+    "requirements"    text            NOT NULL,
+    "required_skills" varchar[]       NOT NULL DEFAULT '{}',
+    "employment_type" employment_type NOT NULL,
+    "work_mode"       work_mode       NOT NULL,
+    "seniority_level" seniority_level NOT NULL,
+    "created_at"      timestamptz     NOT NULL DEFAULT (now()),
+    UNIQUE ("job_id", "revision")
+);
+
+CREATE FUNCTION prevent_job_revision_update() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'job revisions cannot be changed';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "job_revisions_immutable"
+    BEFORE UPDATE
+    ON "job_revisions"
+    FOR EACH ROW
+EXECUTE FUNCTION prevent_job_revision_update();
+
+-- the current state of the existing jobs is their first revision
+INSERT INTO "job_revisions" ("job_id", "revision", "title", "industry", "description", "location",
+                             "salary_min", "salary_max", "salary_currency", "salary_period", "requirements",
+                             "required_skills", "employment_type", "work_mode", "seniority_level", "created_at")
+SELECT j.id,
+       1,
+       j.title,
+       j.industry,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       COALESCE((SELECT array_agg(js.skill ORDER BY js.skill) FROM job_skills js WHERE js.job_id = j.id), '{}'),
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
+       j.created_at
+FROM jobs j;
+
+-- the revision of the job the candidate applied to
+ALTER TABLE "job_applications" ADD COLUMN "job_revision_id" integer REFERENCES "job_revisions" ("id") ON DELETE SET NULL;
+
+UPDATE "job_applications" ja
+SET "job_revision_id" = jr.id
+FROM job_revisions jr
+WHERE jr.job_id = ja.job_id;
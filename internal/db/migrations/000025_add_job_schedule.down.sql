@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_jobs_status_publish_at;
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "expiry_notified_at";
+ALTER TABLE "jobs" DROP COLUMN IF EXISTS "publish_at";
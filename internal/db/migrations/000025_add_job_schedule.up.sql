@@ -0,0 +1,6 @@
+-- drafts with publish_at are published by the scheduler at that time
+ALTER TABLE "jobs" ADD COLUMN "publish_at" timestamptz;
+-- the employers are notified once before the job expires, it is reset when expires_at changes
+ALTER TABLE "jobs" ADD COLUMN "expiry_notified_at" timestamptz;
+
+CREATE INDEX idx_jobs_status_publish_at ON jobs (status, publish_at);
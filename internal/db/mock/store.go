@@ -7,9 +7,11 @@ package mockdb
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
-	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/grannnsacker/job-finder-back/internal/db/sqlc"
 )
 
 // MockStore is a mock of Store interface.
@@ -18,11 +20,6 @@ type MockStore struct {
 	recorder *MockStoreMockRecorder
 }
 
-func (m *MockStore) UpdateJobApplicationNote(ctx context.Context, arg db.UpdateJobApplicationNoteParams) error {
-	//TODO implement me
-	panic("implement me")
-}
-
 // MockStoreMockRecorder is the mock recorder for MockStore.
 type MockStoreMockRecorder struct {
 	mock *MockStore
@@ -40,6 +37,229 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AcceptCompanyInvitation mocks base method.
+func (m *MockStore) AcceptCompanyInvitation(arg0 context.Context, arg1 db.AcceptCompanyInvitationParams) (db.CompanyInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptCompanyInvitation", arg0, arg1)
+	ret0, _ := ret[0].(db.CompanyInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptCompanyInvitation indicates an expected call of AcceptCompanyInvitation.
+func (mr *MockStoreMockRecorder) AcceptCompanyInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptCompanyInvitation", reflect.TypeOf((*MockStore)(nil).AcceptCompanyInvitation), arg0, arg1)
+}
+
+// AdminActionTx mocks base method.
+func (m *MockStore) AdminActionTx(arg0 context.Context, arg1 db.AdminActionTxParams) (db.AdminActionTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminActionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AdminActionTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminActionTx indicates an expected call of AdminActionTx.
+func (mr *MockStoreMockRecorder) AdminActionTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminActionTx", reflect.TypeOf((*MockStore)(nil).AdminActionTx), arg0, arg1)
+}
+
+// BlockSession mocks base method.
+func (m *MockStore) BlockSession(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockStoreMockRecorder) BlockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockStore)(nil).BlockSession), arg0, arg1)
+}
+
+// BlockSessionsBySubject mocks base method.
+func (m *MockStore) BlockSessionsBySubject(arg0 context.Context, arg1 db.BlockSessionsBySubjectParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSessionsBySubject", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockSessionsBySubject indicates an expected call of BlockSessionsBySubject.
+func (mr *MockStoreMockRecorder) BlockSessionsBySubject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSessionsBySubject", reflect.TypeOf((*MockStore)(nil).BlockSessionsBySubject), arg0, arg1)
+}
+
+// CountCompanyEmployers mocks base method.
+func (m *MockStore) CountCompanyEmployers(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCompanyEmployers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCompanyEmployers indicates an expected call of CountCompanyEmployers.
+func (mr *MockStoreMockRecorder) CountCompanyEmployers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompanyEmployers", reflect.TypeOf((*MockStore)(nil).CountCompanyEmployers), arg0, arg1)
+}
+
+// CountCompanyEmployersByRole mocks base method.
+func (m *MockStore) CountCompanyEmployersByRole(arg0 context.Context, arg1 db.CountCompanyEmployersByRoleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCompanyEmployersByRole", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCompanyEmployersByRole indicates an expected call of CountCompanyEmployersByRole.
+func (mr *MockStoreMockRecorder) CountCompanyEmployersByRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompanyEmployersByRole", reflect.TypeOf((*MockStore)(nil).CountCompanyEmployersByRole), arg0, arg1)
+}
+
+// CountJobApplicationsForEmployer mocks base method.
+func (m *MockStore) CountJobApplicationsForEmployer(arg0 context.Context, arg1 db.CountJobApplicationsForEmployerParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobApplicationsForEmployer", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobApplicationsForEmployer indicates an expected call of CountJobApplicationsForEmployer.
+func (mr *MockStoreMockRecorder) CountJobApplicationsForEmployer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobApplicationsForEmployer", reflect.TypeOf((*MockStore)(nil).CountJobApplicationsForEmployer), arg0, arg1)
+}
+
+// CountJobApplicationsForUser mocks base method.
+func (m *MockStore) CountJobApplicationsForUser(arg0 context.Context, arg1 db.CountJobApplicationsForUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobApplicationsForUser", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobApplicationsForUser indicates an expected call of CountJobApplicationsForUser.
+func (mr *MockStoreMockRecorder) CountJobApplicationsForUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobApplicationsForUser", reflect.TypeOf((*MockStore)(nil).CountJobApplicationsForUser), arg0, arg1)
+}
+
+// CountJobsByCompanyExactName mocks base method.
+func (m *MockStore) CountJobsByCompanyExactName(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobsByCompanyExactName", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobsByCompanyExactName indicates an expected call of CountJobsByCompanyExactName.
+func (mr *MockStoreMockRecorder) CountJobsByCompanyExactName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobsByCompanyExactName", reflect.TypeOf((*MockStore)(nil).CountJobsByCompanyExactName), arg0, arg1)
+}
+
+// CountJobsByCompanyID mocks base method.
+func (m *MockStore) CountJobsByCompanyID(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobsByCompanyID", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobsByCompanyID indicates an expected call of CountJobsByCompanyID.
+func (mr *MockStoreMockRecorder) CountJobsByCompanyID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobsByCompanyID", reflect.TypeOf((*MockStore)(nil).CountJobsByCompanyID), arg0, arg1)
+}
+
+// CountJobsByCompanyName mocks base method.
+func (m *MockStore) CountJobsByCompanyName(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobsByCompanyName", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobsByCompanyName indicates an expected call of CountJobsByCompanyName.
+func (mr *MockStoreMockRecorder) CountJobsByCompanyName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobsByCompanyName", reflect.TypeOf((*MockStore)(nil).CountJobsByCompanyName), arg0, arg1)
+}
+
+// CountJobsByFilters mocks base method.
+func (m *MockStore) CountJobsByFilters(arg0 context.Context, arg1 db.ListJobsByFiltersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobsByFilters", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobsByFilters indicates an expected call of CountJobsByFilters.
+func (mr *MockStoreMockRecorder) CountJobsByFilters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobsByFilters", reflect.TypeOf((*MockStore)(nil).CountJobsByFilters), arg0, arg1)
+}
+
+// CountJobsForEmployer mocks base method.
+func (m *MockStore) CountJobsForEmployer(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJobsForEmployer", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJobsForEmployer indicates an expected call of CountJobsForEmployer.
+func (mr *MockStoreMockRecorder) CountJobsForEmployer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJobsForEmployer", reflect.TypeOf((*MockStore)(nil).CountJobsForEmployer), arg0, arg1)
+}
+
+// CreateAdmin mocks base method.
+func (m *MockStore) CreateAdmin(arg0 context.Context, arg1 db.CreateAdminParams) (db.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdmin", arg0, arg1)
+	ret0, _ := ret[0].(db.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdmin indicates an expected call of CreateAdmin.
+func (mr *MockStoreMockRecorder) CreateAdmin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockStore)(nil).CreateAdmin), arg0, arg1)
+}
+
+// CreateAdminAuditLog mocks base method.
+func (m *MockStore) CreateAdminAuditLog(arg0 context.Context, arg1 db.CreateAdminAuditLogParams) (db.AdminAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdminAuditLog", arg0, arg1)
+	ret0, _ := ret[0].(db.AdminAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdminAuditLog indicates an expected call of CreateAdminAuditLog.
+func (mr *MockStoreMockRecorder) CreateAdminAuditLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminAuditLog", reflect.TypeOf((*MockStore)(nil).CreateAdminAuditLog), arg0, arg1)
+}
+
 // CreateCompany mocks base method.
 func (m *MockStore) CreateCompany(arg0 context.Context, arg1 db.CreateCompanyParams) (db.Company, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +275,51 @@ func (mr *MockStoreMockRecorder) CreateCompany(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompany", reflect.TypeOf((*MockStore)(nil).CreateCompany), arg0, arg1)
 }
 
+// CreateCompanyAPIKey mocks base method.
+func (m *MockStore) CreateCompanyAPIKey(arg0 context.Context, arg1 db.CreateCompanyAPIKeyParams) (db.CompanyApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompanyAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(db.CompanyApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompanyAPIKey indicates an expected call of CreateCompanyAPIKey.
+func (mr *MockStoreMockRecorder) CreateCompanyAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompanyAPIKey", reflect.TypeOf((*MockStore)(nil).CreateCompanyAPIKey), arg0, arg1)
+}
+
+// CreateCompanyInvitation mocks base method.
+func (m *MockStore) CreateCompanyInvitation(arg0 context.Context, arg1 db.CreateCompanyInvitationParams) (db.CompanyInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompanyInvitation", arg0, arg1)
+	ret0, _ := ret[0].(db.CompanyInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompanyInvitation indicates an expected call of CreateCompanyInvitation.
+func (mr *MockStoreMockRecorder) CreateCompanyInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompanyInvitation", reflect.TypeOf((*MockStore)(nil).CreateCompanyInvitation), arg0, arg1)
+}
+
+// CreateCompanyInvitationTx mocks base method.
+func (m *MockStore) CreateCompanyInvitationTx(arg0 context.Context, arg1 db.CreateCompanyInvitationTxParams) (db.CreateCompanyInvitationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompanyInvitationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateCompanyInvitationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompanyInvitationTx indicates an expected call of CreateCompanyInvitationTx.
+func (mr *MockStoreMockRecorder) CreateCompanyInvitationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompanyInvitationTx", reflect.TypeOf((*MockStore)(nil).CreateCompanyInvitationTx), arg0, arg1)
+}
+
 // CreateEmployer mocks base method.
 func (m *MockStore) CreateEmployer(arg0 context.Context, arg1 db.CreateEmployerParams) (db.Employer, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +335,21 @@ func (mr *MockStoreMockRecorder) CreateEmployer(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployer", reflect.TypeOf((*MockStore)(nil).CreateEmployer), arg0, arg1)
 }
 
+// CreateEmployerRecoveryCode mocks base method.
+func (m *MockStore) CreateEmployerRecoveryCode(arg0 context.Context, arg1 db.CreateEmployerRecoveryCodeParams) (db.EmployerRecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmployerRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(db.EmployerRecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEmployerRecoveryCode indicates an expected call of CreateEmployerRecoveryCode.
+func (mr *MockStoreMockRecorder) CreateEmployerRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployerRecoveryCode", reflect.TypeOf((*MockStore)(nil).CreateEmployerRecoveryCode), arg0, arg1)
+}
+
 // CreateEmployerTx mocks base method.
 func (m *MockStore) CreateEmployerTx(arg0 context.Context, arg1 db.CreateEmployerTxParams) (db.CreateEmployerTxResult, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +410,21 @@ func (mr *MockStoreMockRecorder) CreateJobApplicationTx(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobApplicationTx", reflect.TypeOf((*MockStore)(nil).CreateJobApplicationTx), arg0, arg1)
 }
 
+// CreateJobRevision mocks base method.
+func (m *MockStore) CreateJobRevision(arg0 context.Context, arg1 db.CreateJobRevisionParams) (db.JobRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJobRevision", arg0, arg1)
+	ret0, _ := ret[0].(db.JobRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobRevision indicates an expected call of CreateJobRevision.
+func (mr *MockStoreMockRecorder) CreateJobRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobRevision", reflect.TypeOf((*MockStore)(nil).CreateJobRevision), arg0, arg1)
+}
+
 // CreateJobSkill mocks base method.
 func (m *MockStore) CreateJobSkill(arg0 context.Context, arg1 db.CreateJobSkillParams) (db.JobSkill, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +440,36 @@ func (mr *MockStoreMockRecorder) CreateJobSkill(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobSkill", reflect.TypeOf((*MockStore)(nil).CreateJobSkill), arg0, arg1)
 }
 
+// CreateJobTemplate mocks base method.
+func (m *MockStore) CreateJobTemplate(arg0 context.Context, arg1 db.CreateJobTemplateParams) (db.JobTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJobTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.JobTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobTemplate indicates an expected call of CreateJobTemplate.
+func (mr *MockStoreMockRecorder) CreateJobTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobTemplate", reflect.TypeOf((*MockStore)(nil).CreateJobTemplate), arg0, arg1)
+}
+
+// CreateJobTx mocks base method.
+func (m *MockStore) CreateJobTx(arg0 context.Context, arg1 db.CreateJobTxParams) (db.CreateJobTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJobTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateJobTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJobTx indicates an expected call of CreateJobTx.
+func (mr *MockStoreMockRecorder) CreateJobTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobTx", reflect.TypeOf((*MockStore)(nil).CreateJobTx), arg0, arg1)
+}
+
 // CreateMultipleJobSkills mocks base method.
 func (m *MockStore) CreateMultipleJobSkills(arg0 context.Context, arg1 []string, arg2 int32) error {
 	m.ctrl.T.Helper()
@@ -174,6 +499,51 @@ func (mr *MockStoreMockRecorder) CreateMultipleUserSkills(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultipleUserSkills", reflect.TypeOf((*MockStore)(nil).CreateMultipleUserSkills), arg0, arg1, arg2)
 }
 
+// CreatePasswordReset mocks base method.
+func (m *MockStore) CreatePasswordReset(arg0 context.Context, arg1 db.CreatePasswordResetParams) (db.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(db.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockStoreMockRecorder) CreatePasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockStore)(nil).CreatePasswordReset), arg0, arg1)
+}
+
+// CreatePasswordResetTx mocks base method.
+func (m *MockStore) CreatePasswordResetTx(arg0 context.Context, arg1 db.CreatePasswordResetTxParams) (db.CreatePasswordResetTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreatePasswordResetTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordResetTx indicates an expected call of CreatePasswordResetTx.
+func (mr *MockStoreMockRecorder) CreatePasswordResetTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetTx", reflect.TypeOf((*MockStore)(nil).CreatePasswordResetTx), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -219,6 +589,20 @@ func (mr *MockStoreMockRecorder) CreateUserTx(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), arg0, arg1)
 }
 
+// CreateVerifyEmail mocks base method.
+func (m *MockStore) CreateVerifyEmail(arg0 context.Context, arg1 db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
+func (mr *MockStoreMockRecorder) CreateVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockStore)(nil).CreateVerifyEmail), arg0, arg1)
+}
 
 // DeleteAllUserSkills mocks base method.
 func (m *MockStore) DeleteAllUserSkills(arg0 context.Context, arg1 int32) error {
@@ -248,6 +632,20 @@ func (mr *MockStoreMockRecorder) DeleteCompany(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompany", reflect.TypeOf((*MockStore)(nil).DeleteCompany), arg0, arg1)
 }
 
+// DeleteCompanyInvitation mocks base method.
+func (m *MockStore) DeleteCompanyInvitation(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompanyInvitation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCompanyInvitation indicates an expected call of DeleteCompanyInvitation.
+func (mr *MockStoreMockRecorder) DeleteCompanyInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompanyInvitation", reflect.TypeOf((*MockStore)(nil).DeleteCompanyInvitation), arg0, arg1)
+}
+
 // DeleteEmployer mocks base method.
 func (m *MockStore) DeleteEmployer(arg0 context.Context, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -262,6 +660,34 @@ func (mr *MockStoreMockRecorder) DeleteEmployer(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmployer", reflect.TypeOf((*MockStore)(nil).DeleteEmployer), arg0, arg1)
 }
 
+// DeleteEmployerRecoveryCodes mocks base method.
+func (m *MockStore) DeleteEmployerRecoveryCodes(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmployerRecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmployerRecoveryCodes indicates an expected call of DeleteEmployerRecoveryCodes.
+func (mr *MockStoreMockRecorder) DeleteEmployerRecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmployerRecoveryCodes", reflect.TypeOf((*MockStore)(nil).DeleteEmployerRecoveryCodes), arg0, arg1)
+}
+
+// DeleteEmployerTOTP mocks base method.
+func (m *MockStore) DeleteEmployerTOTP(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmployerTOTP", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmployerTOTP indicates an expected call of DeleteEmployerTOTP.
+func (mr *MockStoreMockRecorder) DeleteEmployerTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmployerTOTP", reflect.TypeOf((*MockStore)(nil).DeleteEmployerTOTP), arg0, arg1)
+}
+
 // DeleteJob mocks base method.
 func (m *MockStore) DeleteJob(arg0 context.Context, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -332,88 +758,265 @@ func (mr *MockStoreMockRecorder) DeleteJobSkillsByJobID(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobSkillsByJobID", reflect.TypeOf((*MockStore)(nil).DeleteJobSkillsByJobID), arg0, arg1)
 }
 
+// DeleteJobTemplate mocks base method.
+func (m *MockStore) DeleteJobTemplate(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJobTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJobTemplate indicates an expected call of DeleteJobTemplate.
+func (mr *MockStoreMockRecorder) DeleteJobTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobTemplate", reflect.TypeOf((*MockStore)(nil).DeleteJobTemplate), arg0, arg1)
+}
+
 // DeleteMultipleJobSkills mocks base method.
-func (m *MockStore) DeleteMultipleJobSkills(arg0 context.Context, arg1 []int32) error {
+func (m *MockStore) DeleteMultipleJobSkills(arg0 context.Context, arg1 db.DeleteMultipleJobSkillsParams) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteMultipleJobSkills", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMultipleJobSkills indicates an expected call of DeleteMultipleJobSkills.
+func (mr *MockStoreMockRecorder) DeleteMultipleJobSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMultipleJobSkills", reflect.TypeOf((*MockStore)(nil).DeleteMultipleJobSkills), arg0, arg1)
+}
+
+// DeleteMultipleUserSkills mocks base method.
+func (m *MockStore) DeleteMultipleUserSkills(arg0 context.Context, arg1 []int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMultipleUserSkills", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMultipleUserSkills indicates an expected call of DeleteMultipleUserSkills.
+func (mr *MockStoreMockRecorder) DeleteMultipleUserSkills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMultipleUserSkills", reflect.TypeOf((*MockStore)(nil).DeleteMultipleUserSkills), arg0, arg1)
+}
+
+// DeletePasswordResets mocks base method.
+func (m *MockStore) DeletePasswordResets(arg0 context.Context, arg1 db.DeletePasswordResetsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePasswordResets", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePasswordResets indicates an expected call of DeletePasswordResets.
+func (mr *MockStoreMockRecorder) DeletePasswordResets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePasswordResets", reflect.TypeOf((*MockStore)(nil).DeletePasswordResets), arg0, arg1)
+}
+
+// DeletePendingCompanyInvitations mocks base method.
+func (m *MockStore) DeletePendingCompanyInvitations(arg0 context.Context, arg1 db.DeletePendingCompanyInvitationsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingCompanyInvitations", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePendingCompanyInvitations indicates an expected call of DeletePendingCompanyInvitations.
+func (mr *MockStoreMockRecorder) DeletePendingCompanyInvitations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingCompanyInvitations", reflect.TypeOf((*MockStore)(nil).DeletePendingCompanyInvitations), arg0, arg1)
+}
+
+// DeleteUser mocks base method.
+func (m *MockStore) DeleteUser(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
+}
+
+// DeleteUserSkill mocks base method.
+func (m *MockStore) DeleteUserSkill(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSkill", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSkill indicates an expected call of DeleteUserSkill.
+func (mr *MockStoreMockRecorder) DeleteUserSkill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSkill", reflect.TypeOf((*MockStore)(nil).DeleteUserSkill), arg0, arg1)
+}
+
+// DeleteVerifyEmail mocks base method.
+func (m *MockStore) DeleteVerifyEmail(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVerifyEmail", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteMultipleJobSkills indicates an expected call of DeleteMultipleJobSkills.
-func (mr *MockStoreMockRecorder) DeleteMultipleJobSkills(arg0, arg1 interface{}) *gomock.Call {
+// DeleteVerifyEmail indicates an expected call of DeleteVerifyEmail.
+func (mr *MockStoreMockRecorder) DeleteVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVerifyEmail", reflect.TypeOf((*MockStore)(nil).DeleteVerifyEmail), arg0, arg1)
+}
+
+// DisableEmployerTOTPTx mocks base method.
+func (m *MockStore) DisableEmployerTOTPTx(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableEmployerTOTPTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableEmployerTOTPTx indicates an expected call of DisableEmployerTOTPTx.
+func (mr *MockStoreMockRecorder) DisableEmployerTOTPTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableEmployerTOTPTx", reflect.TypeOf((*MockStore)(nil).DisableEmployerTOTPTx), arg0, arg1)
+}
+
+// EnableEmployerTOTP mocks base method.
+func (m *MockStore) EnableEmployerTOTP(arg0 context.Context, arg1 db.EnableEmployerTOTPParams) (db.EmployerTotp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableEmployerTOTP", arg0, arg1)
+	ret0, _ := ret[0].(db.EmployerTotp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableEmployerTOTP indicates an expected call of EnableEmployerTOTP.
+func (mr *MockStoreMockRecorder) EnableEmployerTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableEmployerTOTP", reflect.TypeOf((*MockStore)(nil).EnableEmployerTOTP), arg0, arg1)
+}
+
+// EnableEmployerTOTPTx mocks base method.
+func (m *MockStore) EnableEmployerTOTPTx(arg0 context.Context, arg1 db.EnableEmployerTOTPTxParams) (db.EnableEmployerTOTPTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableEmployerTOTPTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EnableEmployerTOTPTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableEmployerTOTPTx indicates an expected call of EnableEmployerTOTPTx.
+func (mr *MockStoreMockRecorder) EnableEmployerTOTPTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableEmployerTOTPTx", reflect.TypeOf((*MockStore)(nil).EnableEmployerTOTPTx), arg0, arg1)
+}
+
+// ExecTx mocks base method.
+func (m *MockStore) ExecTx(arg0 context.Context, arg1 func(*db.Queries) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecTx indicates an expected call of ExecTx.
+func (mr *MockStoreMockRecorder) ExecTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecTx", reflect.TypeOf((*MockStore)(nil).ExecTx), arg0, arg1)
+}
+
+// ExpireJobs mocks base method.
+func (m *MockStore) ExpireJobs(arg0 context.Context, arg1 time.Time) ([]db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireJobs", arg0, arg1)
+	ret0, _ := ret[0].([]db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireJobs indicates an expected call of ExpireJobs.
+func (mr *MockStoreMockRecorder) ExpireJobs(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMultipleJobSkills", reflect.TypeOf((*MockStore)(nil).DeleteMultipleJobSkills), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireJobs", reflect.TypeOf((*MockStore)(nil).ExpireJobs), arg0, arg1)
 }
 
-// DeleteMultipleUserSkills mocks base method.
-func (m *MockStore) DeleteMultipleUserSkills(arg0 context.Context, arg1 []int32) error {
+// ExportJobApplicationsForEmployer mocks base method.
+func (m *MockStore) ExportJobApplicationsForEmployer(arg0 context.Context, arg1 db.ExportJobApplicationsForEmployerParams) ([]db.ExportJobApplicationsForEmployerRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMultipleUserSkills", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ExportJobApplicationsForEmployer", arg0, arg1)
+	ret0, _ := ret[0].([]db.ExportJobApplicationsForEmployerRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteMultipleUserSkills indicates an expected call of DeleteMultipleUserSkills.
-func (mr *MockStoreMockRecorder) DeleteMultipleUserSkills(arg0, arg1 interface{}) *gomock.Call {
+// ExportJobApplicationsForEmployer indicates an expected call of ExportJobApplicationsForEmployer.
+func (mr *MockStoreMockRecorder) ExportJobApplicationsForEmployer(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMultipleUserSkills", reflect.TypeOf((*MockStore)(nil).DeleteMultipleUserSkills), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportJobApplicationsForEmployer", reflect.TypeOf((*MockStore)(nil).ExportJobApplicationsForEmployer), arg0, arg1)
 }
 
-// DeleteUser mocks base method.
-func (m *MockStore) DeleteUser(arg0 context.Context, arg1 int32) error {
+// ExportJobsForEmployer mocks base method.
+func (m *MockStore) ExportJobsForEmployer(arg0 context.Context, arg1 db.ExportJobsForEmployerParams) ([]db.ExportJobsForEmployerRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ExportJobsForEmployer", arg0, arg1)
+	ret0, _ := ret[0].([]db.ExportJobsForEmployerRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+// ExportJobsForEmployer indicates an expected call of ExportJobsForEmployer.
+func (mr *MockStoreMockRecorder) ExportJobsForEmployer(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportJobsForEmployer", reflect.TypeOf((*MockStore)(nil).ExportJobsForEmployer), arg0, arg1)
 }
 
-// DeleteUserSkill mocks base method.
-func (m *MockStore) DeleteUserSkill(arg0 context.Context, arg1 int32) error {
+// GetAdminByEmail mocks base method.
+func (m *MockStore) GetAdminByEmail(arg0 context.Context, arg1 string) (db.Admin, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUserSkill", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetAdminByEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteUserSkill indicates an expected call of DeleteUserSkill.
-func (mr *MockStoreMockRecorder) DeleteUserSkill(arg0, arg1 interface{}) *gomock.Call {
+// GetAdminByEmail indicates an expected call of GetAdminByEmail.
+func (mr *MockStoreMockRecorder) GetAdminByEmail(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSkill", reflect.TypeOf((*MockStore)(nil).DeleteUserSkill), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminByEmail", reflect.TypeOf((*MockStore)(nil).GetAdminByEmail), arg0, arg1)
 }
 
-// DeleteVerifyEmail mocks base method.
-func (m *MockStore) DeleteVerifyEmail(arg0 context.Context, arg1 string) error {
+// GetAdminByID mocks base method.
+func (m *MockStore) GetAdminByID(arg0 context.Context, arg1 int32) (db.Admin, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVerifyEmail", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetAdminByID", arg0, arg1)
+	ret0, _ := ret[0].(db.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteVerifyEmail indicates an expected call of DeleteVerifyEmail.
-func (mr *MockStoreMockRecorder) DeleteVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+// GetAdminByID indicates an expected call of GetAdminByID.
+func (mr *MockStoreMockRecorder) GetAdminByID(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVerifyEmail", reflect.TypeOf((*MockStore)(nil).DeleteVerifyEmail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminByID", reflect.TypeOf((*MockStore)(nil).GetAdminByID), arg0, arg1)
 }
 
-// ExecTx mocks base method.
-func (m *MockStore) ExecTx(arg0 context.Context, arg1 func(*db.Queries) error) error {
+// GetCompanyAPIKey mocks base method.
+func (m *MockStore) GetCompanyAPIKey(arg0 context.Context, arg1 int64) (db.CompanyApiKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExecTx", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetCompanyAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(db.CompanyApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ExecTx indicates an expected call of ExecTx.
-func (mr *MockStoreMockRecorder) ExecTx(arg0, arg1 interface{}) *gomock.Call {
+// GetCompanyAPIKey indicates an expected call of GetCompanyAPIKey.
+func (mr *MockStoreMockRecorder) GetCompanyAPIKey(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecTx", reflect.TypeOf((*MockStore)(nil).ExecTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyAPIKey", reflect.TypeOf((*MockStore)(nil).GetCompanyAPIKey), arg0, arg1)
 }
 
 // GetCompanyByID mocks base method.
@@ -461,6 +1064,21 @@ func (mr *MockStoreMockRecorder) GetCompanyIDOfJob(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyIDOfJob", reflect.TypeOf((*MockStore)(nil).GetCompanyIDOfJob), arg0, arg1)
 }
 
+// GetCompanyInvitation mocks base method.
+func (m *MockStore) GetCompanyInvitation(arg0 context.Context, arg1 int64) (db.CompanyInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyInvitation", arg0, arg1)
+	ret0, _ := ret[0].(db.CompanyInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyInvitation indicates an expected call of GetCompanyInvitation.
+func (mr *MockStoreMockRecorder) GetCompanyInvitation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyInvitation", reflect.TypeOf((*MockStore)(nil).GetCompanyInvitation), arg0, arg1)
+}
+
 // GetCompanyNameByID mocks base method.
 func (m *MockStore) GetCompanyNameByID(arg0 context.Context, arg1 int32) (string, error) {
 	m.ctrl.T.Helper()
@@ -521,6 +1139,36 @@ func (mr *MockStoreMockRecorder) GetEmployerByID(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployerByID", reflect.TypeOf((*MockStore)(nil).GetEmployerByID), arg0, arg1)
 }
 
+// GetEmployerTOTP mocks base method.
+func (m *MockStore) GetEmployerTOTP(arg0 context.Context, arg1 int32) (db.EmployerTotp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployerTOTP", arg0, arg1)
+	ret0, _ := ret[0].(db.EmployerTotp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployerTOTP indicates an expected call of GetEmployerTOTP.
+func (mr *MockStoreMockRecorder) GetEmployerTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployerTOTP", reflect.TypeOf((*MockStore)(nil).GetEmployerTOTP), arg0, arg1)
+}
+
+// GetExchangeRate mocks base method.
+func (m *MockStore) GetExchangeRate(arg0 context.Context, arg1 string) (db.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRate", arg0, arg1)
+	ret0, _ := ret[0].(db.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRate indicates an expected call of GetExchangeRate.
+func (mr *MockStoreMockRecorder) GetExchangeRate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockStore)(nil).GetExchangeRate), arg0, arg1)
+}
+
 // GetJob mocks base method.
 func (m *MockStore) GetJob(arg0 context.Context, arg1 int32) (db.Job, error) {
 	m.ctrl.T.Helper()
@@ -536,6 +1184,21 @@ func (mr *MockStoreMockRecorder) GetJob(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockStore)(nil).GetJob), arg0, arg1)
 }
 
+// GetJobApplication mocks base method.
+func (m *MockStore) GetJobApplication(arg0 context.Context, arg1 int32) (db.JobApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobApplication", arg0, arg1)
+	ret0, _ := ret[0].(db.JobApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobApplication indicates an expected call of GetJobApplication.
+func (mr *MockStoreMockRecorder) GetJobApplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobApplication", reflect.TypeOf((*MockStore)(nil).GetJobApplication), arg0, arg1)
+}
+
 // GetJobApplicationForEmployer mocks base method.
 func (m *MockStore) GetJobApplicationForEmployer(arg0 context.Context, arg1 int32) (db.GetJobApplicationForEmployerRow, error) {
 	m.ctrl.T.Helper()
@@ -641,6 +1304,81 @@ func (mr *MockStoreMockRecorder) GetJobIDOfJobApplication(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobIDOfJobApplication", reflect.TypeOf((*MockStore)(nil).GetJobIDOfJobApplication), arg0, arg1)
 }
 
+// GetJobRevision mocks base method.
+func (m *MockStore) GetJobRevision(arg0 context.Context, arg1 db.GetJobRevisionParams) (db.JobRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobRevision", arg0, arg1)
+	ret0, _ := ret[0].(db.JobRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobRevision indicates an expected call of GetJobRevision.
+func (mr *MockStoreMockRecorder) GetJobRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobRevision", reflect.TypeOf((*MockStore)(nil).GetJobRevision), arg0, arg1)
+}
+
+// GetJobRevisionByID mocks base method.
+func (m *MockStore) GetJobRevisionByID(arg0 context.Context, arg1 int32) (db.JobRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobRevisionByID", arg0, arg1)
+	ret0, _ := ret[0].(db.JobRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobRevisionByID indicates an expected call of GetJobRevisionByID.
+func (mr *MockStoreMockRecorder) GetJobRevisionByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobRevisionByID", reflect.TypeOf((*MockStore)(nil).GetJobRevisionByID), arg0, arg1)
+}
+
+// GetJobTemplate mocks base method.
+func (m *MockStore) GetJobTemplate(arg0 context.Context, arg1 int32) (db.JobTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.JobTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobTemplate indicates an expected call of GetJobTemplate.
+func (mr *MockStoreMockRecorder) GetJobTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobTemplate", reflect.TypeOf((*MockStore)(nil).GetJobTemplate), arg0, arg1)
+}
+
+// GetJobViewTotals mocks base method.
+func (m *MockStore) GetJobViewTotals(arg0 context.Context, arg1 db.GetJobViewTotalsParams) (db.GetJobViewTotalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobViewTotals", arg0, arg1)
+	ret0, _ := ret[0].(db.GetJobViewTotalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobViewTotals indicates an expected call of GetJobViewTotals.
+func (mr *MockStoreMockRecorder) GetJobViewTotals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobViewTotals", reflect.TypeOf((*MockStore)(nil).GetJobViewTotals), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockStore) GetUserByEmail(arg0 context.Context, arg1 string) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -687,6 +1425,82 @@ func (mr *MockStoreMockRecorder) GetUserDetailsByEmail(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDetailsByEmail", reflect.TypeOf((*MockStore)(nil).GetUserDetailsByEmail), arg0, arg1)
 }
 
+// GetUserDetailsByID mocks base method.
+func (m *MockStore) GetUserDetailsByID(arg0 context.Context, arg1 int32) (db.User, []db.UserSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDetailsByID", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].([]db.UserSkill)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDetailsByID indicates an expected call of GetUserDetailsByID.
+func (mr *MockStoreMockRecorder) GetUserDetailsByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDetailsByID", reflect.TypeOf((*MockStore)(nil).GetUserDetailsByID), arg0, arg1)
+}
+
+// ImportJobsTx mocks base method.
+func (m *MockStore) ImportJobsTx(arg0 context.Context, arg1 db.ImportJobsTxParams) (db.ImportJobsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJobsTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ImportJobsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportJobsTx indicates an expected call of ImportJobsTx.
+func (mr *MockStoreMockRecorder) ImportJobsTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJobsTx", reflect.TypeOf((*MockStore)(nil).ImportJobsTx), arg0, arg1)
+}
+
+// JoinCompanyTx mocks base method.
+func (m *MockStore) JoinCompanyTx(arg0 context.Context, arg1 db.JoinCompanyTxParams) (db.JoinCompanyTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinCompanyTx", arg0, arg1)
+	ret0, _ := ret[0].(db.JoinCompanyTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinCompanyTx indicates an expected call of JoinCompanyTx.
+func (mr *MockStoreMockRecorder) JoinCompanyTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinCompanyTx", reflect.TypeOf((*MockStore)(nil).JoinCompanyTx), arg0, arg1)
+}
+
+// ListActiveSessionsBySubject mocks base method.
+func (m *MockStore) ListActiveSessionsBySubject(arg0 context.Context, arg1 db.ListActiveSessionsBySubjectParams) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveSessionsBySubject", arg0, arg1)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveSessionsBySubject indicates an expected call of ListActiveSessionsBySubject.
+func (mr *MockStoreMockRecorder) ListActiveSessionsBySubject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSessionsBySubject", reflect.TypeOf((*MockStore)(nil).ListActiveSessionsBySubject), arg0, arg1)
+}
+
+// ListAdminAuditLogs mocks base method.
+func (m *MockStore) ListAdminAuditLogs(arg0 context.Context, arg1 db.ListAdminAuditLogsParams) ([]db.AdminAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminAuditLogs", arg0, arg1)
+	ret0, _ := ret[0].([]db.AdminAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminAuditLogs indicates an expected call of ListAdminAuditLogs.
+func (mr *MockStoreMockRecorder) ListAdminAuditLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminAuditLogs", reflect.TypeOf((*MockStore)(nil).ListAdminAuditLogs), arg0, arg1)
+}
+
 // ListAllJobSkillsByJobID mocks base method.
 func (m *MockStore) ListAllJobSkillsByJobID(arg0 context.Context, arg1 int32) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -717,6 +1531,96 @@ func (mr *MockStoreMockRecorder) ListAllJobsForES(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllJobsForES", reflect.TypeOf((*MockStore)(nil).ListAllJobsForES), arg0)
 }
 
+// ListCompanyAPIKeys mocks base method.
+func (m *MockStore) ListCompanyAPIKeys(arg0 context.Context, arg1 int32) ([]db.CompanyApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].([]db.CompanyApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyAPIKeys indicates an expected call of ListCompanyAPIKeys.
+func (mr *MockStoreMockRecorder) ListCompanyAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyAPIKeys", reflect.TypeOf((*MockStore)(nil).ListCompanyAPIKeys), arg0, arg1)
+}
+
+// ListCompanyEmployers mocks base method.
+func (m *MockStore) ListCompanyEmployers(arg0 context.Context, arg1 int32) ([]db.Employer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyEmployers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Employer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyEmployers indicates an expected call of ListCompanyEmployers.
+func (mr *MockStoreMockRecorder) ListCompanyEmployers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyEmployers", reflect.TypeOf((*MockStore)(nil).ListCompanyEmployers), arg0, arg1)
+}
+
+// ListCompanyInvitations mocks base method.
+func (m *MockStore) ListCompanyInvitations(arg0 context.Context, arg1 int32) ([]db.CompanyInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyInvitations", arg0, arg1)
+	ret0, _ := ret[0].([]db.CompanyInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyInvitations indicates an expected call of ListCompanyInvitations.
+func (mr *MockStoreMockRecorder) ListCompanyInvitations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyInvitations", reflect.TypeOf((*MockStore)(nil).ListCompanyInvitations), arg0, arg1)
+}
+
+// ListCompanyJobsForES mocks base method.
+func (m *MockStore) ListCompanyJobsForES(arg0 context.Context, arg1 int32) ([]db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanyJobsForES", arg0, arg1)
+	ret0, _ := ret[0].([]db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanyJobsForES indicates an expected call of ListCompanyJobsForES.
+func (mr *MockStoreMockRecorder) ListCompanyJobsForES(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanyJobsForES", reflect.TypeOf((*MockStore)(nil).ListCompanyJobsForES), arg0, arg1)
+}
+
+// ListExchangeRates mocks base method.
+func (m *MockStore) ListExchangeRates(arg0 context.Context) ([]db.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExchangeRates", arg0)
+	ret0, _ := ret[0].([]db.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExchangeRates indicates an expected call of ListExchangeRates.
+func (mr *MockStoreMockRecorder) ListExchangeRates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExchangeRates", reflect.TypeOf((*MockStore)(nil).ListExchangeRates), arg0)
+}
+
+// ListJobApplicationCountsByPeriod mocks base method.
+func (m *MockStore) ListJobApplicationCountsByPeriod(arg0 context.Context, arg1 db.ListJobApplicationCountsByPeriodParams) ([]db.ListJobApplicationCountsByPeriodRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobApplicationCountsByPeriod", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListJobApplicationCountsByPeriodRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobApplicationCountsByPeriod indicates an expected call of ListJobApplicationCountsByPeriod.
+func (mr *MockStoreMockRecorder) ListJobApplicationCountsByPeriod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobApplicationCountsByPeriod", reflect.TypeOf((*MockStore)(nil).ListJobApplicationCountsByPeriod), arg0, arg1)
+}
+
 // ListJobApplicationsForEmployer mocks base method.
 func (m *MockStore) ListJobApplicationsForEmployer(arg0 context.Context, arg1 db.ListJobApplicationsForEmployerParams) ([]db.ListJobApplicationsForEmployerRow, error) {
 	m.ctrl.T.Helper()
@@ -741,25 +1645,70 @@ func (m *MockStore) ListJobApplicationsForUser(arg0 context.Context, arg1 db.Lis
 	return ret0, ret1
 }
 
-// ListJobApplicationsForUser indicates an expected call of ListJobApplicationsForUser.
-func (mr *MockStoreMockRecorder) ListJobApplicationsForUser(arg0, arg1 interface{}) *gomock.Call {
+// ListJobApplicationsForUser indicates an expected call of ListJobApplicationsForUser.
+func (mr *MockStoreMockRecorder) ListJobApplicationsForUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobApplicationsForUser", reflect.TypeOf((*MockStore)(nil).ListJobApplicationsForUser), arg0, arg1)
+}
+
+// ListJobRevisions mocks base method.
+func (m *MockStore) ListJobRevisions(arg0 context.Context, arg1 int32) ([]db.JobRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]db.JobRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobRevisions indicates an expected call of ListJobRevisions.
+func (mr *MockStoreMockRecorder) ListJobRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobRevisions", reflect.TypeOf((*MockStore)(nil).ListJobRevisions), arg0, arg1)
+}
+
+// ListJobSkillsByJobID mocks base method.
+func (m *MockStore) ListJobSkillsByJobID(arg0 context.Context, arg1 db.ListJobSkillsByJobIDParams) ([]db.ListJobSkillsByJobIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobSkillsByJobID", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListJobSkillsByJobIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobSkillsByJobID indicates an expected call of ListJobSkillsByJobID.
+func (mr *MockStoreMockRecorder) ListJobSkillsByJobID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobSkillsByJobID", reflect.TypeOf((*MockStore)(nil).ListJobSkillsByJobID), arg0, arg1)
+}
+
+// ListJobTemplates mocks base method.
+func (m *MockStore) ListJobTemplates(arg0 context.Context, arg1 int32) ([]db.JobTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobTemplates", arg0, arg1)
+	ret0, _ := ret[0].([]db.JobTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobTemplates indicates an expected call of ListJobTemplates.
+func (mr *MockStoreMockRecorder) ListJobTemplates(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobApplicationsForUser", reflect.TypeOf((*MockStore)(nil).ListJobApplicationsForUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobTemplates", reflect.TypeOf((*MockStore)(nil).ListJobTemplates), arg0, arg1)
 }
 
-// ListJobSkillsByJobID mocks base method.
-func (m *MockStore) ListJobSkillsByJobID(arg0 context.Context, arg1 db.ListJobSkillsByJobIDParams) ([]db.ListJobSkillsByJobIDRow, error) {
+// ListJobViewsByPeriod mocks base method.
+func (m *MockStore) ListJobViewsByPeriod(arg0 context.Context, arg1 db.ListJobViewsByPeriodParams) ([]db.ListJobViewsByPeriodRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListJobSkillsByJobID", arg0, arg1)
-	ret0, _ := ret[0].([]db.ListJobSkillsByJobIDRow)
+	ret := m.ctrl.Call(m, "ListJobViewsByPeriod", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListJobViewsByPeriodRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListJobSkillsByJobID indicates an expected call of ListJobSkillsByJobID.
-func (mr *MockStoreMockRecorder) ListJobSkillsByJobID(arg0, arg1 interface{}) *gomock.Call {
+// ListJobViewsByPeriod indicates an expected call of ListJobViewsByPeriod.
+func (mr *MockStoreMockRecorder) ListJobViewsByPeriod(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobSkillsByJobID", reflect.TypeOf((*MockStore)(nil).ListJobSkillsByJobID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobViewsByPeriod", reflect.TypeOf((*MockStore)(nil).ListJobViewsByPeriod), arg0, arg1)
 }
 
 // ListJobsByCompanyExactName mocks base method.
@@ -969,6 +1918,244 @@ func (mr *MockStoreMockRecorder) LoadTestData(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTestData", reflect.TypeOf((*MockStore)(nil).LoadTestData), arg0)
 }
 
+// MarkJobsExpiryNotified mocks base method.
+func (m *MockStore) MarkJobsExpiryNotified(arg0 context.Context, arg1 db.MarkJobsExpiryNotifiedParams) ([]db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkJobsExpiryNotified", arg0, arg1)
+	ret0, _ := ret[0].([]db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkJobsExpiryNotified indicates an expected call of MarkJobsExpiryNotified.
+func (mr *MockStoreMockRecorder) MarkJobsExpiryNotified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkJobsExpiryNotified", reflect.TypeOf((*MockStore)(nil).MarkJobsExpiryNotified), arg0, arg1)
+}
+
+// PublishScheduledJobs mocks base method.
+func (m *MockStore) PublishScheduledJobs(arg0 context.Context, arg1 time.Time) ([]db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledJobs", arg0, arg1)
+	ret0, _ := ret[0].([]db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledJobs indicates an expected call of PublishScheduledJobs.
+func (mr *MockStoreMockRecorder) PublishScheduledJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledJobs", reflect.TypeOf((*MockStore)(nil).PublishScheduledJobs), arg0, arg1)
+}
+
+// RecordJobImpressions mocks base method.
+func (m *MockStore) RecordJobImpressions(arg0 context.Context, arg1 db.RecordJobImpressionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordJobImpressions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordJobImpressions indicates an expected call of RecordJobImpressions.
+func (mr *MockStoreMockRecorder) RecordJobImpressions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordJobImpressions", reflect.TypeOf((*MockStore)(nil).RecordJobImpressions), arg0, arg1)
+}
+
+// RecordJobView mocks base method.
+func (m *MockStore) RecordJobView(arg0 context.Context, arg1 db.RecordJobViewParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordJobView", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordJobView indicates an expected call of RecordJobView.
+func (mr *MockStoreMockRecorder) RecordJobView(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordJobView", reflect.TypeOf((*MockStore)(nil).RecordJobView), arg0, arg1)
+}
+
+// ResetPasswordTx mocks base method.
+func (m *MockStore) ResetPasswordTx(arg0 context.Context, arg1 db.ResetPasswordTxParams) (db.ResetPasswordTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ResetPasswordTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPasswordTx indicates an expected call of ResetPasswordTx.
+func (mr *MockStoreMockRecorder) ResetPasswordTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordTx", reflect.TypeOf((*MockStore)(nil).ResetPasswordTx), arg0, arg1)
+}
+
+// RestoreCompany mocks base method.
+func (m *MockStore) RestoreCompany(arg0 context.Context, arg1 int32) (db.Company, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCompany", arg0, arg1)
+	ret0, _ := ret[0].(db.Company)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCompany indicates an expected call of RestoreCompany.
+func (mr *MockStoreMockRecorder) RestoreCompany(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCompany", reflect.TypeOf((*MockStore)(nil).RestoreCompany), arg0, arg1)
+}
+
+// RestoreEmployer mocks base method.
+func (m *MockStore) RestoreEmployer(arg0 context.Context, arg1 int32) (db.Employer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEmployer", arg0, arg1)
+	ret0, _ := ret[0].(db.Employer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreEmployer indicates an expected call of RestoreEmployer.
+func (mr *MockStoreMockRecorder) RestoreEmployer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEmployer", reflect.TypeOf((*MockStore)(nil).RestoreEmployer), arg0, arg1)
+}
+
+// RestoreUser mocks base method.
+func (m *MockStore) RestoreUser(arg0 context.Context, arg1 int32) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockStoreMockRecorder) RestoreUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockStore)(nil).RestoreUser), arg0, arg1)
+}
+
+// RevokeCompanyAPIKey mocks base method.
+func (m *MockStore) RevokeCompanyAPIKey(arg0 context.Context, arg1 int64) (db.CompanyApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCompanyAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(db.CompanyApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeCompanyAPIKey indicates an expected call of RevokeCompanyAPIKey.
+func (mr *MockStoreMockRecorder) RevokeCompanyAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCompanyAPIKey", reflect.TypeOf((*MockStore)(nil).RevokeCompanyAPIKey), arg0, arg1)
+}
+
+// SearchCompanies mocks base method.
+func (m *MockStore) SearchCompanies(arg0 context.Context, arg1 db.SearchCompaniesParams) ([]db.Company, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCompanies", arg0, arg1)
+	ret0, _ := ret[0].([]db.Company)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCompanies indicates an expected call of SearchCompanies.
+func (mr *MockStoreMockRecorder) SearchCompanies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCompanies", reflect.TypeOf((*MockStore)(nil).SearchCompanies), arg0, arg1)
+}
+
+// SearchEmployers mocks base method.
+func (m *MockStore) SearchEmployers(arg0 context.Context, arg1 db.SearchEmployersParams) ([]db.Employer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEmployers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Employer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEmployers indicates an expected call of SearchEmployers.
+func (mr *MockStoreMockRecorder) SearchEmployers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEmployers", reflect.TypeOf((*MockStore)(nil).SearchEmployers), arg0, arg1)
+}
+
+// SearchUsers mocks base method.
+func (m *MockStore) SearchUsers(arg0 context.Context, arg1 db.SearchUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", arg0, arg1)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockStoreMockRecorder) SearchUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockStore)(nil).SearchUsers), arg0, arg1)
+}
+
+// SuspendCompany mocks base method.
+func (m *MockStore) SuspendCompany(arg0 context.Context, arg1 int32) (db.Company, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendCompany", arg0, arg1)
+	ret0, _ := ret[0].(db.Company)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendCompany indicates an expected call of SuspendCompany.
+func (mr *MockStoreMockRecorder) SuspendCompany(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendCompany", reflect.TypeOf((*MockStore)(nil).SuspendCompany), arg0, arg1)
+}
+
+// SuspendEmployer mocks base method.
+func (m *MockStore) SuspendEmployer(arg0 context.Context, arg1 int32) (db.Employer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendEmployer", arg0, arg1)
+	ret0, _ := ret[0].(db.Employer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendEmployer indicates an expected call of SuspendEmployer.
+func (mr *MockStoreMockRecorder) SuspendEmployer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendEmployer", reflect.TypeOf((*MockStore)(nil).SuspendEmployer), arg0, arg1)
+}
+
+// SuspendUser mocks base method.
+func (m *MockStore) SuspendUser(arg0 context.Context, arg1 int32) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockStoreMockRecorder) SuspendUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockStore)(nil).SuspendUser), arg0, arg1)
+}
+
+// UnpublishJob mocks base method.
+func (m *MockStore) UnpublishJob(arg0 context.Context, arg1 int32) (db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishJob", arg0, arg1)
+	ret0, _ := ret[0].(db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpublishJob indicates an expected call of UnpublishJob.
+func (mr *MockStoreMockRecorder) UnpublishJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishJob", reflect.TypeOf((*MockStore)(nil).UnpublishJob), arg0, arg1)
+}
+
 // UpdateCompany mocks base method.
 func (m *MockStore) UpdateCompany(arg0 context.Context, arg1 db.UpdateCompanyParams) (db.Company, error) {
 	m.ctrl.T.Helper()
@@ -1013,6 +2200,21 @@ func (mr *MockStoreMockRecorder) UpdateEmployerPassword(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployerPassword", reflect.TypeOf((*MockStore)(nil).UpdateEmployerPassword), arg0, arg1)
 }
 
+// UpdateEmployerRole mocks base method.
+func (m *MockStore) UpdateEmployerRole(arg0 context.Context, arg1 db.UpdateEmployerRoleParams) (db.Employer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmployerRole", arg0, arg1)
+	ret0, _ := ret[0].(db.Employer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmployerRole indicates an expected call of UpdateEmployerRole.
+func (mr *MockStoreMockRecorder) UpdateEmployerRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployerRole", reflect.TypeOf((*MockStore)(nil).UpdateEmployerRole), arg0, arg1)
+}
+
 // UpdateJob mocks base method.
 func (m *MockStore) UpdateJob(arg0 context.Context, arg1 db.UpdateJobParams) (db.Job, error) {
 	m.ctrl.T.Helper()
@@ -1043,6 +2245,20 @@ func (mr *MockStoreMockRecorder) UpdateJobApplication(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobApplication", reflect.TypeOf((*MockStore)(nil).UpdateJobApplication), arg0, arg1)
 }
 
+// UpdateJobApplicationNote mocks base method.
+func (m *MockStore) UpdateJobApplicationNote(arg0 context.Context, arg1 db.UpdateJobApplicationNoteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobApplicationNote", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateJobApplicationNote indicates an expected call of UpdateJobApplicationNote.
+func (mr *MockStoreMockRecorder) UpdateJobApplicationNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobApplicationNote", reflect.TypeOf((*MockStore)(nil).UpdateJobApplicationNote), arg0, arg1)
+}
+
 // UpdateJobApplicationStatus mocks base method.
 func (m *MockStore) UpdateJobApplicationStatus(arg0 context.Context, arg1 db.UpdateJobApplicationStatusParams) error {
 	m.ctrl.T.Helper()
@@ -1072,6 +2288,51 @@ func (mr *MockStoreMockRecorder) UpdateJobSkill(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobSkill", reflect.TypeOf((*MockStore)(nil).UpdateJobSkill), arg0, arg1)
 }
 
+// UpdateJobStatus mocks base method.
+func (m *MockStore) UpdateJobStatus(arg0 context.Context, arg1 db.UpdateJobStatusParams) (db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobStatus indicates an expected call of UpdateJobStatus.
+func (mr *MockStoreMockRecorder) UpdateJobStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobStatus", reflect.TypeOf((*MockStore)(nil).UpdateJobStatus), arg0, arg1)
+}
+
+// UpdateJobTemplate mocks base method.
+func (m *MockStore) UpdateJobTemplate(arg0 context.Context, arg1 db.UpdateJobTemplateParams) (db.JobTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.JobTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobTemplate indicates an expected call of UpdateJobTemplate.
+func (mr *MockStoreMockRecorder) UpdateJobTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobTemplate", reflect.TypeOf((*MockStore)(nil).UpdateJobTemplate), arg0, arg1)
+}
+
+// UpdateJobTx mocks base method.
+func (m *MockStore) UpdateJobTx(arg0 context.Context, arg1 db.UpdateJobTxParams) (db.UpdateJobTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJobTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateJobTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobTx indicates an expected call of UpdateJobTx.
+func (mr *MockStoreMockRecorder) UpdateJobTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobTx", reflect.TypeOf((*MockStore)(nil).UpdateJobTx), arg0, arg1)
+}
+
 // UpdatePassword mocks base method.
 func (m *MockStore) UpdatePassword(arg0 context.Context, arg1 db.UpdatePasswordParams) error {
 	m.ctrl.T.Helper()
@@ -1116,8 +2377,125 @@ func (mr *MockStoreMockRecorder) UpdateUserSkill(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserSkill", reflect.TypeOf((*MockStore)(nil).UpdateUserSkill), arg0, arg1)
 }
 
+// UpdateVerifyEmail mocks base method.
+func (m *MockStore) UpdateVerifyEmail(arg0 context.Context, arg1 db.UpdateVerifyEmailParams) (db.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVerifyEmail indicates an expected call of UpdateVerifyEmail.
+func (mr *MockStoreMockRecorder) UpdateVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerifyEmail", reflect.TypeOf((*MockStore)(nil).UpdateVerifyEmail), arg0, arg1)
+}
+
+// UpsertEmployerTOTP mocks base method.
+func (m *MockStore) UpsertEmployerTOTP(arg0 context.Context, arg1 db.UpsertEmployerTOTPParams) (db.EmployerTotp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertEmployerTOTP", arg0, arg1)
+	ret0, _ := ret[0].(db.EmployerTotp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertEmployerTOTP indicates an expected call of UpsertEmployerTOTP.
+func (mr *MockStoreMockRecorder) UpsertEmployerTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertEmployerTOTP", reflect.TypeOf((*MockStore)(nil).UpsertEmployerTOTP), arg0, arg1)
+}
+
+// UpsertExchangeRate mocks base method.
+func (m *MockStore) UpsertExchangeRate(arg0 context.Context, arg1 db.UpsertExchangeRateParams) (db.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertExchangeRate", arg0, arg1)
+	ret0, _ := ret[0].(db.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertExchangeRate indicates an expected call of UpsertExchangeRate.
+func (mr *MockStoreMockRecorder) UpsertExchangeRate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertExchangeRate", reflect.TypeOf((*MockStore)(nil).UpsertExchangeRate), arg0, arg1)
+}
+
+// UseCompanyAPIKey mocks base method.
+func (m *MockStore) UseCompanyAPIKey(arg0 context.Context, arg1 string) (db.UseCompanyAPIKeyRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseCompanyAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(db.UseCompanyAPIKeyRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseCompanyAPIKey indicates an expected call of UseCompanyAPIKey.
+func (mr *MockStoreMockRecorder) UseCompanyAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseCompanyAPIKey", reflect.TypeOf((*MockStore)(nil).UseCompanyAPIKey), arg0, arg1)
+}
+
+// UseEmployerRecoveryCode mocks base method.
+func (m *MockStore) UseEmployerRecoveryCode(arg0 context.Context, arg1 db.UseEmployerRecoveryCodeParams) (db.EmployerRecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseEmployerRecoveryCode", arg0, arg1)
+	ret0, _ := ret[0].(db.EmployerRecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseEmployerRecoveryCode indicates an expected call of UseEmployerRecoveryCode.
+func (mr *MockStoreMockRecorder) UseEmployerRecoveryCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseEmployerRecoveryCode", reflect.TypeOf((*MockStore)(nil).UseEmployerRecoveryCode), arg0, arg1)
+}
+
+// UseEmployerTOTPStep mocks base method.
+func (m *MockStore) UseEmployerTOTPStep(arg0 context.Context, arg1 db.UseEmployerTOTPStepParams) (db.EmployerTotp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseEmployerTOTPStep", arg0, arg1)
+	ret0, _ := ret[0].(db.EmployerTotp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseEmployerTOTPStep indicates an expected call of UseEmployerTOTPStep.
+func (mr *MockStoreMockRecorder) UseEmployerTOTPStep(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseEmployerTOTPStep", reflect.TypeOf((*MockStore)(nil).UseEmployerTOTPStep), arg0, arg1)
+}
+
+// UsePasswordReset mocks base method.
+func (m *MockStore) UsePasswordReset(arg0 context.Context, arg1 db.UsePasswordResetParams) (db.PasswordReset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsePasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(db.PasswordReset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsePasswordReset indicates an expected call of UsePasswordReset.
+func (mr *MockStoreMockRecorder) UsePasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsePasswordReset", reflect.TypeOf((*MockStore)(nil).UsePasswordReset), arg0, arg1)
+}
 
+// VerifyEmailTx mocks base method.
+func (m *MockStore) VerifyEmailTx(arg0 context.Context, arg1 db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmailTx", arg0, arg1)
+	ret0, _ := ret[0].(db.VerifyEmailTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
 
+// VerifyEmailTx indicates an expected call of VerifyEmailTx.
+func (mr *MockStoreMockRecorder) VerifyEmailTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmailTx", reflect.TypeOf((*MockStore)(nil).VerifyEmailTx), arg0, arg1)
+}
 
 // VerifyEmployerEmail mocks base method.
 func (m *MockStore) VerifyEmployerEmail(arg0 context.Context, arg1 string) (db.Employer, error) {
@@ -1134,9 +2512,6 @@ func (mr *MockStoreMockRecorder) VerifyEmployerEmail(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmployerEmail", reflect.TypeOf((*MockStore)(nil).VerifyEmployerEmail), arg0, arg1)
 }
 
-
-
-
 // VerifyUserEmail mocks base method.
 func (m *MockStore) VerifyUserEmail(arg0 context.Context, arg1 string) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -1146,3 +2521,8 @@ func (m *MockStore) VerifyUserEmail(arg0 context.Context, arg1 string) (db.User,
 	return ret0, ret1
 }
 
+// VerifyUserEmail indicates an expected call of VerifyUserEmail.
+func (mr *MockStoreMockRecorder) VerifyUserEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUserEmail", reflect.TypeOf((*MockStore)(nil).VerifyUserEmail), arg0, arg1)
+}
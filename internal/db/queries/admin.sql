@@ -0,0 +1,14 @@
+-- name: CreateAdmin :one
+INSERT INTO admins (full_name, email, hashed_password)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetAdminByID :one
+SELECT *
+FROM admins
+WHERE id = $1;
+
+-- name: GetAdminByEmail :one
+SELECT *
+FROM admins
+WHERE email = $1;
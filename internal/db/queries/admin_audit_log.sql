@@ -0,0 +1,13 @@
+-- name: CreateAdminAuditLog :one
+INSERT INTO admin_audit_logs (admin_id, action, target_type, target_id, details)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListAdminAuditLogs :many
+SELECT *
+FROM admin_audit_logs
+WHERE (@filter_admin_id::bool = FALSE OR admin_id = @admin_id)
+  AND (@filter_target_type::bool = FALSE OR target_type = @target_type)
+  AND (@filter_target_id::bool = FALSE OR target_id = @target_id)
+ORDER BY created_at DESC, id DESC
+LIMIT $1 OFFSET $2;
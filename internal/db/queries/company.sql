@@ -29,4 +29,23 @@ RETURNING *;
 -- name: DeleteCompany :exec
 DELETE
 FROM companies
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: SearchCompanies :many
+SELECT *
+FROM companies
+WHERE name ILIKE '%' || @search::text || '%'
+ORDER BY id
+LIMIT $1 OFFSET $2;
+
+-- name: SuspendCompany :one
+UPDATE companies
+SET suspended_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: RestoreCompany :one
+UPDATE companies
+SET suspended_at = NULL
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,37 @@
+-- name: CreateCompanyAPIKey :one
+INSERT INTO company_api_keys
+    (company_id, employer_id, name, key_prefix, hashed_key, scopes)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetCompanyAPIKey :one
+SELECT *
+FROM company_api_keys
+WHERE id = $1;
+
+-- name: ListCompanyAPIKeys :many
+SELECT *
+FROM company_api_keys
+WHERE company_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: UseCompanyAPIKey :one
+UPDATE company_api_keys k
+SET last_used_at = now()
+FROM employers e,
+     companies c
+WHERE k.hashed_key = $1
+  AND k.revoked_at IS NULL
+  AND e.id = k.employer_id
+  AND e.company_id = k.company_id
+  AND e.suspended_at IS NULL
+  AND c.id = k.company_id
+  AND c.suspended_at IS NULL
+RETURNING k.id, k.company_id, k.employer_id, k.scopes, e.email AS employer_email;
+
+-- name: RevokeCompanyAPIKey :one
+UPDATE company_api_keys
+SET revoked_at = now()
+WHERE id = $1
+  AND revoked_at IS NULL
+RETURNING *;
@@ -0,0 +1,39 @@
+-- name: CreateCompanyInvitation :one
+INSERT INTO company_invitations
+    (company_id, invited_by, email, role, hashed_code)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetCompanyInvitation :one
+SELECT *
+FROM company_invitations
+WHERE id = $1;
+
+-- name: ListCompanyInvitations :many
+SELECT *
+FROM company_invitations
+WHERE company_id = $1
+  AND is_accepted = FALSE
+  AND expired_at > now()
+ORDER BY created_at DESC, id DESC;
+
+-- name: AcceptCompanyInvitation :one
+UPDATE company_invitations
+SET is_accepted = TRUE
+WHERE hashed_code = $1
+  AND email = $2
+  AND is_accepted = FALSE
+  AND expired_at > now()
+RETURNING *;
+
+-- name: DeleteCompanyInvitation :exec
+DELETE
+FROM company_invitations
+WHERE id = $1;
+
+-- name: DeletePendingCompanyInvitations :exec
+DELETE
+FROM company_invitations
+WHERE company_id = $1
+  AND email = $2
+  AND is_accepted = FALSE;
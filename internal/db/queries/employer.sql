@@ -1,6 +1,6 @@
 -- name: CreateEmployer :one
-INSERT INTO employers (company_id, full_name, email, hashed_password)
-VALUES ($1, $2, $3, $4)
+INSERT INTO employers (company_id, full_name, email, hashed_password, role)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetEmployerByID :one
@@ -21,6 +21,29 @@ SET company_id = $2,
 WHERE id = $1
 RETURNING *;
 
+-- name: ListCompanyEmployers :many
+SELECT *
+FROM employers
+WHERE company_id = $1
+ORDER BY id;
+
+-- name: CountCompanyEmployers :one
+SELECT count(*)
+FROM employers
+WHERE company_id = $1;
+
+-- name: CountCompanyEmployersByRole :one
+SELECT count(*)
+FROM employers
+WHERE company_id = $1
+  AND role = $2;
+
+-- name: UpdateEmployerRole :one
+UPDATE employers
+SET role = $2
+WHERE id = $1
+RETURNING *;
+
 -- name: UpdateEmployerPassword :exec
 UPDATE employers
 SET hashed_password = $2
@@ -48,3 +71,23 @@ SELECT c.name      AS company_name,
 FROM employers e
          JOIN companies c ON c.id = e.company_id
 WHERE e.email = $1;
+
+-- name: SearchEmployers :many
+SELECT *
+FROM employers
+WHERE email ILIKE '%' || @search::text || '%'
+   OR full_name ILIKE '%' || @search::text || '%'
+ORDER BY id
+LIMIT $1 OFFSET $2;
+
+-- name: SuspendEmployer :one
+UPDATE employers
+SET suspended_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: RestoreEmployer :one
+UPDATE employers
+SET suspended_at = NULL
+WHERE id = $1
+RETURNING *;
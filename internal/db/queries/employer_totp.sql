@@ -0,0 +1,54 @@
+-- name: UpsertEmployerTOTP :one
+INSERT INTO employer_totps
+    (employer_id, secret)
+VALUES ($1, $2)
+ON CONFLICT (employer_id) DO UPDATE
+    SET secret         = EXCLUDED.secret,
+        is_enabled     = FALSE,
+        last_used_step = 0,
+        created_at     = now()
+RETURNING *;
+
+-- name: GetEmployerTOTP :one
+SELECT *
+FROM employer_totps
+WHERE employer_id = $1;
+
+-- name: EnableEmployerTOTP :one
+UPDATE employer_totps
+SET is_enabled     = TRUE,
+    last_used_step = $2
+WHERE employer_id = $1
+RETURNING *;
+
+-- name: UseEmployerTOTPStep :one
+UPDATE employer_totps
+SET last_used_step = $2
+WHERE employer_id = $1
+  AND is_enabled = TRUE
+  AND last_used_step < $2
+RETURNING *;
+
+-- name: DeleteEmployerTOTP :exec
+DELETE
+FROM employer_totps
+WHERE employer_id = $1;
+
+-- name: CreateEmployerRecoveryCode :one
+INSERT INTO employer_recovery_codes
+    (employer_id, hashed_code)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: UseEmployerRecoveryCode :one
+UPDATE employer_recovery_codes
+SET is_used = TRUE
+WHERE employer_id = $1
+  AND hashed_code = $2
+  AND is_used = FALSE
+RETURNING *;
+
+-- name: DeleteEmployerRecoveryCodes :exec
+DELETE
+FROM employer_recovery_codes
+WHERE employer_id = $1;
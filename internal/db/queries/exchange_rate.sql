@@ -0,0 +1,17 @@
+-- name: GetExchangeRate :one
+SELECT *
+FROM exchange_rates
+WHERE currency = $1;
+
+-- name: ListExchangeRates :many
+SELECT *
+FROM exchange_rates
+ORDER BY currency;
+
+-- name: UpsertExchangeRate :one
+INSERT INTO exchange_rates (currency, rate_to_usd)
+VALUES ($1, $2)
+ON CONFLICT (currency) DO UPDATE
+    SET rate_to_usd = EXCLUDED.rate_to_usd,
+        updated_at  = now()
+RETURNING *;
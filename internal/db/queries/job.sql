@@ -6,17 +6,42 @@ INSERT INTO jobs (title,
                   location,
                   salary_min,
                   salary_max,
-                  requirements)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+                  requirements,
+                  status,
+                  expires_at,
+                  employment_type,
+                  work_mode,
+                  seniority_level,
+                  salary_currency,
+                  salary_period,
+                  publish_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 RETURNING *;
 
 -- name: GetJob :one
 SELECT *
 FROM jobs
-WHERE id = $1;
+WHERE id = $1
+  AND deleted_at IS NULL;
 
 -- name: GetJobDetails :one
-SELECT j.*,
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.company_id,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.status,
+       j.expires_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
        c.name      AS company_name,
        c.location  AS company_location,
        c.industry  AS company_industry,
@@ -25,86 +50,405 @@ SELECT j.*,
        e.full_name AS employer_full_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
-         JOIN employers e ON c.id = e.company_id
-WHERE j.id = $1;
+         -- a company can have several employers, the job is presented by its first owner
+         JOIN LATERAL (SELECT id, email, full_name
+                       FROM employers
+                       WHERE company_id = c.id
+                         AND role = 'owner'
+                       ORDER BY id
+                       LIMIT 1) e ON true
+WHERE j.id = $1
+  AND j.status <> 'draft'
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL;
 
 -- name: ListJobsByTitle :many
 SELECT *
 FROM jobs
 WHERE title ILIKE '%' || @title::text || '%'
+  AND deleted_at IS NULL
 LIMIT $1 OFFSET $2;
 
 -- name: ListJobsByLocation :many
 SELECT *
 FROM jobs
 WHERE location = $1
+  AND deleted_at IS NULL
 LIMIT $2 OFFSET $3;
 
 -- name: ListJobsByIndustry :many
 SELECT *
 FROM jobs
 WHERE industry = $1
+  AND deleted_at IS NULL
 LIMIT $2 OFFSET $3;
 
 -- name: ListJobsByCompanyID :many
-SELECT j.*,
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.company_id,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
        c.name AS company_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
 WHERE j.company_id = $1
-LIMIT $2 OFFSET $3;
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+  -- keyset pagination, only jobs after the cursor in the requested order,
+  -- the cursor keeps the salary or the company name of its job as they were on the previous page
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR @sort::text = 'newest' AND (j.created_at, j.id) < (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int)
+    OR @sort::text = 'oldest' AND (j.created_at, j.id) > (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int)
+    OR @sort::text = 'salary-desc' AND (normalize_salary(j.salary_max, j.salary_currency, j.salary_period), j.id) <
+                                       (normalize_salary(sqlc.narg('cursor_salary')::int,
+                                                         sqlc.narg('cursor_salary_currency')::char(3),
+                                                         sqlc.narg('cursor_salary_period')::salary_period), @cursor_id::int)
+    OR @sort::text = 'salary-asc' AND (normalize_salary(j.salary_min, j.salary_currency, j.salary_period), j.id) >
+                                      (normalize_salary(sqlc.narg('cursor_salary')::int,
+                                                        sqlc.narg('cursor_salary_currency')::char(3),
+                                                        sqlc.narg('cursor_salary_period')::salary_period), @cursor_id::int)
+    OR @sort::text = 'company' AND (c.name, j.id) > (sqlc.narg('cursor_company_name')::text, @cursor_id::int))
+ORDER BY CASE WHEN @sort::text = 'newest' THEN j.created_at END DESC,
+         CASE WHEN @sort::text = 'oldest' THEN j.created_at END ASC,
+         CASE WHEN @sort::text = 'salary-desc' THEN normalize_salary(j.salary_max, j.salary_currency, j.salary_period) END DESC,
+         CASE WHEN @sort::text = 'salary-asc' THEN normalize_salary(j.salary_min, j.salary_currency, j.salary_period) END ASC,
+         CASE WHEN @sort::text = 'company' THEN c.name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN @sort::text IN ('newest', 'salary-desc') THEN j.id END DESC,
+         CASE WHEN @sort::text IN ('oldest', 'salary-asc', 'company') THEN j.id END ASC
+LIMIT $2;
 
 -- name: ListJobsByCompanyExactName :many
-SELECT j.*,
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.company_id,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
        c.name AS company_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
 WHERE c.name = $1
-LIMIT $2 OFFSET $3;
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+  -- keyset pagination, only jobs after the cursor in the requested order,
+  -- the cursor keeps the salary or the company name of its job as they were on the previous page
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR @sort::text = 'newest' AND (j.created_at, j.id) < (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int)
+    OR @sort::text = 'oldest' AND (j.created_at, j.id) > (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int)
+    OR @sort::text = 'salary-desc' AND (normalize_salary(j.salary_max, j.salary_currency, j.salary_period), j.id) <
+                                       (normalize_salary(sqlc.narg('cursor_salary')::int,
+                                                         sqlc.narg('cursor_salary_currency')::char(3),
+                                                         sqlc.narg('cursor_salary_period')::salary_period), @cursor_id::int)
+    OR @sort::text = 'salary-asc' AND (normalize_salary(j.salary_min, j.salary_currency, j.salary_period), j.id) >
+                                      (normalize_salary(sqlc.narg('cursor_salary')::int,
+                                                        sqlc.narg('cursor_salary_currency')::char(3),
+                                                        sqlc.narg('cursor_salary_period')::salary_period), @cursor_id::int)
+    OR @sort::text = 'company' AND (c.name, j.id) > (sqlc.narg('cursor_company_name')::text, @cursor_id::int))
+ORDER BY CASE WHEN @sort::text = 'newest' THEN j.created_at END DESC,
+         CASE WHEN @sort::text = 'oldest' THEN j.created_at END ASC,
+         CASE WHEN @sort::text = 'salary-desc' THEN normalize_salary(j.salary_max, j.salary_currency, j.salary_period) END DESC,
+         CASE WHEN @sort::text = 'salary-asc' THEN normalize_salary(j.salary_min, j.salary_currency, j.salary_period) END ASC,
+         CASE WHEN @sort::text = 'company' THEN c.name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN @sort::text IN ('newest', 'salary-desc') THEN j.id END DESC,
+         CASE WHEN @sort::text IN ('oldest', 'salary-asc', 'company') THEN j.id END ASC
+LIMIT $2;
 
 -- name: ListJobsByCompanyName :many
-SELECT j.*,
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.company_id,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
        c.name AS company_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
 WHERE c.name ILIKE '%' || @name::text || '%'
-LIMIT $1 OFFSET $2;
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+  -- keyset pagination, only jobs after the cursor in the requested order,
+  -- the cursor keeps the salary or the company name of its job as they were on the previous page
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR @sort::text = 'newest' AND (j.created_at, j.id) < (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int)
+    OR @sort::text = 'oldest' AND (j.created_at, j.id) > (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int)
+    OR @sort::text = 'salary-desc' AND (normalize_salary(j.salary_max, j.salary_currency, j.salary_period), j.id) <
+                                       (normalize_salary(sqlc.narg('cursor_salary')::int,
+                                                         sqlc.narg('cursor_salary_currency')::char(3),
+                                                         sqlc.narg('cursor_salary_period')::salary_period), @cursor_id::int)
+    OR @sort::text = 'salary-asc' AND (normalize_salary(j.salary_min, j.salary_currency, j.salary_period), j.id) >
+                                      (normalize_salary(sqlc.narg('cursor_salary')::int,
+                                                        sqlc.narg('cursor_salary_currency')::char(3),
+                                                        sqlc.narg('cursor_salary_period')::salary_period), @cursor_id::int)
+    OR @sort::text = 'company' AND (c.name, j.id) > (sqlc.narg('cursor_company_name')::text, @cursor_id::int))
+ORDER BY CASE WHEN @sort::text = 'newest' THEN j.created_at END DESC,
+         CASE WHEN @sort::text = 'oldest' THEN j.created_at END ASC,
+         CASE WHEN @sort::text = 'salary-desc' THEN normalize_salary(j.salary_max, j.salary_currency, j.salary_period) END DESC,
+         CASE WHEN @sort::text = 'salary-asc' THEN normalize_salary(j.salary_min, j.salary_currency, j.salary_period) END ASC,
+         CASE WHEN @sort::text = 'company' THEN c.name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN @sort::text IN ('newest', 'salary-desc') THEN j.id END DESC,
+         CASE WHEN @sort::text IN ('oldest', 'salary-asc', 'company') THEN j.id END ASC
+LIMIT $1;
+
+-- name: CountJobsByCompanyID :one
+SELECT count(*)
+FROM jobs j
+         JOIN companies c ON j.company_id = c.id
+WHERE j.company_id = $1
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL;
+
+-- name: CountJobsByCompanyExactName :one
+SELECT count(*)
+FROM jobs j
+         JOIN companies c ON j.company_id = c.id
+WHERE c.name = $1
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL;
+
+-- name: CountJobsByCompanyName :one
+SELECT count(*)
+FROM jobs j
+         JOIN companies c ON j.company_id = c.id
+WHERE c.name ILIKE '%' || @name::text || '%'
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL;
 
 -- name: ListJobsBySalaryRange :many
 SELECT *
 FROM jobs
-WHERE salary_min >= $1
-  AND salary_max <= $2
-LIMIT $3 OFFSET $4;
+WHERE normalize_salary(salary_min, salary_currency, salary_period) >=
+      normalize_salary(@salary_min::int, @salary_currency::char(3), @salary_period::salary_period)
+  AND normalize_salary(salary_max, salary_currency, salary_period) <=
+      normalize_salary(@salary_max::int, @salary_currency::char(3), @salary_period::salary_period)
+  AND deleted_at IS NULL
+LIMIT $1 OFFSET $2;
 
+-- the match score is the sum of the scores of the job, up to 100 points:
+-- skills up to 50, desired title 20, industry 10, location 10 and salary range 10
 -- name: ListJobsMatchingUserSkills :many
-SELECT j.*,
-       c.name AS company_name
-FROM jobs j
-         JOIN companies c ON j.company_id = c.id
-WHERE j.id IN (SELECT job_id
-               FROM job_skills
-               WHERE skill IN (SELECT skill
-                               FROM user_skills
-                               WHERE user_id = $1))
-LIMIT $2 OFFSET $3;
+SELECT m.id,
+       m.title,
+       m.industry,
+       m.company_id,
+       m.description,
+       m.location,
+       m.salary_min,
+       m.salary_max,
+       m.salary_currency,
+       m.salary_period,
+       m.requirements,
+       m.created_at,
+       m.employment_type,
+       m.work_mode,
+       m.seniority_level,
+       m.company_name,
+       m.matched_skills,
+       m.total_skills,
+       m.skills_score,
+       m.title_score,
+       m.industry_score,
+       m.location_score,
+       m.salary_score,
+       (m.skills_score + m.title_score + m.industry_score + m.location_score + m.salary_score)::float8 AS match_score
+FROM (SELECT j.id,
+             j.title,
+             j.industry,
+             j.company_id,
+             j.description,
+             j.location,
+             j.salary_min,
+             j.salary_max,
+             j.salary_currency,
+             j.salary_period,
+             j.requirements,
+             j.created_at,
+             j.employment_type,
+             j.work_mode,
+             j.seniority_level,
+             c.name                                                                      AS company_name,
+             s.matched_skills,
+             s.total_skills,
+             -- every skill of the job the user has counts half, the other half grows with the years
+             -- of experience up to 5, so a job is scored by the share of its skills the user has
+             (50 * s.skills_weight / s.total_skills)::float8                             AS skills_score,
+             (CASE
+                  WHEN u.desired_job_title <> '' AND
+                       strpos(lower(j.title), lower(u.desired_job_title)) > 0 THEN 20
+                  ELSE 0 END)::float8                                                    AS title_score,
+             (CASE
+                  WHEN u.desired_industry <> '' AND lower(j.industry) = lower(u.desired_industry) THEN 10
+                  ELSE 0 END)::float8                                                    AS industry_score,
+             -- remote jobs match any location
+             (CASE
+                  WHEN j.work_mode = 'remote' THEN 10
+                  WHEN u.location <> '' AND lower(j.location) = lower(u.location) THEN 10
+                  ELSE 0 END)::float8                                                    AS location_score,
+             -- the share of the desired salary range the job can pay, both converted to USD per year
+             (CASE
+                  WHEN normalize_salary(u.desired_salary_max, u.desired_salary_currency, u.desired_salary_period) <=
+                       normalize_salary(u.desired_salary_min, u.desired_salary_currency, u.desired_salary_period)
+                      THEN 10
+                  ELSE 10 * least(1, (normalize_salary(j.salary_max, j.salary_currency, j.salary_period) -
+                                      normalize_salary(u.desired_salary_min, u.desired_salary_currency,
+                                                       u.desired_salary_period)) /
+                                     (normalize_salary(u.desired_salary_max, u.desired_salary_currency,
+                                                       u.desired_salary_period) -
+                                      normalize_salary(u.desired_salary_min, u.desired_salary_currency,
+                                                       u.desired_salary_period)))
+                 END)::float8                                                            AS salary_score
+      FROM jobs j
+               JOIN companies c ON j.company_id = c.id
+               JOIN users u ON u.id = @user_id
+               JOIN LATERAL (SELECT count(*)                                                   AS total_skills,
+                                    count(us.id)                                               AS matched_skills,
+                                    COALESCE(sum(0.5 + 0.5 * least(us.experience, 5) / 5.0), 0) AS skills_weight
+                             FROM job_skills js
+                                      LEFT JOIN user_skills us ON us.skill = js.skill AND us.user_id = u.id
+                             WHERE js.job_id = j.id) s ON true
+      WHERE s.matched_skills > 0
+        -- the job pays at least the desired minimum of the user, both converted to USD per year
+        AND normalize_salary(j.salary_max, j.salary_currency, j.salary_period) >=
+            normalize_salary(u.desired_salary_min, u.desired_salary_currency, u.desired_salary_period)
+        AND j.status = 'published'
+        AND (j.expires_at IS NULL OR j.expires_at > now())
+        AND j.deleted_at IS NULL
+        AND j.unpublished_at IS NULL
+        AND c.suspended_at IS NULL) m
+ORDER BY CASE
+             WHEN @sort::text = 'score'
+                 THEN m.skills_score + m.title_score + m.industry_score + m.location_score + m.salary_score END DESC,
+         CASE WHEN @sort::text = 'newest' THEN m.created_at END DESC,
+         CASE WHEN @sort::text = 'oldest' THEN m.created_at END ASC,
+         CASE WHEN @sort::text = 'salary-desc' THEN normalize_salary(m.salary_max, m.salary_currency, m.salary_period) END DESC,
+         CASE WHEN @sort::text = 'salary-asc' THEN normalize_salary(m.salary_min, m.salary_currency, m.salary_period) END ASC,
+         CASE WHEN @sort::text = 'company' THEN m.company_name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN @sort::text IN ('score', 'newest', 'salary-desc') THEN m.id END DESC,
+         CASE WHEN @sort::text IN ('oldest', 'salary-asc', 'company') THEN m.id END ASC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: UpdateJob :one
 UPDATE jobs
-SET title        = $2,
-    industry     = $3,
-    company_id   = $4,
-    description  = $5,
-    location     = $6,
-    salary_min   = $7,
-    salary_max   = $8,
-    requirements = $9
+SET title           = $2,
+    industry        = $3,
+    company_id      = $4,
+    description     = $5,
+    location        = $6,
+    salary_min      = $7,
+    salary_max      = $8,
+    requirements    = $9,
+    employment_type = $10,
+    work_mode       = $11,
+    seniority_level = $12,
+    salary_currency = $13,
+    salary_period   = $14
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateJobStatus :one
+UPDATE jobs
+SET status             = $2,
+    expires_at         = $3,
+    publish_at         = $4,
+    -- the employers are notified again about a new expiration
+    expiry_notified_at = CASE WHEN expires_at IS DISTINCT FROM $3 THEN NULL ELSE expiry_notified_at END
+WHERE id = $1
+RETURNING *;
+
+-- drafts of suspended companies and drafts unpublished by an admin wait until they are restored
+-- name: PublishScheduledJobs :many
+UPDATE jobs
+SET status     = 'published',
+    publish_at = NULL
+WHERE status = 'draft'
+  AND publish_at <= @now::timestamptz
+  AND deleted_at IS NULL
+  AND unpublished_at IS NULL
+  AND company_id IN (SELECT id
+                     FROM companies
+                     WHERE suspended_at IS NULL)
+RETURNING *;
+
+-- name: ExpireJobs :many
+UPDATE jobs
+SET status = 'expired'
+WHERE status = 'published'
+  AND expires_at <= @now::timestamptz
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- marks the published jobs expiring until notify_before, so the employers are notified only once
+-- name: MarkJobsExpiryNotified :many
+UPDATE jobs
+SET expiry_notified_at = now()
+WHERE status = 'published'
+  AND expires_at > @now::timestamptz
+  AND expires_at <= @notify_before::timestamptz
+  AND expiry_notified_at IS NULL
+  AND deleted_at IS NULL
+  AND unpublished_at IS NULL
+RETURNING *;
+
+-- name: UnpublishJob :one
+UPDATE jobs
+SET unpublished_at = now()
 WHERE id = $1
 RETURNING *;
 
 -- name: DeleteJob :exec
-DELETE
-FROM jobs
+UPDATE jobs
+SET deleted_at = now()
 WHERE id = $1;
 
 -- name: ListAllJobsForES :many
@@ -116,9 +460,28 @@ SELECT j.id,
        c.name AS company_name,
        j.salary_min,
        j.salary_max,
-       j.requirements
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.status,
+       j.expires_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level
 FROM jobs j
-         JOIN companies c ON j.company_id = c.id;
+         JOIN companies c ON j.company_id = c.id
+WHERE j.status = 'published'
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL;
+
+-- name: ListCompanyJobsForES :many
+SELECT *
+FROM jobs
+WHERE company_id = $1
+  AND status = 'published'
+  AND deleted_at IS NULL
+  AND unpublished_at IS NULL;
 
 -- name: GetCompanyIDOfJob :one
 SELECT company_id
@@ -133,13 +496,63 @@ SELECT id,
        location,
        salary_min,
        salary_max,
-       created_at
+       salary_currency,
+       salary_period,
+       created_at,
+       status,
+       employment_type,
+       work_mode,
+       seniority_level
 FROM jobs
 WHERE company_id = $1
+  AND deleted_at IS NULL
+  -- keyset pagination, only jobs after the cursor in the requested order
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR @created_at_asc::bool AND (created_at, id) > (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int)
+    OR @created_at_desc::bool AND (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int))
 ORDER BY CASE WHEN @created_at_asc::bool THEN created_at END ASC,
+         CASE WHEN @created_at_asc::bool THEN id END ASC,
          CASE WHEN @created_at_desc::bool THEN created_at END DESC,
-         created_at DESC
-LIMIT $2 OFFSET $3;
+         CASE WHEN @created_at_desc::bool THEN id END DESC
+LIMIT $2;
+
+-- name: CountJobsForEmployer :one
+SELECT count(*)
+FROM jobs
+WHERE company_id = $1
+  AND deleted_at IS NULL;
+
+-- name: ExportJobsForEmployer :many
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.status,
+       j.expires_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
+       COALESCE((SELECT array_agg(js.skill ORDER BY js.skill)
+                 FROM job_skills js
+                 WHERE js.job_id = j.id), '{}')::text[] AS required_skills,
+       (SELECT count(*)
+        FROM job_applications ja
+        WHERE ja.job_id = j.id)                       AS applications
+FROM jobs j
+WHERE j.company_id = $1
+  AND j.deleted_at IS NULL
+  -- keyset pagination, the export is read page by page
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (j.created_at, j.id) < (sqlc.narg('cursor_created_at')::timestamptz, @cursor_id::int))
+ORDER BY j.created_at DESC, j.id DESC
+LIMIT $2;
 
 -- name: GetJobBasicInfo :one
 SELECT
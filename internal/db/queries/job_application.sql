@@ -1,6 +1,12 @@
 -- name: CreateJobApplication :one
-INSERT INTO job_applications (user_id, job_id, message, cv)
-VALUES ($1, $2, $3, $4)
+INSERT INTO job_applications (user_id, job_id, message, cv, job_revision_id)
+VALUES ($1, $2, $3, $4,
+        -- the application is pinned to the latest revision of the job
+        (SELECT jr.id
+         FROM job_revisions jr
+         WHERE jr.job_id = $2
+         ORDER BY jr.revision DESC
+         LIMIT 1))
 RETURNING *;
 
 -- this function will be used by users only
@@ -14,10 +20,13 @@ SELECT ja.id         AS application_id,
        ja.message    AS application_message,
        ja.cv         AS user_cv,
        ja.user_id    AS user_id,
-       ja.notification AS notification
+       ja.notification AS notification,
+       ja.job_revision_id AS job_revision_id,
+       jr.revision   AS job_revision
 FROM job_applications ja
          JOIN jobs j ON ja.job_id = j.id
          JOIN companies c ON j.company_id = c.id
+         LEFT JOIN job_revisions jr ON ja.job_revision_id = jr.id
 WHERE ja.id = $1;
 
 -- this function will be used by employers
@@ -33,11 +42,13 @@ SELECT ja.id         AS application_id,
        u.email       AS user_email,
        u.full_name   AS user_full_name,
        u.location    AS user_location,
-       c.id          AS company_id
+       c.id          AS company_id,
+       jr.revision   AS job_revision
 FROM job_applications ja
          JOIN jobs j ON ja.job_id = j.id
          JOIN companies c ON j.company_id = c.id
          JOIN users u ON ja.user_id = u.id
+         LEFT JOIN job_revisions jr ON ja.job_revision_id = jr.id
 WHERE ja.id = $1;
 
 -- name: ListJobApplicationsForUser :many
@@ -48,16 +59,28 @@ SELECT ja.user_id    AS user_id,
        j.id          AS job_id,
        c.name        AS company_name,
        ja.status     AS application_status,
-       ja.applied_at AS application_date
+       ja.applied_at AS application_date,
+       (j.deleted_at IS NOT NULL)::bool AS job_deleted
 FROM job_applications ja
          JOIN jobs j ON ja.job_id = j.id
          JOIN companies c ON j.company_id = c.id
 WHERE ja.user_id = $1
   AND (@filter_status::bool = TRUE AND ja.status = @status OR @filter_status::bool = FALSE)
+  -- keyset pagination, only applications after the cursor in the requested order
+  AND (sqlc.narg('cursor_applied_at')::timestamptz IS NULL
+    OR @applied_at_asc::bool AND (ja.applied_at, ja.id) > (sqlc.narg('cursor_applied_at')::timestamptz, @cursor_id::int)
+    OR @applied_at_desc::bool AND (ja.applied_at, ja.id) < (sqlc.narg('cursor_applied_at')::timestamptz, @cursor_id::int))
 ORDER BY CASE WHEN @applied_at_asc::bool THEN ja.applied_at END ASC,
+         CASE WHEN @applied_at_asc::bool THEN ja.id END ASC,
          CASE WHEN @applied_at_desc::bool THEN ja.applied_at END DESC,
-         ja.applied_at DESC
-LIMIT $2 OFFSET $3;
+         CASE WHEN @applied_at_desc::bool THEN ja.id END DESC
+LIMIT $2;
+
+-- name: CountJobApplicationsForUser :one
+SELECT count(*)
+FROM job_applications ja
+WHERE ja.user_id = $1
+  AND (@filter_status::bool = TRUE AND ja.status = @status OR @filter_status::bool = FALSE);
 
 -- name: ListJobApplicationsForEmployer :many
 SELECT ja.id         AS application_id,
@@ -70,10 +93,55 @@ FROM job_applications ja
          JOIN users u ON u.id = ja.user_id
 WHERE ja.job_id = $1
   AND (@filter_status::bool = TRUE AND ja.status = @status OR @filter_status::bool = FALSE)
+  -- keyset pagination, only applications after the cursor in the requested order
+  AND (sqlc.narg('cursor_applied_at')::timestamptz IS NULL
+    OR @applied_at_asc::bool AND (ja.applied_at, ja.id) > (sqlc.narg('cursor_applied_at')::timestamptz, @cursor_id::int)
+    OR @applied_at_desc::bool AND (ja.applied_at, ja.id) < (sqlc.narg('cursor_applied_at')::timestamptz, @cursor_id::int))
 ORDER BY CASE WHEN @applied_at_asc::bool THEN ja.applied_at END ASC,
+         CASE WHEN @applied_at_asc::bool THEN ja.id END ASC,
          CASE WHEN @applied_at_desc::bool THEN ja.applied_at END DESC,
-         ja.applied_at DESC
-LIMIT $2 OFFSET $3;
+         CASE WHEN @applied_at_desc::bool THEN ja.id END DESC
+LIMIT $2;
+
+-- name: CountJobApplicationsForEmployer :one
+SELECT count(*)
+FROM job_applications ja
+WHERE ja.job_id = $1
+  AND (@filter_status::bool = TRUE AND ja.status = @status OR @filter_status::bool = FALSE);
+
+-- name: ExportJobApplicationsForEmployer :many
+SELECT ja.id         AS application_id,
+       ja.status     AS application_status,
+       ja.applied_at AS application_date,
+       j.id          AS job_id,
+       j.title       AS job_title,
+       ja.user_id    AS user_id,
+       u.full_name   AS user_full_name,
+       u.email       AS user_email,
+       u.location    AS user_location,
+       u.telegram_id AS user_telegram_id,
+       COALESCE((SELECT array_agg(us.skill ORDER BY us.skill)
+                 FROM user_skills us
+                 WHERE us.user_id = u.id), '{}')::text[] AS user_skills
+FROM job_applications ja
+         JOIN jobs j ON j.id = ja.job_id
+         JOIN users u ON u.id = ja.user_id
+WHERE ja.job_id = $1
+  AND (@filter_status::bool = TRUE AND ja.status = @status OR @filter_status::bool = FALSE)
+  -- keyset pagination, the export is read page by page
+  AND (sqlc.narg('cursor_applied_at')::timestamptz IS NULL
+    OR (ja.applied_at, ja.id) > (sqlc.narg('cursor_applied_at')::timestamptz, @cursor_id::int))
+ORDER BY ja.applied_at, ja.id
+LIMIT sqlc.arg('limit');
+
+-- name: ListJobApplicationCountsByPeriod :many
+SELECT date_trunc(@period::text, ja.applied_at AT TIME ZONE 'UTC')::date AS period_start,
+       count(*)                                                         AS applications
+FROM job_applications ja
+WHERE ja.job_id = $1
+  AND (ja.applied_at AT TIME ZONE 'UTC')::date BETWEEN @from_date::date AND @to_date::date
+GROUP BY period_start
+ORDER BY period_start;
 
 -- name: UpdateJobApplication :one
 UPDATE job_applications
@@ -113,6 +181,8 @@ SELECT job_id
 FROM job_applications
 WHERE id = $1;
 
-
-
-
+-- this function will be used by admins only
+-- name: GetJobApplication :one
+SELECT *
+FROM job_applications
+WHERE id = $1;
@@ -0,0 +1,43 @@
+-- name: CreateJobRevision :one
+INSERT INTO job_revisions (job_id, revision, author_id, title, industry, description, location, salary_min,
+                           salary_max, salary_currency, salary_period, requirements, required_skills,
+                           employment_type, work_mode, seniority_level)
+SELECT j.id,
+       COALESCE((SELECT max(jr.revision) FROM job_revisions jr WHERE jr.job_id = j.id), 0) + 1,
+       sqlc.narg('author_id'),
+       j.title,
+       j.industry,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       COALESCE((SELECT array_agg(js.skill ORDER BY js.skill)
+                 FROM job_skills js
+                 WHERE js.job_id = j.id), '{}')::varchar[],
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level
+FROM jobs j
+-- the revision is a snapshot of the job and its skills as they are stored
+WHERE j.id = @job_id
+RETURNING id, job_id, revision, author_id, title, industry, description, location, salary_min, salary_max, salary_currency, salary_period, requirements, required_skills, employment_type, work_mode, seniority_level, created_at;
+
+-- name: GetJobRevision :one
+SELECT id, job_id, revision, author_id, title, industry, description, location, salary_min, salary_max, salary_currency, salary_period, requirements, required_skills, employment_type, work_mode, seniority_level, created_at
+FROM job_revisions
+WHERE job_id = $1
+  AND revision = $2;
+
+-- name: GetJobRevisionByID :one
+SELECT id, job_id, revision, author_id, title, industry, description, location, salary_min, salary_max, salary_currency, salary_period, requirements, required_skills, employment_type, work_mode, seniority_level, created_at
+FROM job_revisions
+WHERE id = $1;
+
+-- name: ListJobRevisions :many
+SELECT id, job_id, revision, author_id, title, industry, description, location, salary_min, salary_max, salary_currency, salary_period, requirements, required_skills, employment_type, work_mode, seniority_level, created_at
+FROM job_revisions
+WHERE job_id = $1
+ORDER BY revision DESC;
@@ -31,10 +31,11 @@ DELETE
 FROM job_skills
 WHERE id = $1;
 
--- name: DeleteMultipleJobSkills :exec
+-- name: DeleteMultipleJobSkills :execrows
 DELETE
 FROM job_skills
-WHERE id = ANY (@IDs::int[]);
+WHERE id = ANY (@IDs::int[])
+  AND job_id = @job_id;
 
 -- name: DeleteJobSkillsByJobID :exec
 DELETE
@@ -0,0 +1,30 @@
+-- name: CreateJobTemplate :one
+INSERT INTO job_templates (company_id, name, description, requirements, required_skills)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetJobTemplate :one
+SELECT *
+FROM job_templates
+WHERE id = $1;
+
+-- name: ListJobTemplates :many
+SELECT *
+FROM job_templates
+WHERE company_id = $1
+ORDER BY name, id;
+
+-- name: UpdateJobTemplate :one
+UPDATE job_templates
+SET name            = $2,
+    description     = $3,
+    requirements    = $4,
+    required_skills = $5,
+    updated_at      = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteJobTemplate :exec
+DELETE
+FROM job_templates
+WHERE id = $1;
@@ -0,0 +1,31 @@
+-- name: RecordJobView :exec
+INSERT INTO job_views (job_id, viewer, source)
+VALUES ($1, $2, 'detail')
+ON CONFLICT (job_id, viewed_on, source, viewer) DO NOTHING;
+
+-- name: RecordJobImpressions :exec
+INSERT INTO job_views (job_id, viewer, source)
+SELECT j.id, @viewer::text, 'search'
+FROM jobs j
+-- jobs of the search index that were deleted in the meantime are skipped
+WHERE j.id = ANY (@job_ids::int[])
+ON CONFLICT (job_id, viewed_on, source, viewer) DO NOTHING;
+
+-- name: GetJobViewTotals :one
+SELECT count(*) FILTER (WHERE v.source = 'detail')                 AS views,
+       count(DISTINCT v.viewer) FILTER (WHERE v.source = 'detail') AS unique_viewers,
+       count(*) FILTER (WHERE v.source = 'search')                 AS impressions
+FROM job_views v
+WHERE v.job_id = $1
+  AND v.viewed_on BETWEEN @from_date::date AND @to_date::date;
+
+-- name: ListJobViewsByPeriod :many
+SELECT date_trunc(@period::text, v.viewed_on)::date                 AS period_start,
+       count(*) FILTER (WHERE v.source = 'detail')                 AS views,
+       count(DISTINCT v.viewer) FILTER (WHERE v.source = 'detail') AS unique_viewers,
+       count(*) FILTER (WHERE v.source = 'search')                 AS impressions
+FROM job_views v
+WHERE v.job_id = $1
+  AND v.viewed_on BETWEEN @from_date::date AND @to_date::date
+GROUP BY period_start
+ORDER BY period_start;
@@ -0,0 +1,21 @@
+-- name: CreatePasswordReset :one
+INSERT INTO password_resets
+    (email, account_type, account_id, hashed_code)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: UsePasswordReset :one
+UPDATE password_resets
+SET is_used = TRUE
+WHERE email = $1
+  AND account_type = $2
+  AND hashed_code = $3
+  AND is_used = FALSE
+  AND expired_at > now()
+RETURNING *;
+
+-- name: DeletePasswordResets :exec
+DELETE
+FROM password_resets
+WHERE email = $1
+  AND account_type = $2;
@@ -0,0 +1,38 @@
+-- name: CreateSession :one
+INSERT INTO sessions (id,
+                      email,
+                      role,
+                      subject_id,
+                      refresh_token,
+                      user_agent,
+                      client_ip,
+                      is_blocked,
+                      expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetSession :one
+SELECT *
+FROM sessions
+WHERE id = $1;
+
+-- name: ListActiveSessionsBySubject :many
+SELECT *
+FROM sessions
+WHERE role = $1
+  AND subject_id = $2
+  AND is_blocked = FALSE
+  AND expires_at > now()
+ORDER BY created_at DESC;
+
+-- name: BlockSession :exec
+UPDATE sessions
+SET is_blocked = TRUE
+WHERE id = $1;
+
+-- name: BlockSessionsBySubject :exec
+UPDATE sessions
+SET is_blocked = TRUE
+WHERE role = $1
+  AND subject_id = $2
+  AND is_blocked = FALSE;
@@ -1,7 +1,7 @@
 -- name: CreateUser :one
 INSERT INTO users (full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min,
-                   desired_salary_max, skills, experience, telegram_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+                   desired_salary_max, skills, experience, telegram_id, desired_salary_currency, desired_salary_period)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 RETURNING *;
 
 -- name: GetUserByID :one
@@ -25,7 +25,9 @@ SET full_name          = $2,
     desired_salary_max = $8,
     skills             = $9,
     experience         = $10,
-    telegram_id = $10
+    telegram_id = $10,
+    desired_salary_currency = $11,
+    desired_salary_period   = $12
 WHERE id = $1
 RETURNING *;
 
@@ -43,4 +45,24 @@ RETURNING *;
 -- name: DeleteUser :exec
 DELETE
 FROM users
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: SearchUsers :many
+SELECT *
+FROM users
+WHERE email ILIKE '%' || @search::text || '%'
+   OR full_name ILIKE '%' || @search::text || '%'
+ORDER BY id
+LIMIT $1 OFFSET $2;
+
+-- name: SuspendUser :one
+UPDATE users
+SET suspended_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: RestoreUser :one
+UPDATE users
+SET suspended_at = NULL
+WHERE id = $1
+RETURNING *;
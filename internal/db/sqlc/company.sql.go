@@ -12,7 +12,7 @@ import (
 const createCompany = `-- name: CreateCompany :one
 INSERT INTO companies (name, industry, location)
 VALUES ($1, $2, $3)
-RETURNING id, name, industry, location
+RETURNING id, name, industry, location, suspended_at
 `
 
 type CreateCompanyParams struct {
@@ -29,6 +29,7 @@ func (q *Queries) CreateCompany(ctx context.Context, arg CreateCompanyParams) (C
 		&i.Name,
 		&i.Industry,
 		&i.Location,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
@@ -45,7 +46,7 @@ func (q *Queries) DeleteCompany(ctx context.Context, id int32) error {
 }
 
 const getCompanyByID = `-- name: GetCompanyByID :one
-SELECT id, name, industry, location
+SELECT id, name, industry, location, suspended_at
 FROM companies
 WHERE id = $1
 `
@@ -58,12 +59,13 @@ func (q *Queries) GetCompanyByID(ctx context.Context, id int32) (Company, error)
 		&i.Name,
 		&i.Industry,
 		&i.Location,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
 
 const getCompanyByName = `-- name: GetCompanyByName :one
-SELECT id, name, industry, location
+SELECT id, name, industry, location, suspended_at
 FROM companies
 WHERE name = $1
 `
@@ -76,6 +78,7 @@ func (q *Queries) GetCompanyByName(ctx context.Context, name string) (Company, e
 		&i.Name,
 		&i.Industry,
 		&i.Location,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
@@ -93,13 +96,96 @@ func (q *Queries) GetCompanyNameByID(ctx context.Context, id int32) (string, err
 	return name, err
 }
 
+const restoreCompany = `-- name: RestoreCompany :one
+UPDATE companies
+SET suspended_at = NULL
+WHERE id = $1
+RETURNING id, name, industry, location, suspended_at
+`
+
+func (q *Queries) RestoreCompany(ctx context.Context, id int32) (Company, error) {
+	row := q.db.QueryRowContext(ctx, restoreCompany, id)
+	var i Company
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Industry,
+		&i.Location,
+		&i.SuspendedAt,
+	)
+	return i, err
+}
+
+const searchCompanies = `-- name: SearchCompanies :many
+SELECT id, name, industry, location, suspended_at
+FROM companies
+WHERE name ILIKE '%' || $3::text || '%'
+ORDER BY id
+LIMIT $1 OFFSET $2
+`
+
+type SearchCompaniesParams struct {
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+	Search string `json:"search"`
+}
+
+func (q *Queries) SearchCompanies(ctx context.Context, arg SearchCompaniesParams) ([]Company, error) {
+	rows, err := q.db.QueryContext(ctx, searchCompanies, arg.Limit, arg.Offset, arg.Search)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Company{}
+	for rows.Next() {
+		var i Company
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Industry,
+			&i.Location,
+			&i.SuspendedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const suspendCompany = `-- name: SuspendCompany :one
+UPDATE companies
+SET suspended_at = now()
+WHERE id = $1
+RETURNING id, name, industry, location, suspended_at
+`
+
+func (q *Queries) SuspendCompany(ctx context.Context, id int32) (Company, error) {
+	row := q.db.QueryRowContext(ctx, suspendCompany, id)
+	var i Company
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Industry,
+		&i.Location,
+		&i.SuspendedAt,
+	)
+	return i, err
+}
+
 const updateCompany = `-- name: UpdateCompany :one
 UPDATE companies
 SET name     = $2,
     industry = $3,
     location = $4
 WHERE id = $1
-RETURNING id, name, industry, location
+RETURNING id, name, industry, location, suspended_at
 `
 
 type UpdateCompanyParams struct {
@@ -122,6 +208,7 @@ func (q *Queries) UpdateCompany(ctx context.Context, arg UpdateCompanyParams) (C
 		&i.Name,
 		&i.Industry,
 		&i.Location,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
@@ -9,17 +9,50 @@ import (
 	"context"
 )
 
+const countCompanyEmployers = `-- name: CountCompanyEmployers :one
+SELECT count(*)
+FROM employers
+WHERE company_id = $1
+`
+
+func (q *Queries) CountCompanyEmployers(ctx context.Context, companyID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCompanyEmployers, companyID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCompanyEmployersByRole = `-- name: CountCompanyEmployersByRole :one
+SELECT count(*)
+FROM employers
+WHERE company_id = $1
+  AND role = $2
+`
+
+type CountCompanyEmployersByRoleParams struct {
+	CompanyID int32        `json:"company_id"`
+	Role      EmployerRole `json:"role"`
+}
+
+func (q *Queries) CountCompanyEmployersByRole(ctx context.Context, arg CountCompanyEmployersByRoleParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCompanyEmployersByRole, arg.CompanyID, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEmployer = `-- name: CreateEmployer :one
-INSERT INTO employers (company_id, full_name, email, hashed_password)
-VALUES ($1, $2, $3, $4)
-RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified
+INSERT INTO employers (company_id, full_name, email, hashed_password, role)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
 `
 
 type CreateEmployerParams struct {
-	CompanyID      int32  `json:"company_id"`
-	FullName       string `json:"full_name"`
-	Email          string `json:"email"`
-	HashedPassword string `json:"hashed_password"`
+	CompanyID      int32        `json:"company_id"`
+	FullName       string       `json:"full_name"`
+	Email          string       `json:"email"`
+	HashedPassword string       `json:"hashed_password"`
+	Role           EmployerRole `json:"role"`
 }
 
 func (q *Queries) CreateEmployer(ctx context.Context, arg CreateEmployerParams) (Employer, error) {
@@ -28,6 +61,7 @@ func (q *Queries) CreateEmployer(ctx context.Context, arg CreateEmployerParams)
 		arg.FullName,
 		arg.Email,
 		arg.HashedPassword,
+		arg.Role,
 	)
 	var i Employer
 	err := row.Scan(
@@ -38,6 +72,8 @@ func (q *Queries) CreateEmployer(ctx context.Context, arg CreateEmployerParams)
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
@@ -92,7 +128,7 @@ func (q *Queries) GetEmployerAndCompanyDetails(ctx context.Context, email string
 }
 
 const getEmployerByEmail = `-- name: GetEmployerByEmail :one
-SELECT id, company_id, full_name, email, hashed_password, created_at, is_email_verified
+SELECT id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
 FROM employers
 WHERE email = $1
 `
@@ -108,12 +144,14 @@ func (q *Queries) GetEmployerByEmail(ctx context.Context, email string) (Employe
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
 
 const getEmployerByID = `-- name: GetEmployerByID :one
-SELECT id, company_id, full_name, email, hashed_password, created_at, is_email_verified
+SELECT id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
 FROM employers
 WHERE id = $1
 `
@@ -129,6 +167,144 @@ func (q *Queries) GetEmployerByID(ctx context.Context, id int32) (Employer, erro
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.SuspendedAt,
+	)
+	return i, err
+}
+
+const listCompanyEmployers = `-- name: ListCompanyEmployers :many
+SELECT id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
+FROM employers
+WHERE company_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListCompanyEmployers(ctx context.Context, companyID int32) ([]Employer, error) {
+	rows, err := q.db.QueryContext(ctx, listCompanyEmployers, companyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Employer{}
+	for rows.Next() {
+		var i Employer
+		if err := rows.Scan(
+			&i.ID,
+			&i.CompanyID,
+			&i.FullName,
+			&i.Email,
+			&i.HashedPassword,
+			&i.CreatedAt,
+			&i.IsEmailVerified,
+			&i.Role,
+			&i.SuspendedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreEmployer = `-- name: RestoreEmployer :one
+UPDATE employers
+SET suspended_at = NULL
+WHERE id = $1
+RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
+`
+
+func (q *Queries) RestoreEmployer(ctx context.Context, id int32) (Employer, error) {
+	row := q.db.QueryRowContext(ctx, restoreEmployer, id)
+	var i Employer
+	err := row.Scan(
+		&i.ID,
+		&i.CompanyID,
+		&i.FullName,
+		&i.Email,
+		&i.HashedPassword,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.SuspendedAt,
+	)
+	return i, err
+}
+
+const searchEmployers = `-- name: SearchEmployers :many
+SELECT id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
+FROM employers
+WHERE email ILIKE '%' || $3::text || '%'
+   OR full_name ILIKE '%' || $3::text || '%'
+ORDER BY id
+LIMIT $1 OFFSET $2
+`
+
+type SearchEmployersParams struct {
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+	Search string `json:"search"`
+}
+
+func (q *Queries) SearchEmployers(ctx context.Context, arg SearchEmployersParams) ([]Employer, error) {
+	rows, err := q.db.QueryContext(ctx, searchEmployers, arg.Limit, arg.Offset, arg.Search)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Employer{}
+	for rows.Next() {
+		var i Employer
+		if err := rows.Scan(
+			&i.ID,
+			&i.CompanyID,
+			&i.FullName,
+			&i.Email,
+			&i.HashedPassword,
+			&i.CreatedAt,
+			&i.IsEmailVerified,
+			&i.Role,
+			&i.SuspendedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const suspendEmployer = `-- name: SuspendEmployer :one
+UPDATE employers
+SET suspended_at = now()
+WHERE id = $1
+RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
+`
+
+func (q *Queries) SuspendEmployer(ctx context.Context, id int32) (Employer, error) {
+	row := q.db.QueryRowContext(ctx, suspendEmployer, id)
+	var i Employer
+	err := row.Scan(
+		&i.ID,
+		&i.CompanyID,
+		&i.FullName,
+		&i.Email,
+		&i.HashedPassword,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
@@ -139,7 +315,7 @@ SET company_id = $2,
     full_name  = $3,
     email      = $4
 WHERE id = $1
-RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified
+RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
 `
 
 type UpdateEmployerParams struct {
@@ -165,6 +341,8 @@ func (q *Queries) UpdateEmployer(ctx context.Context, arg UpdateEmployerParams)
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
@@ -185,11 +363,40 @@ func (q *Queries) UpdateEmployerPassword(ctx context.Context, arg UpdateEmployer
 	return err
 }
 
+const updateEmployerRole = `-- name: UpdateEmployerRole :one
+UPDATE employers
+SET role = $2
+WHERE id = $1
+RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
+`
+
+type UpdateEmployerRoleParams struct {
+	ID   int32        `json:"id"`
+	Role EmployerRole `json:"role"`
+}
+
+func (q *Queries) UpdateEmployerRole(ctx context.Context, arg UpdateEmployerRoleParams) (Employer, error) {
+	row := q.db.QueryRowContext(ctx, updateEmployerRole, arg.ID, arg.Role)
+	var i Employer
+	err := row.Scan(
+		&i.ID,
+		&i.CompanyID,
+		&i.FullName,
+		&i.Email,
+		&i.HashedPassword,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.SuspendedAt,
+	)
+	return i, err
+}
+
 const verifyEmployerEmail = `-- name: VerifyEmployerEmail :one
 UPDATE employers
 SET is_email_verified = TRUE
 WHERE email = $1
-RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified
+RETURNING id, company_id, full_name, email, hashed_password, created_at, is_email_verified, role, suspended_at
 `
 
 func (q *Queries) VerifyEmployerEmail(ctx context.Context, email string) (Employer, error) {
@@ -203,6 +410,8 @@ func (q *Queries) VerifyEmployerEmail(ctx context.Context, email string) (Employ
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.Role,
+		&i.SuspendedAt,
 	)
 	return i, err
 }
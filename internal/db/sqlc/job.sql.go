@@ -7,9 +7,83 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
+const countJobsByCompanyExactName = `-- name: CountJobsByCompanyExactName :one
+SELECT count(*)
+FROM jobs j
+         JOIN companies c ON j.company_id = c.id
+WHERE c.name = $1
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+`
+
+func (q *Queries) CountJobsByCompanyExactName(ctx context.Context, name string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countJobsByCompanyExactName, name)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countJobsByCompanyID = `-- name: CountJobsByCompanyID :one
+SELECT count(*)
+FROM jobs j
+         JOIN companies c ON j.company_id = c.id
+WHERE j.company_id = $1
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+`
+
+func (q *Queries) CountJobsByCompanyID(ctx context.Context, companyID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countJobsByCompanyID, companyID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countJobsByCompanyName = `-- name: CountJobsByCompanyName :one
+SELECT count(*)
+FROM jobs j
+         JOIN companies c ON j.company_id = c.id
+WHERE c.name ILIKE '%' || $1::text || '%'
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+`
+
+func (q *Queries) CountJobsByCompanyName(ctx context.Context, name string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countJobsByCompanyName, name)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countJobsForEmployer = `-- name: CountJobsForEmployer :one
+SELECT count(*)
+FROM jobs
+WHERE company_id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) CountJobsForEmployer(ctx context.Context, companyID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countJobsForEmployer, companyID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createJob = `-- name: CreateJob :one
 INSERT INTO jobs (title,
                   industry,
@@ -18,20 +92,36 @@ INSERT INTO jobs (title,
                   location,
                   salary_min,
                   salary_max,
-                  requirements)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at
+                  requirements,
+                  status,
+                  expires_at,
+                  employment_type,
+                  work_mode,
+                  seniority_level,
+                  salary_currency,
+                  salary_period,
+                  publish_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
 `
 
 type CreateJobParams struct {
-	Title        string `json:"title"`
-	Industry     string `json:"industry"`
-	CompanyID    int32  `json:"company_id"`
-	Description  string `json:"description"`
-	Location     string `json:"location"`
-	SalaryMin    int32  `json:"salary_min"`
-	SalaryMax    int32  `json:"salary_max"`
-	Requirements string `json:"requirements"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	CompanyID      int32          `json:"company_id"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	Requirements   string         `json:"requirements"`
+	Status         JobStatus      `json:"status"`
+	ExpiresAt      sql.NullTime   `json:"expires_at"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	PublishAt      sql.NullTime   `json:"publish_at"`
 }
 
 func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (Job, error) {
@@ -44,6 +134,14 @@ func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (Job, erro
 		arg.SalaryMin,
 		arg.SalaryMax,
 		arg.Requirements,
+		arg.Status,
+		arg.ExpiresAt,
+		arg.EmploymentType,
+		arg.WorkMode,
+		arg.SeniorityLevel,
+		arg.SalaryCurrency,
+		arg.SalaryPeriod,
+		arg.PublishAt,
 	)
 	var i Job
 	err := row.Scan(
@@ -57,13 +155,24 @@ func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (Job, erro
 		&i.SalaryMax,
 		&i.Requirements,
 		&i.CreatedAt,
+		&i.UnpublishedAt,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.DeletedAt,
+		&i.EmploymentType,
+		&i.WorkMode,
+		&i.SeniorityLevel,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.PublishAt,
+		&i.ExpiryNotifiedAt,
 	)
 	return i, err
 }
 
 const deleteJob = `-- name: DeleteJob :exec
-DELETE
-FROM jobs
+UPDATE jobs
+SET deleted_at = now()
 WHERE id = $1
 `
 
@@ -72,6 +181,168 @@ func (q *Queries) DeleteJob(ctx context.Context, id int32) error {
 	return err
 }
 
+const expireJobs = `-- name: ExpireJobs :many
+UPDATE jobs
+SET status = 'expired'
+WHERE status = 'published'
+  AND expires_at <= $1::timestamptz
+  AND deleted_at IS NULL
+RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
+`
+
+func (q *Queries) ExpireJobs(ctx context.Context, now time.Time) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, expireJobs, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Industry,
+			&i.CompanyID,
+			&i.Description,
+			&i.Location,
+			&i.SalaryMin,
+			&i.SalaryMax,
+			&i.Requirements,
+			&i.CreatedAt,
+			&i.UnpublishedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.DeletedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.PublishAt,
+			&i.ExpiryNotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const exportJobsForEmployer = `-- name: ExportJobsForEmployer :many
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.status,
+       j.expires_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
+       COALESCE((SELECT array_agg(js.skill ORDER BY js.skill)
+                 FROM job_skills js
+                 WHERE js.job_id = j.id), '{}')::text[] AS required_skills,
+       (SELECT count(*)
+        FROM job_applications ja
+        WHERE ja.job_id = j.id)                       AS applications
+FROM jobs j
+WHERE j.company_id = $1
+  AND j.deleted_at IS NULL
+  -- keyset pagination, the export is read page by page
+  AND ($3::timestamptz IS NULL
+    OR (j.created_at, j.id) < ($3::timestamptz, $4::int))
+ORDER BY j.created_at DESC, j.id DESC
+LIMIT $2
+`
+
+type ExportJobsForEmployerParams struct {
+	CompanyID       int32        `json:"company_id"`
+	Limit           int32        `json:"limit"`
+	CursorCreatedAt sql.NullTime `json:"cursor_created_at"`
+	CursorID        int32        `json:"cursor_id"`
+}
+
+type ExportJobsForEmployerRow struct {
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	Requirements   string         `json:"requirements"`
+	CreatedAt      time.Time      `json:"created_at"`
+	Status         JobStatus      `json:"status"`
+	ExpiresAt      sql.NullTime   `json:"expires_at"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	RequiredSkills []string       `json:"required_skills"`
+	Applications   int64          `json:"applications"`
+}
+
+func (q *Queries) ExportJobsForEmployer(ctx context.Context, arg ExportJobsForEmployerParams) ([]ExportJobsForEmployerRow, error) {
+	rows, err := q.db.QueryContext(ctx, exportJobsForEmployer,
+		arg.CompanyID,
+		arg.Limit,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExportJobsForEmployerRow{}
+	for rows.Next() {
+		var i ExportJobsForEmployerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Industry,
+			&i.Description,
+			&i.Location,
+			&i.SalaryMin,
+			&i.SalaryMax,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.Requirements,
+			&i.CreatedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			pq.Array(&i.RequiredSkills),
+			&i.Applications,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCompanyIDOfJob = `-- name: GetCompanyIDOfJob :one
 SELECT company_id
 FROM jobs
@@ -86,9 +357,10 @@ func (q *Queries) GetCompanyIDOfJob(ctx context.Context, id int32) (int32, error
 }
 
 const getJob = `-- name: GetJob :one
-SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at
+SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
 FROM jobs
 WHERE id = $1
+  AND deleted_at IS NULL
 `
 
 func (q *Queries) GetJob(ctx context.Context, id int32) (Job, error) {
@@ -105,6 +377,17 @@ func (q *Queries) GetJob(ctx context.Context, id int32) (Job, error) {
 		&i.SalaryMax,
 		&i.Requirements,
 		&i.CreatedAt,
+		&i.UnpublishedAt,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.DeletedAt,
+		&i.EmploymentType,
+		&i.WorkMode,
+		&i.SeniorityLevel,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.PublishAt,
+		&i.ExpiryNotifiedAt,
 	)
 	return i, err
 }
@@ -131,7 +414,23 @@ func (q *Queries) GetJobBasicInfo(ctx context.Context, id int32) (GetJobBasicInf
 }
 
 const getJobDetails = `-- name: GetJobDetails :one
-SELECT j.id, j.title, j.industry, j.company_id, j.description, j.location, j.salary_min, j.salary_max, j.requirements, j.created_at,
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.company_id,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.status,
+       j.expires_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
        c.name      AS company_name,
        c.location  AS company_location,
        c.industry  AS company_industry,
@@ -140,27 +439,44 @@ SELECT j.id, j.title, j.industry, j.company_id, j.description, j.location, j.sal
        e.full_name AS employer_full_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
-         JOIN employers e ON c.id = e.company_id
+         -- a company can have several employers, the job is presented by its first owner
+         JOIN LATERAL (SELECT id, email, full_name
+                       FROM employers
+                       WHERE company_id = c.id
+                         AND role = 'owner'
+                       ORDER BY id
+                       LIMIT 1) e ON true
 WHERE j.id = $1
+  AND j.status <> 'draft'
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
 `
 
 type GetJobDetailsRow struct {
-	ID               int32     `json:"id"`
-	Title            string    `json:"title"`
-	Industry         string    `json:"industry"`
-	CompanyID        int32     `json:"company_id"`
-	Description      string    `json:"description"`
-	Location         string    `json:"location"`
-	SalaryMin        int32     `json:"salary_min"`
-	SalaryMax        int32     `json:"salary_max"`
-	Requirements     string    `json:"requirements"`
-	CreatedAt        time.Time `json:"created_at"`
-	CompanyName      string    `json:"company_name"`
-	CompanyLocation  string    `json:"company_location"`
-	CompanyIndustry  string    `json:"company_industry"`
-	EmployerID       int32     `json:"employer_id"`
-	EmployerEmail    string    `json:"employer_email"`
-	EmployerFullName string    `json:"employer_full_name"`
+	ID               int32          `json:"id"`
+	Title            string         `json:"title"`
+	Industry         string         `json:"industry"`
+	CompanyID        int32          `json:"company_id"`
+	Description      string         `json:"description"`
+	Location         string         `json:"location"`
+	SalaryMin        int32          `json:"salary_min"`
+	SalaryMax        int32          `json:"salary_max"`
+	SalaryCurrency   string         `json:"salary_currency"`
+	SalaryPeriod     SalaryPeriod   `json:"salary_period"`
+	Requirements     string         `json:"requirements"`
+	CreatedAt        time.Time      `json:"created_at"`
+	Status           JobStatus      `json:"status"`
+	ExpiresAt        sql.NullTime   `json:"expires_at"`
+	EmploymentType   EmploymentType `json:"employment_type"`
+	WorkMode         WorkMode       `json:"work_mode"`
+	SeniorityLevel   SeniorityLevel `json:"seniority_level"`
+	CompanyName      string         `json:"company_name"`
+	CompanyLocation  string         `json:"company_location"`
+	CompanyIndustry  string         `json:"company_industry"`
+	EmployerID       int32          `json:"employer_id"`
+	EmployerEmail    string         `json:"employer_email"`
+	EmployerFullName string         `json:"employer_full_name"`
 }
 
 func (q *Queries) GetJobDetails(ctx context.Context, id int32) (GetJobDetailsRow, error) {
@@ -175,8 +491,15 @@ func (q *Queries) GetJobDetails(ctx context.Context, id int32) (GetJobDetailsRow
 		&i.Location,
 		&i.SalaryMin,
 		&i.SalaryMax,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
 		&i.Requirements,
 		&i.CreatedAt,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.EmploymentType,
+		&i.WorkMode,
+		&i.SeniorityLevel,
 		&i.CompanyName,
 		&i.CompanyLocation,
 		&i.CompanyIndustry,
@@ -196,21 +519,39 @@ SELECT j.id,
        c.name AS company_name,
        j.salary_min,
        j.salary_max,
-       j.requirements
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.status,
+       j.expires_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
+WHERE j.status = 'published'
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
 `
 
 type ListAllJobsForESRow struct {
-	ID           int32  `json:"id"`
-	Title        string `json:"title"`
-	Industry     string `json:"industry"`
-	Location     string `json:"location"`
-	Description  string `json:"description"`
-	CompanyName  string `json:"company_name"`
-	SalaryMin    int32  `json:"salary_min"`
-	SalaryMax    int32  `json:"salary_max"`
-	Requirements string `json:"requirements"`
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	Location       string         `json:"location"`
+	Description    string         `json:"description"`
+	CompanyName    string         `json:"company_name"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	Requirements   string         `json:"requirements"`
+	Status         JobStatus      `json:"status"`
+	ExpiresAt      sql.NullTime   `json:"expires_at"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
 }
 
 func (q *Queries) ListAllJobsForES(ctx context.Context) ([]ListAllJobsForESRow, error) {
@@ -231,7 +572,68 @@ func (q *Queries) ListAllJobsForES(ctx context.Context) ([]ListAllJobsForESRow,
 			&i.CompanyName,
 			&i.SalaryMin,
 			&i.SalaryMax,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
 			&i.Requirements,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompanyJobsForES = `-- name: ListCompanyJobsForES :many
+SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
+FROM jobs
+WHERE company_id = $1
+  AND status = 'published'
+  AND deleted_at IS NULL
+  AND unpublished_at IS NULL
+`
+
+func (q *Queries) ListCompanyJobsForES(ctx context.Context, companyID int32) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, listCompanyJobsForES, companyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Industry,
+			&i.CompanyID,
+			&i.Description,
+			&i.Location,
+			&i.SalaryMin,
+			&i.SalaryMax,
+			&i.Requirements,
+			&i.CreatedAt,
+			&i.UnpublishedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.DeletedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.PublishAt,
+			&i.ExpiryNotifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -247,36 +649,98 @@ func (q *Queries) ListAllJobsForES(ctx context.Context) ([]ListAllJobsForESRow,
 }
 
 const listJobsByCompanyExactName = `-- name: ListJobsByCompanyExactName :many
-SELECT j.id, j.title, j.industry, j.company_id, j.description, j.location, j.salary_min, j.salary_max, j.requirements, j.created_at,
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.company_id,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
        c.name AS company_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
 WHERE c.name = $1
-LIMIT $2 OFFSET $3
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+  -- keyset pagination, only jobs after the cursor in the requested order,
+  -- the cursor keeps the salary or the company name of its job as they were on the previous page
+  AND ($3::timestamptz IS NULL
+    OR $4::text = 'newest' AND (j.created_at, j.id) < ($3::timestamptz, $5::int)
+    OR $4::text = 'oldest' AND (j.created_at, j.id) > ($3::timestamptz, $5::int)
+    OR $4::text = 'salary-desc' AND (normalize_salary(j.salary_max, j.salary_currency, j.salary_period), j.id) <
+                                       (normalize_salary($6::int,
+                                                         $7::char(3),
+                                                         $8::salary_period), $5::int)
+    OR $4::text = 'salary-asc' AND (normalize_salary(j.salary_min, j.salary_currency, j.salary_period), j.id) >
+                                      (normalize_salary($6::int,
+                                                        $7::char(3),
+                                                        $8::salary_period), $5::int)
+    OR $4::text = 'company' AND (c.name, j.id) > ($9::text, $5::int))
+ORDER BY CASE WHEN $4::text = 'newest' THEN j.created_at END DESC,
+         CASE WHEN $4::text = 'oldest' THEN j.created_at END ASC,
+         CASE WHEN $4::text = 'salary-desc' THEN normalize_salary(j.salary_max, j.salary_currency, j.salary_period) END DESC,
+         CASE WHEN $4::text = 'salary-asc' THEN normalize_salary(j.salary_min, j.salary_currency, j.salary_period) END ASC,
+         CASE WHEN $4::text = 'company' THEN c.name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN $4::text IN ('newest', 'salary-desc') THEN j.id END DESC,
+         CASE WHEN $4::text IN ('oldest', 'salary-asc', 'company') THEN j.id END ASC
+LIMIT $2
 `
 
 type ListJobsByCompanyExactNameParams struct {
-	Name   string `json:"name"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Name                 string           `json:"name"`
+	Limit                int32            `json:"limit"`
+	CursorCreatedAt      sql.NullTime     `json:"cursor_created_at"`
+	Sort                 string           `json:"sort"`
+	CursorID             int32            `json:"cursor_id"`
+	CursorSalary         sql.NullInt32    `json:"cursor_salary"`
+	CursorSalaryCurrency sql.NullString   `json:"cursor_salary_currency"`
+	CursorSalaryPeriod   NullSalaryPeriod `json:"cursor_salary_period"`
+	CursorCompanyName    sql.NullString   `json:"cursor_company_name"`
 }
 
 type ListJobsByCompanyExactNameRow struct {
-	ID           int32     `json:"id"`
-	Title        string    `json:"title"`
-	Industry     string    `json:"industry"`
-	CompanyID    int32     `json:"company_id"`
-	Description  string    `json:"description"`
-	Location     string    `json:"location"`
-	SalaryMin    int32     `json:"salary_min"`
-	SalaryMax    int32     `json:"salary_max"`
-	Requirements string    `json:"requirements"`
-	CreatedAt    time.Time `json:"created_at"`
-	CompanyName  string    `json:"company_name"`
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	CompanyID      int32          `json:"company_id"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	Requirements   string         `json:"requirements"`
+	CreatedAt      time.Time      `json:"created_at"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	CompanyName    string         `json:"company_name"`
 }
 
 func (q *Queries) ListJobsByCompanyExactName(ctx context.Context, arg ListJobsByCompanyExactNameParams) ([]ListJobsByCompanyExactNameRow, error) {
-	rows, err := q.db.QueryContext(ctx, listJobsByCompanyExactName, arg.Name, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listJobsByCompanyExactName,
+		arg.Name,
+		arg.Limit,
+		arg.CursorCreatedAt,
+		arg.Sort,
+		arg.CursorID,
+		arg.CursorSalary,
+		arg.CursorSalaryCurrency,
+		arg.CursorSalaryPeriod,
+		arg.CursorCompanyName,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -293,8 +757,13 @@ func (q *Queries) ListJobsByCompanyExactName(ctx context.Context, arg ListJobsBy
 			&i.Location,
 			&i.SalaryMin,
 			&i.SalaryMax,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
 			&i.CompanyName,
 		); err != nil {
 			return nil, err
@@ -311,36 +780,98 @@ func (q *Queries) ListJobsByCompanyExactName(ctx context.Context, arg ListJobsBy
 }
 
 const listJobsByCompanyID = `-- name: ListJobsByCompanyID :many
-SELECT j.id, j.title, j.industry, j.company_id, j.description, j.location, j.salary_min, j.salary_max, j.requirements, j.created_at,
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.company_id,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
        c.name AS company_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
 WHERE j.company_id = $1
-LIMIT $2 OFFSET $3
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+  -- keyset pagination, only jobs after the cursor in the requested order,
+  -- the cursor keeps the salary or the company name of its job as they were on the previous page
+  AND ($3::timestamptz IS NULL
+    OR $4::text = 'newest' AND (j.created_at, j.id) < ($3::timestamptz, $5::int)
+    OR $4::text = 'oldest' AND (j.created_at, j.id) > ($3::timestamptz, $5::int)
+    OR $4::text = 'salary-desc' AND (normalize_salary(j.salary_max, j.salary_currency, j.salary_period), j.id) <
+                                       (normalize_salary($6::int,
+                                                         $7::char(3),
+                                                         $8::salary_period), $5::int)
+    OR $4::text = 'salary-asc' AND (normalize_salary(j.salary_min, j.salary_currency, j.salary_period), j.id) >
+                                      (normalize_salary($6::int,
+                                                        $7::char(3),
+                                                        $8::salary_period), $5::int)
+    OR $4::text = 'company' AND (c.name, j.id) > ($9::text, $5::int))
+ORDER BY CASE WHEN $4::text = 'newest' THEN j.created_at END DESC,
+         CASE WHEN $4::text = 'oldest' THEN j.created_at END ASC,
+         CASE WHEN $4::text = 'salary-desc' THEN normalize_salary(j.salary_max, j.salary_currency, j.salary_period) END DESC,
+         CASE WHEN $4::text = 'salary-asc' THEN normalize_salary(j.salary_min, j.salary_currency, j.salary_period) END ASC,
+         CASE WHEN $4::text = 'company' THEN c.name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN $4::text IN ('newest', 'salary-desc') THEN j.id END DESC,
+         CASE WHEN $4::text IN ('oldest', 'salary-asc', 'company') THEN j.id END ASC
+LIMIT $2
 `
 
 type ListJobsByCompanyIDParams struct {
-	CompanyID int32 `json:"company_id"`
-	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
+	CompanyID            int32            `json:"company_id"`
+	Limit                int32            `json:"limit"`
+	CursorCreatedAt      sql.NullTime     `json:"cursor_created_at"`
+	Sort                 string           `json:"sort"`
+	CursorID             int32            `json:"cursor_id"`
+	CursorSalary         sql.NullInt32    `json:"cursor_salary"`
+	CursorSalaryCurrency sql.NullString   `json:"cursor_salary_currency"`
+	CursorSalaryPeriod   NullSalaryPeriod `json:"cursor_salary_period"`
+	CursorCompanyName    sql.NullString   `json:"cursor_company_name"`
 }
 
 type ListJobsByCompanyIDRow struct {
-	ID           int32     `json:"id"`
-	Title        string    `json:"title"`
-	Industry     string    `json:"industry"`
-	CompanyID    int32     `json:"company_id"`
-	Description  string    `json:"description"`
-	Location     string    `json:"location"`
-	SalaryMin    int32     `json:"salary_min"`
-	SalaryMax    int32     `json:"salary_max"`
-	Requirements string    `json:"requirements"`
-	CreatedAt    time.Time `json:"created_at"`
-	CompanyName  string    `json:"company_name"`
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	CompanyID      int32          `json:"company_id"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	Requirements   string         `json:"requirements"`
+	CreatedAt      time.Time      `json:"created_at"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	CompanyName    string         `json:"company_name"`
 }
 
 func (q *Queries) ListJobsByCompanyID(ctx context.Context, arg ListJobsByCompanyIDParams) ([]ListJobsByCompanyIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listJobsByCompanyID, arg.CompanyID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listJobsByCompanyID,
+		arg.CompanyID,
+		arg.Limit,
+		arg.CursorCreatedAt,
+		arg.Sort,
+		arg.CursorID,
+		arg.CursorSalary,
+		arg.CursorSalaryCurrency,
+		arg.CursorSalaryPeriod,
+		arg.CursorCompanyName,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -357,8 +888,13 @@ func (q *Queries) ListJobsByCompanyID(ctx context.Context, arg ListJobsByCompany
 			&i.Location,
 			&i.SalaryMin,
 			&i.SalaryMax,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
 			&i.CompanyName,
 		); err != nil {
 			return nil, err
@@ -375,36 +911,98 @@ func (q *Queries) ListJobsByCompanyID(ctx context.Context, arg ListJobsByCompany
 }
 
 const listJobsByCompanyName = `-- name: ListJobsByCompanyName :many
-SELECT j.id, j.title, j.industry, j.company_id, j.description, j.location, j.salary_min, j.salary_max, j.requirements, j.created_at,
+SELECT j.id,
+       j.title,
+       j.industry,
+       j.company_id,
+       j.description,
+       j.location,
+       j.salary_min,
+       j.salary_max,
+       j.salary_currency,
+       j.salary_period,
+       j.requirements,
+       j.created_at,
+       j.employment_type,
+       j.work_mode,
+       j.seniority_level,
        c.name AS company_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
-WHERE c.name ILIKE '%' || $3::text || '%'
-LIMIT $1 OFFSET $2
+WHERE c.name ILIKE '%' || @name::text || '%'
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL
+  -- keyset pagination, only jobs after the cursor in the requested order,
+  -- the cursor keeps the salary or the company name of its job as they were on the previous page
+  AND ($3::timestamptz IS NULL
+    OR $4::text = 'newest' AND (j.created_at, j.id) < ($3::timestamptz, $5::int)
+    OR $4::text = 'oldest' AND (j.created_at, j.id) > ($3::timestamptz, $5::int)
+    OR $4::text = 'salary-desc' AND (normalize_salary(j.salary_max, j.salary_currency, j.salary_period), j.id) <
+                                       (normalize_salary($6::int,
+                                                         $7::char(3),
+                                                         $8::salary_period), $5::int)
+    OR $4::text = 'salary-asc' AND (normalize_salary(j.salary_min, j.salary_currency, j.salary_period), j.id) >
+                                      (normalize_salary($6::int,
+                                                        $7::char(3),
+                                                        $8::salary_period), $5::int)
+    OR $4::text = 'company' AND (c.name, j.id) > ($9::text, $5::int))
+ORDER BY CASE WHEN $4::text = 'newest' THEN j.created_at END DESC,
+         CASE WHEN $4::text = 'oldest' THEN j.created_at END ASC,
+         CASE WHEN $4::text = 'salary-desc' THEN normalize_salary(j.salary_max, j.salary_currency, j.salary_period) END DESC,
+         CASE WHEN $4::text = 'salary-asc' THEN normalize_salary(j.salary_min, j.salary_currency, j.salary_period) END ASC,
+         CASE WHEN $4::text = 'company' THEN c.name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN $4::text IN ('newest', 'salary-desc') THEN j.id END DESC,
+         CASE WHEN $4::text IN ('oldest', 'salary-asc', 'company') THEN j.id END ASC
+LIMIT $1
 `
 
 type ListJobsByCompanyNameParams struct {
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
-	Name   string `json:"name"`
+	Limit                int32            `json:"limit"`
+	Name                 string           `json:"name"`
+	CursorCreatedAt      sql.NullTime     `json:"cursor_created_at"`
+	Sort                 string           `json:"sort"`
+	CursorID             int32            `json:"cursor_id"`
+	CursorSalary         sql.NullInt32    `json:"cursor_salary"`
+	CursorSalaryCurrency sql.NullString   `json:"cursor_salary_currency"`
+	CursorSalaryPeriod   NullSalaryPeriod `json:"cursor_salary_period"`
+	CursorCompanyName    sql.NullString   `json:"cursor_company_name"`
 }
 
 type ListJobsByCompanyNameRow struct {
-	ID           int32     `json:"id"`
-	Title        string    `json:"title"`
-	Industry     string    `json:"industry"`
-	CompanyID    int32     `json:"company_id"`
-	Description  string    `json:"description"`
-	Location     string    `json:"location"`
-	SalaryMin    int32     `json:"salary_min"`
-	SalaryMax    int32     `json:"salary_max"`
-	Requirements string    `json:"requirements"`
-	CreatedAt    time.Time `json:"created_at"`
-	CompanyName  string    `json:"company_name"`
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	CompanyID      int32          `json:"company_id"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	Requirements   string         `json:"requirements"`
+	CreatedAt      time.Time      `json:"created_at"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	CompanyName    string         `json:"company_name"`
 }
 
 func (q *Queries) ListJobsByCompanyName(ctx context.Context, arg ListJobsByCompanyNameParams) ([]ListJobsByCompanyNameRow, error) {
-	rows, err := q.db.QueryContext(ctx, listJobsByCompanyName, arg.Limit, arg.Offset, arg.Name)
+	rows, err := q.db.QueryContext(ctx, listJobsByCompanyName,
+		arg.Limit,
+		arg.Name,
+		arg.CursorCreatedAt,
+		arg.Sort,
+		arg.CursorID,
+		arg.CursorSalary,
+		arg.CursorSalaryCurrency,
+		arg.CursorSalaryPeriod,
+		arg.CursorCompanyName,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -421,8 +1019,13 @@ func (q *Queries) ListJobsByCompanyName(ctx context.Context, arg ListJobsByCompa
 			&i.Location,
 			&i.SalaryMin,
 			&i.SalaryMax,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
 			&i.CompanyName,
 		); err != nil {
 			return nil, err
@@ -439,9 +1042,10 @@ func (q *Queries) ListJobsByCompanyName(ctx context.Context, arg ListJobsByCompa
 }
 
 const listJobsByIndustry = `-- name: ListJobsByIndustry :many
-SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at
+SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
 FROM jobs
 WHERE industry = $1
+  AND deleted_at IS NULL
 LIMIT $2 OFFSET $3
 `
 
@@ -471,6 +1075,17 @@ func (q *Queries) ListJobsByIndustry(ctx context.Context, arg ListJobsByIndustry
 			&i.SalaryMax,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.UnpublishedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.DeletedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.PublishAt,
+			&i.ExpiryNotifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -486,9 +1101,10 @@ func (q *Queries) ListJobsByIndustry(ctx context.Context, arg ListJobsByIndustry
 }
 
 const listJobsByLocation = `-- name: ListJobsByLocation :many
-SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at
+SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
 FROM jobs
 WHERE location = $1
+  AND deleted_at IS NULL
 LIMIT $2 OFFSET $3
 `
 
@@ -518,6 +1134,17 @@ func (q *Queries) ListJobsByLocation(ctx context.Context, arg ListJobsByLocation
 			&i.SalaryMax,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.UnpublishedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.DeletedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.PublishAt,
+			&i.ExpiryNotifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -533,26 +1160,33 @@ func (q *Queries) ListJobsByLocation(ctx context.Context, arg ListJobsByLocation
 }
 
 const listJobsBySalaryRange = `-- name: ListJobsBySalaryRange :many
-SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at
+SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
 FROM jobs
-WHERE salary_min >= $1
-  AND salary_max <= $2
-LIMIT $3 OFFSET $4
+WHERE normalize_salary(salary_min, salary_currency, salary_period) >=
+      normalize_salary($3::int, $4::char(3), $5::salary_period)
+  AND normalize_salary(salary_max, salary_currency, salary_period) <=
+      normalize_salary($6::int, $4::char(3), $5::salary_period)
+  AND deleted_at IS NULL
+LIMIT $1 OFFSET $2
 `
 
 type ListJobsBySalaryRangeParams struct {
-	SalaryMin int32 `json:"salary_min"`
-	SalaryMax int32 `json:"salary_max"`
-	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
+	Limit          int32        `json:"limit"`
+	Offset         int32        `json:"offset"`
+	SalaryMin      int32        `json:"salary_min"`
+	SalaryCurrency string       `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod `json:"salary_period"`
+	SalaryMax      int32        `json:"salary_max"`
 }
 
 func (q *Queries) ListJobsBySalaryRange(ctx context.Context, arg ListJobsBySalaryRangeParams) ([]Job, error) {
 	rows, err := q.db.QueryContext(ctx, listJobsBySalaryRange,
-		arg.SalaryMin,
-		arg.SalaryMax,
 		arg.Limit,
 		arg.Offset,
+		arg.SalaryMin,
+		arg.SalaryCurrency,
+		arg.SalaryPeriod,
+		arg.SalaryMax,
 	)
 	if err != nil {
 		return nil, err
@@ -572,6 +1206,17 @@ func (q *Queries) ListJobsBySalaryRange(ctx context.Context, arg ListJobsBySalar
 			&i.SalaryMax,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.UnpublishedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.DeletedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.PublishAt,
+			&i.ExpiryNotifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -587,9 +1232,10 @@ func (q *Queries) ListJobsBySalaryRange(ctx context.Context, arg ListJobsBySalar
 }
 
 const listJobsByTitle = `-- name: ListJobsByTitle :many
-SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at
+SELECT id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
 FROM jobs
 WHERE title ILIKE '%' || $3::text || '%'
+  AND deleted_at IS NULL
 LIMIT $1 OFFSET $2
 `
 
@@ -619,6 +1265,17 @@ func (q *Queries) ListJobsByTitle(ctx context.Context, arg ListJobsByTitleParams
 			&i.SalaryMax,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.UnpublishedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.DeletedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.PublishAt,
+			&i.ExpiryNotifiedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -641,40 +1298,60 @@ SELECT id,
        location,
        salary_min,
        salary_max,
-       created_at
+       salary_currency,
+       salary_period,
+       created_at,
+       status,
+       employment_type,
+       work_mode,
+       seniority_level
 FROM jobs
 WHERE company_id = $1
+  AND deleted_at IS NULL
+  -- keyset pagination, only jobs after the cursor in the requested order
+  AND ($3::timestamptz IS NULL
+    OR $4::bool AND (created_at, id) > ($3::timestamptz, $5::int)
+    OR $6::bool AND (created_at, id) < ($3::timestamptz, $5::int))
 ORDER BY CASE WHEN $4::bool THEN created_at END ASC,
-         CASE WHEN $5::bool THEN created_at END DESC,
-         created_at DESC
-LIMIT $2 OFFSET $3
+         CASE WHEN $4::bool THEN id END ASC,
+         CASE WHEN $6::bool THEN created_at END DESC,
+         CASE WHEN $6::bool THEN id END DESC
+LIMIT $2
 `
 
 type ListJobsForEmployerParams struct {
-	CompanyID     int32 `json:"company_id"`
-	Limit         int32 `json:"limit"`
-	Offset        int32 `json:"offset"`
-	CreatedAtAsc  bool  `json:"created_at_asc"`
-	CreatedAtDesc bool  `json:"created_at_desc"`
+	CompanyID       int32        `json:"company_id"`
+	Limit           int32        `json:"limit"`
+	CursorCreatedAt sql.NullTime `json:"cursor_created_at"`
+	CreatedAtAsc    bool         `json:"created_at_asc"`
+	CursorID        int32        `json:"cursor_id"`
+	CreatedAtDesc   bool         `json:"created_at_desc"`
 }
 
 type ListJobsForEmployerRow struct {
-	ID          int32     `json:"id"`
-	Title       string    `json:"title"`
-	Industry    string    `json:"industry"`
-	Description string    `json:"description"`
-	Location    string    `json:"location"`
-	SalaryMin   int32     `json:"salary_min"`
-	SalaryMax   int32     `json:"salary_max"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	CreatedAt      time.Time      `json:"created_at"`
+	Status         JobStatus      `json:"status"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
 }
 
 func (q *Queries) ListJobsForEmployer(ctx context.Context, arg ListJobsForEmployerParams) ([]ListJobsForEmployerRow, error) {
 	rows, err := q.db.QueryContext(ctx, listJobsForEmployer,
 		arg.CompanyID,
 		arg.Limit,
-		arg.Offset,
+		arg.CursorCreatedAt,
 		arg.CreatedAtAsc,
+		arg.CursorID,
 		arg.CreatedAtDesc,
 	)
 	if err != nil {
@@ -692,7 +1369,13 @@ func (q *Queries) ListJobsForEmployer(ctx context.Context, arg ListJobsForEmploy
 			&i.Location,
 			&i.SalaryMin,
 			&i.SalaryMax,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
 			&i.CreatedAt,
+			&i.Status,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
 		); err != nil {
 			return nil, err
 		}
@@ -708,40 +1391,151 @@ func (q *Queries) ListJobsForEmployer(ctx context.Context, arg ListJobsForEmploy
 }
 
 const listJobsMatchingUserSkills = `-- name: ListJobsMatchingUserSkills :many
-SELECT j.id, j.title, j.industry, j.company_id, j.description, j.location, j.salary_min, j.salary_max, j.requirements, j.created_at,
-       c.name AS company_name
-FROM jobs j
-         JOIN companies c ON j.company_id = c.id
-WHERE j.id IN (SELECT job_id
-               FROM job_skills
-               WHERE skill IN (SELECT skill
-                               FROM user_skills
-                               WHERE user_id = $1))
-LIMIT $2 OFFSET $3
+SELECT m.id,
+       m.title,
+       m.industry,
+       m.company_id,
+       m.description,
+       m.location,
+       m.salary_min,
+       m.salary_max,
+       m.salary_currency,
+       m.salary_period,
+       m.requirements,
+       m.created_at,
+       m.employment_type,
+       m.work_mode,
+       m.seniority_level,
+       m.company_name,
+       m.matched_skills,
+       m.total_skills,
+       m.skills_score,
+       m.title_score,
+       m.industry_score,
+       m.location_score,
+       m.salary_score,
+       (m.skills_score + m.title_score + m.industry_score + m.location_score + m.salary_score)::float8 AS match_score
+FROM (SELECT j.id,
+             j.title,
+             j.industry,
+             j.company_id,
+             j.description,
+             j.location,
+             j.salary_min,
+             j.salary_max,
+             j.salary_currency,
+             j.salary_period,
+             j.requirements,
+             j.created_at,
+             j.employment_type,
+             j.work_mode,
+             j.seniority_level,
+             c.name                                                                      AS company_name,
+             s.matched_skills,
+             s.total_skills,
+             -- every skill of the job the user has counts half, the other half grows with the years
+             -- of experience up to 5, so a job is scored by the share of its skills the user has
+             (50 * s.skills_weight / s.total_skills)::float8                             AS skills_score,
+             (CASE
+                  WHEN u.desired_job_title <> '' AND
+                       strpos(lower(j.title), lower(u.desired_job_title)) > 0 THEN 20
+                  ELSE 0 END)::float8                                                    AS title_score,
+             (CASE
+                  WHEN u.desired_industry <> '' AND lower(j.industry) = lower(u.desired_industry) THEN 10
+                  ELSE 0 END)::float8                                                    AS industry_score,
+             -- remote jobs match any location
+             (CASE
+                  WHEN j.work_mode = 'remote' THEN 10
+                  WHEN u.location <> '' AND lower(j.location) = lower(u.location) THEN 10
+                  ELSE 0 END)::float8                                                    AS location_score,
+             -- the share of the desired salary range the job can pay, both converted to USD per year
+             (CASE
+                  WHEN normalize_salary(u.desired_salary_max, u.desired_salary_currency, u.desired_salary_period) <=
+                       normalize_salary(u.desired_salary_min, u.desired_salary_currency, u.desired_salary_period)
+                      THEN 10
+                  ELSE 10 * least(1, (normalize_salary(j.salary_max, j.salary_currency, j.salary_period) -
+                                      normalize_salary(u.desired_salary_min, u.desired_salary_currency,
+                                                       u.desired_salary_period)) /
+                                     (normalize_salary(u.desired_salary_max, u.desired_salary_currency,
+                                                       u.desired_salary_period) -
+                                      normalize_salary(u.desired_salary_min, u.desired_salary_currency,
+                                                       u.desired_salary_period)))
+                 END)::float8                                                            AS salary_score
+      FROM jobs j
+               JOIN companies c ON j.company_id = c.id
+               JOIN users u ON u.id = $1
+               JOIN LATERAL (SELECT count(*)                                                   AS total_skills,
+                                    count(us.id)                                               AS matched_skills,
+                                    COALESCE(sum(0.5 + 0.5 * least(us.experience, 5) / 5.0), 0) AS skills_weight
+                             FROM job_skills js
+                                      LEFT JOIN user_skills us ON us.skill = js.skill AND us.user_id = u.id
+                             WHERE js.job_id = j.id) s ON true
+      WHERE s.matched_skills > 0
+        -- the job pays at least the desired minimum of the user, both converted to USD per year
+        AND normalize_salary(j.salary_max, j.salary_currency, j.salary_period) >=
+            normalize_salary(u.desired_salary_min, u.desired_salary_currency, u.desired_salary_period)
+        AND j.status = 'published'
+        AND (j.expires_at IS NULL OR j.expires_at > now())
+        AND j.deleted_at IS NULL
+        AND j.unpublished_at IS NULL
+        AND c.suspended_at IS NULL) m
+ORDER BY CASE
+             WHEN $2::text = 'score'
+                 THEN m.skills_score + m.title_score + m.industry_score + m.location_score + m.salary_score END DESC,
+         CASE WHEN $2::text = 'newest' THEN m.created_at END DESC,
+         CASE WHEN $2::text = 'oldest' THEN m.created_at END ASC,
+         CASE WHEN $2::text = 'salary-desc' THEN normalize_salary(m.salary_max, m.salary_currency, m.salary_period) END DESC,
+         CASE WHEN $2::text = 'salary-asc' THEN normalize_salary(m.salary_min, m.salary_currency, m.salary_period) END ASC,
+         CASE WHEN $2::text = 'company' THEN m.company_name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN $2::text IN ('score', 'newest', 'salary-desc') THEN m.id END DESC,
+         CASE WHEN $2::text IN ('oldest', 'salary-asc', 'company') THEN m.id END ASC
+LIMIT $3 OFFSET $4
 `
 
 type ListJobsMatchingUserSkillsParams struct {
-	UserID int32 `json:"user_id"`
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	UserID int32  `json:"user_id"`
+	Sort   string `json:"sort"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
 }
 
 type ListJobsMatchingUserSkillsRow struct {
-	ID           int32     `json:"id"`
-	Title        string    `json:"title"`
-	Industry     string    `json:"industry"`
-	CompanyID    int32     `json:"company_id"`
-	Description  string    `json:"description"`
-	Location     string    `json:"location"`
-	SalaryMin    int32     `json:"salary_min"`
-	SalaryMax    int32     `json:"salary_max"`
-	Requirements string    `json:"requirements"`
-	CreatedAt    time.Time `json:"created_at"`
-	CompanyName  string    `json:"company_name"`
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	CompanyID      int32          `json:"company_id"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	Requirements   string         `json:"requirements"`
+	CreatedAt      time.Time      `json:"created_at"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	CompanyName    string         `json:"company_name"`
+	MatchedSkills  int64          `json:"matched_skills"`
+	TotalSkills    int64          `json:"total_skills"`
+	SkillsScore    float64        `json:"skills_score"`
+	TitleScore     float64        `json:"title_score"`
+	IndustryScore  float64        `json:"industry_score"`
+	LocationScore  float64        `json:"location_score"`
+	SalaryScore    float64        `json:"salary_score"`
+	MatchScore     float64        `json:"match_score"`
 }
 
+// the match score is the sum of the scores of the job, up to 100 points:
+// skills up to 50, desired title 20, industry 10, location 10 and salary range 10
 func (q *Queries) ListJobsMatchingUserSkills(ctx context.Context, arg ListJobsMatchingUserSkillsParams) ([]ListJobsMatchingUserSkillsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listJobsMatchingUserSkills, arg.UserID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listJobsMatchingUserSkills,
+		arg.UserID,
+		arg.Sort,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -758,9 +1552,22 @@ func (q *Queries) ListJobsMatchingUserSkills(ctx context.Context, arg ListJobsMa
 			&i.Location,
 			&i.SalaryMin,
 			&i.SalaryMax,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
 			&i.CompanyName,
+			&i.MatchedSkills,
+			&i.TotalSkills,
+			&i.SkillsScore,
+			&i.TitleScore,
+			&i.IndustryScore,
+			&i.LocationScore,
+			&i.SalaryScore,
+			&i.MatchScore,
 		); err != nil {
 			return nil, err
 		}
@@ -775,30 +1582,199 @@ func (q *Queries) ListJobsMatchingUserSkills(ctx context.Context, arg ListJobsMa
 	return items, nil
 }
 
+const markJobsExpiryNotified = `-- name: MarkJobsExpiryNotified :many
+UPDATE jobs
+SET expiry_notified_at = now()
+WHERE status = 'published'
+  AND expires_at > $1::timestamptz
+  AND expires_at <= $2::timestamptz
+  AND expiry_notified_at IS NULL
+  AND deleted_at IS NULL
+  AND unpublished_at IS NULL
+RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
+`
+
+type MarkJobsExpiryNotifiedParams struct {
+	Now          time.Time `json:"now"`
+	NotifyBefore time.Time `json:"notify_before"`
+}
+
+// marks the published jobs expiring until notify_before, so the employers are notified only once
+func (q *Queries) MarkJobsExpiryNotified(ctx context.Context, arg MarkJobsExpiryNotifiedParams) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, markJobsExpiryNotified, arg.Now, arg.NotifyBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Industry,
+			&i.CompanyID,
+			&i.Description,
+			&i.Location,
+			&i.SalaryMin,
+			&i.SalaryMax,
+			&i.Requirements,
+			&i.CreatedAt,
+			&i.UnpublishedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.DeletedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.PublishAt,
+			&i.ExpiryNotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const publishScheduledJobs = `-- name: PublishScheduledJobs :many
+UPDATE jobs
+SET status     = 'published',
+    publish_at = NULL
+WHERE status = 'draft'
+  AND publish_at <= $1::timestamptz
+  AND deleted_at IS NULL
+  AND unpublished_at IS NULL
+  AND company_id IN (SELECT id
+                     FROM companies
+                     WHERE suspended_at IS NULL)
+RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
+`
+
+// drafts of suspended companies and drafts unpublished by an admin wait until they are restored
+func (q *Queries) PublishScheduledJobs(ctx context.Context, now time.Time) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, publishScheduledJobs, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Industry,
+			&i.CompanyID,
+			&i.Description,
+			&i.Location,
+			&i.SalaryMin,
+			&i.SalaryMax,
+			&i.Requirements,
+			&i.CreatedAt,
+			&i.UnpublishedAt,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.DeletedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
+			&i.PublishAt,
+			&i.ExpiryNotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unpublishJob = `-- name: UnpublishJob :one
+UPDATE jobs
+SET unpublished_at = now()
+WHERE id = $1
+RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
+`
+
+func (q *Queries) UnpublishJob(ctx context.Context, id int32) (Job, error) {
+	row := q.db.QueryRowContext(ctx, unpublishJob, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Industry,
+		&i.CompanyID,
+		&i.Description,
+		&i.Location,
+		&i.SalaryMin,
+		&i.SalaryMax,
+		&i.Requirements,
+		&i.CreatedAt,
+		&i.UnpublishedAt,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.DeletedAt,
+		&i.EmploymentType,
+		&i.WorkMode,
+		&i.SeniorityLevel,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.PublishAt,
+		&i.ExpiryNotifiedAt,
+	)
+	return i, err
+}
+
 const updateJob = `-- name: UpdateJob :one
 UPDATE jobs
-SET title        = $2,
-    industry     = $3,
-    company_id   = $4,
-    description  = $5,
-    location     = $6,
-    salary_min   = $7,
-    salary_max   = $8,
-    requirements = $9
+SET title           = $2,
+    industry        = $3,
+    company_id      = $4,
+    description     = $5,
+    location        = $6,
+    salary_min      = $7,
+    salary_max      = $8,
+    requirements    = $9,
+    employment_type = $10,
+    work_mode       = $11,
+    seniority_level = $12,
+    salary_currency = $13,
+    salary_period   = $14
 WHERE id = $1
-RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at
+RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
 `
 
 type UpdateJobParams struct {
-	ID           int32  `json:"id"`
-	Title        string `json:"title"`
-	Industry     string `json:"industry"`
-	CompanyID    int32  `json:"company_id"`
-	Description  string `json:"description"`
-	Location     string `json:"location"`
-	SalaryMin    int32  `json:"salary_min"`
-	SalaryMax    int32  `json:"salary_max"`
-	Requirements string `json:"requirements"`
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	CompanyID      int32          `json:"company_id"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	Requirements   string         `json:"requirements"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
 }
 
 func (q *Queries) UpdateJob(ctx context.Context, arg UpdateJobParams) (Job, error) {
@@ -812,6 +1788,63 @@ func (q *Queries) UpdateJob(ctx context.Context, arg UpdateJobParams) (Job, erro
 		arg.SalaryMin,
 		arg.SalaryMax,
 		arg.Requirements,
+		arg.EmploymentType,
+		arg.WorkMode,
+		arg.SeniorityLevel,
+		arg.SalaryCurrency,
+		arg.SalaryPeriod,
+	)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Industry,
+		&i.CompanyID,
+		&i.Description,
+		&i.Location,
+		&i.SalaryMin,
+		&i.SalaryMax,
+		&i.Requirements,
+		&i.CreatedAt,
+		&i.UnpublishedAt,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.DeletedAt,
+		&i.EmploymentType,
+		&i.WorkMode,
+		&i.SeniorityLevel,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.PublishAt,
+		&i.ExpiryNotifiedAt,
+	)
+	return i, err
+}
+
+const updateJobStatus = `-- name: UpdateJobStatus :one
+UPDATE jobs
+SET status             = $2,
+    expires_at         = $3,
+    publish_at         = $4,
+    -- the employers are notified again about a new expiration
+    expiry_notified_at = CASE WHEN expires_at IS DISTINCT FROM $3 THEN NULL ELSE expiry_notified_at END
+WHERE id = $1
+RETURNING id, title, industry, company_id, description, location, salary_min, salary_max, requirements, created_at, unpublished_at, status, expires_at, deleted_at, employment_type, work_mode, seniority_level, salary_currency, salary_period, publish_at, expiry_notified_at
+`
+
+type UpdateJobStatusParams struct {
+	ID        int32        `json:"id"`
+	Status    JobStatus    `json:"status"`
+	ExpiresAt sql.NullTime `json:"expires_at"`
+	PublishAt sql.NullTime `json:"publish_at"`
+}
+
+func (q *Queries) UpdateJobStatus(ctx context.Context, arg UpdateJobStatusParams) (Job, error) {
+	row := q.db.QueryRowContext(ctx, updateJobStatus,
+		arg.ID,
+		arg.Status,
+		arg.ExpiresAt,
+		arg.PublishAt,
 	)
 	var i Job
 	err := row.Scan(
@@ -825,6 +1858,17 @@ func (q *Queries) UpdateJob(ctx context.Context, arg UpdateJobParams) (Job, erro
 		&i.SalaryMax,
 		&i.Requirements,
 		&i.CreatedAt,
+		&i.UnpublishedAt,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.DeletedAt,
+		&i.EmploymentType,
+		&i.WorkMode,
+		&i.SeniorityLevel,
+		&i.SalaryCurrency,
+		&i.SalaryPeriod,
+		&i.PublishAt,
+		&i.ExpiryNotifiedAt,
 	)
 	return i, err
 }
@@ -9,12 +9,60 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
+const countJobApplicationsForEmployer = `-- name: CountJobApplicationsForEmployer :one
+SELECT count(*)
+FROM job_applications ja
+WHERE ja.job_id = $1
+  AND ($2::bool = TRUE AND ja.status = $3 OR $2::bool = FALSE)
+`
+
+type CountJobApplicationsForEmployerParams struct {
+	JobID        int32             `json:"job_id"`
+	FilterStatus bool              `json:"filter_status"`
+	Status       ApplicationStatus `json:"status"`
+}
+
+func (q *Queries) CountJobApplicationsForEmployer(ctx context.Context, arg CountJobApplicationsForEmployerParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countJobApplicationsForEmployer, arg.JobID, arg.FilterStatus, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countJobApplicationsForUser = `-- name: CountJobApplicationsForUser :one
+SELECT count(*)
+FROM job_applications ja
+WHERE ja.user_id = $1
+  AND ($2::bool = TRUE AND ja.status = $3 OR $2::bool = FALSE)
+`
+
+type CountJobApplicationsForUserParams struct {
+	UserID       int32             `json:"user_id"`
+	FilterStatus bool              `json:"filter_status"`
+	Status       ApplicationStatus `json:"status"`
+}
+
+func (q *Queries) CountJobApplicationsForUser(ctx context.Context, arg CountJobApplicationsForUserParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countJobApplicationsForUser, arg.UserID, arg.FilterStatus, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createJobApplication = `-- name: CreateJobApplication :one
-INSERT INTO job_applications (user_id, job_id, message, cv)
-VALUES ($1, $2, $3, $4)
-RETURNING id, user_id, job_id, message, cv, status, applied_at, notification
+INSERT INTO job_applications (user_id, job_id, message, cv, job_revision_id)
+VALUES ($1, $2, $3, $4,
+        -- the application is pinned to the latest revision of the job
+        (SELECT jr.id
+         FROM job_revisions jr
+         WHERE jr.job_id = $2
+         ORDER BY jr.revision DESC
+         LIMIT 1))
+RETURNING id, user_id, job_id, message, cv, status, applied_at, notification, job_revision_id
 `
 
 type CreateJobApplicationParams struct {
@@ -41,6 +89,7 @@ func (q *Queries) CreateJobApplication(ctx context.Context, arg CreateJobApplica
 		&i.Status,
 		&i.AppliedAt,
 		&i.Notification,
+		&i.JobRevisionID,
 	)
 	return i, err
 }
@@ -56,6 +105,121 @@ func (q *Queries) DeleteJobApplication(ctx context.Context, id int32) error {
 	return err
 }
 
+const exportJobApplicationsForEmployer = `-- name: ExportJobApplicationsForEmployer :many
+SELECT ja.id         AS application_id,
+       ja.status     AS application_status,
+       ja.applied_at AS application_date,
+       j.id          AS job_id,
+       j.title       AS job_title,
+       ja.user_id    AS user_id,
+       u.full_name   AS user_full_name,
+       u.email       AS user_email,
+       u.location    AS user_location,
+       u.telegram_id AS user_telegram_id,
+       COALESCE((SELECT array_agg(us.skill ORDER BY us.skill)
+                 FROM user_skills us
+                 WHERE us.user_id = u.id), '{}')::text[] AS user_skills
+FROM job_applications ja
+         JOIN jobs j ON j.id = ja.job_id
+         JOIN users u ON u.id = ja.user_id
+WHERE ja.job_id = $1
+  AND ($2::bool = TRUE AND ja.status = $3 OR $2::bool = FALSE)
+  -- keyset pagination, the export is read page by page
+  AND ($4::timestamptz IS NULL
+    OR (ja.applied_at, ja.id) > ($4::timestamptz, $5::int))
+ORDER BY ja.applied_at, ja.id
+LIMIT $6
+`
+
+type ExportJobApplicationsForEmployerParams struct {
+	JobID           int32             `json:"job_id"`
+	FilterStatus    bool              `json:"filter_status"`
+	Status          ApplicationStatus `json:"status"`
+	CursorAppliedAt sql.NullTime      `json:"cursor_applied_at"`
+	CursorID        int32             `json:"cursor_id"`
+	Limit           int32             `json:"limit"`
+}
+
+type ExportJobApplicationsForEmployerRow struct {
+	ApplicationID     int32             `json:"application_id"`
+	ApplicationStatus ApplicationStatus `json:"application_status"`
+	ApplicationDate   time.Time         `json:"application_date"`
+	JobID             int32             `json:"job_id"`
+	JobTitle          string            `json:"job_title"`
+	UserID            int32             `json:"user_id"`
+	UserFullName      string            `json:"user_full_name"`
+	UserEmail         string            `json:"user_email"`
+	UserLocation      string            `json:"user_location"`
+	UserTelegramID    string            `json:"user_telegram_id"`
+	UserSkills        []string          `json:"user_skills"`
+}
+
+func (q *Queries) ExportJobApplicationsForEmployer(ctx context.Context, arg ExportJobApplicationsForEmployerParams) ([]ExportJobApplicationsForEmployerRow, error) {
+	rows, err := q.db.QueryContext(ctx, exportJobApplicationsForEmployer,
+		arg.JobID,
+		arg.FilterStatus,
+		arg.Status,
+		arg.CursorAppliedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ExportJobApplicationsForEmployerRow{}
+	for rows.Next() {
+		var i ExportJobApplicationsForEmployerRow
+		if err := rows.Scan(
+			&i.ApplicationID,
+			&i.ApplicationStatus,
+			&i.ApplicationDate,
+			&i.JobID,
+			&i.JobTitle,
+			&i.UserID,
+			&i.UserFullName,
+			&i.UserEmail,
+			&i.UserLocation,
+			&i.UserTelegramID,
+			pq.Array(&i.UserSkills),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobApplication = `-- name: GetJobApplication :one
+SELECT id, user_id, job_id, message, cv, status, applied_at, notification, job_revision_id
+FROM job_applications
+WHERE id = $1
+`
+
+// this function will be used by admins only
+func (q *Queries) GetJobApplication(ctx context.Context, id int32) (JobApplication, error) {
+	row := q.db.QueryRowContext(ctx, getJobApplication, id)
+	var i JobApplication
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.JobID,
+		&i.Message,
+		&i.Cv,
+		&i.Status,
+		&i.AppliedAt,
+		&i.Notification,
+		&i.JobRevisionID,
+	)
+	return i, err
+}
+
 const getJobApplicationForEmployer = `-- name: GetJobApplicationForEmployer :one
 SELECT ja.id         AS application_id,
        j.title       AS job_title,
@@ -68,11 +232,13 @@ SELECT ja.id         AS application_id,
        u.email       AS user_email,
        u.full_name   AS user_full_name,
        u.location    AS user_location,
-       c.id          AS company_id
+       c.id          AS company_id,
+       jr.revision   AS job_revision
 FROM job_applications ja
          JOIN jobs j ON ja.job_id = j.id
          JOIN companies c ON j.company_id = c.id
          JOIN users u ON ja.user_id = u.id
+         LEFT JOIN job_revisions jr ON ja.job_revision_id = jr.id
 WHERE ja.id = $1
 `
 
@@ -89,6 +255,7 @@ type GetJobApplicationForEmployerRow struct {
 	UserFullName       string            `json:"user_full_name"`
 	UserLocation       string            `json:"user_location"`
 	CompanyID          int32             `json:"company_id"`
+	JobRevision        sql.NullInt32     `json:"job_revision"`
 }
 
 // this function will be used by employers
@@ -108,6 +275,7 @@ func (q *Queries) GetJobApplicationForEmployer(ctx context.Context, id int32) (G
 		&i.UserFullName,
 		&i.UserLocation,
 		&i.CompanyID,
+		&i.JobRevision,
 	)
 	return i, err
 }
@@ -122,10 +290,13 @@ SELECT ja.id         AS application_id,
        ja.message    AS application_message,
        ja.cv         AS user_cv,
        ja.user_id    AS user_id,
-       ja.notification AS notification
+       ja.notification AS notification,
+       ja.job_revision_id AS job_revision_id,
+       jr.revision   AS job_revision
 FROM job_applications ja
          JOIN jobs j ON ja.job_id = j.id
          JOIN companies c ON j.company_id = c.id
+         LEFT JOIN job_revisions jr ON ja.job_revision_id = jr.id
 WHERE ja.id = $1
 `
 
@@ -140,6 +311,8 @@ type GetJobApplicationForUserRow struct {
 	UserCv             []byte            `json:"user_cv"`
 	UserID             int32             `json:"user_id"`
 	Notification       bool              `json:"notification"`
+	JobRevisionID      sql.NullInt32     `json:"job_revision_id"`
+	JobRevision        sql.NullInt32     `json:"job_revision"`
 }
 
 // this function will be used by users only
@@ -157,6 +330,8 @@ func (q *Queries) GetJobApplicationForUser(ctx context.Context, id int32) (GetJo
 		&i.UserCv,
 		&i.UserID,
 		&i.Notification,
+		&i.JobRevisionID,
+		&i.JobRevision,
 	)
 	return i, err
 }
@@ -205,6 +380,59 @@ func (q *Queries) GetJobIDOfJobApplication(ctx context.Context, id int32) (int32
 	return job_id, err
 }
 
+const listJobApplicationCountsByPeriod = `-- name: ListJobApplicationCountsByPeriod :many
+SELECT date_trunc($2::text, ja.applied_at AT TIME ZONE 'UTC')::date AS period_start,
+       count(*)                                                         AS applications
+FROM job_applications ja
+WHERE ja.job_id = $1
+  AND (ja.applied_at AT TIME ZONE 'UTC')::date BETWEEN $3::date AND $4::date
+GROUP BY period_start
+ORDER BY period_start
+`
+
+type ListJobApplicationCountsByPeriodParams struct {
+	JobID    int32     `json:"job_id"`
+	Period   string    `json:"period"`
+	FromDate time.Time `json:"from_date"`
+	ToDate   time.Time `json:"to_date"`
+}
+
+type ListJobApplicationCountsByPeriodRow struct {
+	PeriodStart  time.Time `json:"period_start"`
+	Applications int64     `json:"applications"`
+}
+
+func (q *Queries) ListJobApplicationCountsByPeriod(ctx context.Context, arg ListJobApplicationCountsByPeriodParams) ([]ListJobApplicationCountsByPeriodRow, error) {
+	rows, err := q.db.QueryContext(ctx, listJobApplicationCountsByPeriod,
+		arg.JobID,
+		arg.Period,
+		arg.FromDate,
+		arg.ToDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListJobApplicationCountsByPeriodRow{}
+	for rows.Next() {
+		var i ListJobApplicationCountsByPeriodRow
+		if err := rows.Scan(
+			&i.PeriodStart,
+			&i.Applications,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listJobApplicationsForEmployer = `-- name: ListJobApplicationsForEmployer :many
 SELECT ja.id         AS application_id,
        ja.user_id    AS user_id,
@@ -215,21 +443,27 @@ SELECT ja.id         AS application_id,
 FROM job_applications ja
          JOIN users u ON u.id = ja.user_id
 WHERE ja.job_id = $1
-  AND ($4::bool = TRUE AND ja.status = $5 OR $4::bool = FALSE)
+  AND ($3::bool = TRUE AND ja.status = $4 OR $3::bool = FALSE)
+  -- keyset pagination, only applications after the cursor in the requested order
+  AND ($5::timestamptz IS NULL
+    OR $6::bool AND (ja.applied_at, ja.id) > ($5::timestamptz, $7::int)
+    OR $8::bool AND (ja.applied_at, ja.id) < ($5::timestamptz, $7::int))
 ORDER BY CASE WHEN $6::bool THEN ja.applied_at END ASC,
-         CASE WHEN $7::bool THEN ja.applied_at END DESC,
-         ja.applied_at DESC
-LIMIT $2 OFFSET $3
+         CASE WHEN $6::bool THEN ja.id END ASC,
+         CASE WHEN $8::bool THEN ja.applied_at END DESC,
+         CASE WHEN $8::bool THEN ja.id END DESC
+LIMIT $2
 `
 
 type ListJobApplicationsForEmployerParams struct {
-	JobID         int32             `json:"job_id"`
-	Limit         int32             `json:"limit"`
-	Offset        int32             `json:"offset"`
-	FilterStatus  bool              `json:"filter_status"`
-	Status        ApplicationStatus `json:"status"`
-	AppliedAtAsc  bool              `json:"applied_at_asc"`
-	AppliedAtDesc bool              `json:"applied_at_desc"`
+	JobID           int32             `json:"job_id"`
+	Limit           int32             `json:"limit"`
+	FilterStatus    bool              `json:"filter_status"`
+	Status          ApplicationStatus `json:"status"`
+	CursorAppliedAt sql.NullTime      `json:"cursor_applied_at"`
+	AppliedAtAsc    bool              `json:"applied_at_asc"`
+	CursorID        int32             `json:"cursor_id"`
+	AppliedAtDesc   bool              `json:"applied_at_desc"`
 }
 
 type ListJobApplicationsForEmployerRow struct {
@@ -245,10 +479,11 @@ func (q *Queries) ListJobApplicationsForEmployer(ctx context.Context, arg ListJo
 	rows, err := q.db.QueryContext(ctx, listJobApplicationsForEmployer,
 		arg.JobID,
 		arg.Limit,
-		arg.Offset,
 		arg.FilterStatus,
 		arg.Status,
+		arg.CursorAppliedAt,
 		arg.AppliedAtAsc,
+		arg.CursorID,
 		arg.AppliedAtDesc,
 	)
 	if err != nil {
@@ -287,26 +522,33 @@ SELECT ja.user_id    AS user_id,
        j.id          AS job_id,
        c.name        AS company_name,
        ja.status     AS application_status,
-       ja.applied_at AS application_date
+       ja.applied_at AS application_date,
+       (j.deleted_at IS NOT NULL)::bool AS job_deleted
 FROM job_applications ja
          JOIN jobs j ON ja.job_id = j.id
          JOIN companies c ON j.company_id = c.id
 WHERE ja.user_id = $1
-  AND ($4::bool = TRUE AND ja.status = $5 OR $4::bool = FALSE)
+  AND ($3::bool = TRUE AND ja.status = $4 OR $3::bool = FALSE)
+  -- keyset pagination, only applications after the cursor in the requested order
+  AND ($5::timestamptz IS NULL
+    OR $6::bool AND (ja.applied_at, ja.id) > ($5::timestamptz, $7::int)
+    OR $8::bool AND (ja.applied_at, ja.id) < ($5::timestamptz, $7::int))
 ORDER BY CASE WHEN $6::bool THEN ja.applied_at END ASC,
-         CASE WHEN $7::bool THEN ja.applied_at END DESC,
-         ja.applied_at DESC
-LIMIT $2 OFFSET $3
+         CASE WHEN $6::bool THEN ja.id END ASC,
+         CASE WHEN $8::bool THEN ja.applied_at END DESC,
+         CASE WHEN $8::bool THEN ja.id END DESC
+LIMIT $2
 `
 
 type ListJobApplicationsForUserParams struct {
-	UserID        int32             `json:"user_id"`
-	Limit         int32             `json:"limit"`
-	Offset        int32             `json:"offset"`
-	FilterStatus  bool              `json:"filter_status"`
-	Status        ApplicationStatus `json:"status"`
-	AppliedAtAsc  bool              `json:"applied_at_asc"`
-	AppliedAtDesc bool              `json:"applied_at_desc"`
+	UserID          int32             `json:"user_id"`
+	Limit           int32             `json:"limit"`
+	FilterStatus    bool              `json:"filter_status"`
+	Status          ApplicationStatus `json:"status"`
+	CursorAppliedAt sql.NullTime      `json:"cursor_applied_at"`
+	AppliedAtAsc    bool              `json:"applied_at_asc"`
+	CursorID        int32             `json:"cursor_id"`
+	AppliedAtDesc   bool              `json:"applied_at_desc"`
 }
 
 type ListJobApplicationsForUserRow struct {
@@ -318,16 +560,18 @@ type ListJobApplicationsForUserRow struct {
 	CompanyName       string            `json:"company_name"`
 	ApplicationStatus ApplicationStatus `json:"application_status"`
 	ApplicationDate   time.Time         `json:"application_date"`
+	JobDeleted        bool              `json:"job_deleted"`
 }
 
 func (q *Queries) ListJobApplicationsForUser(ctx context.Context, arg ListJobApplicationsForUserParams) ([]ListJobApplicationsForUserRow, error) {
 	rows, err := q.db.QueryContext(ctx, listJobApplicationsForUser,
 		arg.UserID,
 		arg.Limit,
-		arg.Offset,
 		arg.FilterStatus,
 		arg.Status,
+		arg.CursorAppliedAt,
 		arg.AppliedAtAsc,
+		arg.CursorID,
 		arg.AppliedAtDesc,
 	)
 	if err != nil {
@@ -346,6 +590,7 @@ func (q *Queries) ListJobApplicationsForUser(ctx context.Context, arg ListJobApp
 			&i.CompanyName,
 			&i.ApplicationStatus,
 			&i.ApplicationDate,
+			&i.JobDeleted,
 		); err != nil {
 			return nil, err
 		}
@@ -365,7 +610,7 @@ UPDATE job_applications
 SET message = COALESCE($2, message),
     cv      = COALESCE($3, cv)
 WHERE id = $1
-RETURNING id, user_id, job_id, message, cv, status, applied_at, notification
+RETURNING id, user_id, job_id, message, cv, status, applied_at, notification, job_revision_id
 `
 
 type UpdateJobApplicationParams struct {
@@ -386,6 +631,7 @@ func (q *Queries) UpdateJobApplication(ctx context.Context, arg UpdateJobApplica
 		&i.Status,
 		&i.AppliedAt,
 		&i.Notification,
+		&i.JobRevisionID,
 	)
 	return i, err
 }
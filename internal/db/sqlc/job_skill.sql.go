@@ -51,15 +51,24 @@ func (q *Queries) DeleteJobSkillsByJobID(ctx context.Context, jobID int32) error
 	return err
 }
 
-const deleteMultipleJobSkills = `-- name: DeleteMultipleJobSkills :exec
+const deleteMultipleJobSkills = `-- name: DeleteMultipleJobSkills :execrows
 DELETE
 FROM job_skills
 WHERE id = ANY ($1::int[])
+  AND job_id = $2
 `
 
-func (q *Queries) DeleteMultipleJobSkills(ctx context.Context, ids []int32) error {
-	_, err := q.db.ExecContext(ctx, deleteMultipleJobSkills, pq.Array(ids))
-	return err
+type DeleteMultipleJobSkillsParams struct {
+	IDs   []int32 `json:"ids"`
+	JobID int32   `json:"job_id"`
+}
+
+func (q *Queries) DeleteMultipleJobSkills(ctx context.Context, arg DeleteMultipleJobSkillsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMultipleJobSkills, pq.Array(arg.IDs), arg.JobID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const listAllJobSkillsByJobID = `-- name: ListAllJobSkillsByJobID :many
@@ -5,6 +5,7 @@ import (
 	"github.com/bxcodec/faker/v3"
 	"github.com/grannnsacker/job-finder-back/pkg/utils"
 	"log"
+	"strings"
 	"sync"
 	"sync/atomic"
 )
@@ -14,6 +15,8 @@ func (store *SQLStore) LoadTestData(ctx context.Context) {
 	var wg sync.WaitGroup
 	nOfJobsCreated := int32(0)
 	jobTitles := append(utils.GenerateEngineerJobs(), utils.GenerateDeveloperJobs()...)
+	employmentTypes := []EmploymentType{EmploymentTypeFullTime, EmploymentTypePartTime, EmploymentTypeContract}
+	workModes := []WorkMode{WorkModeOnSite, WorkModeRemote, WorkModeHybrid}
 
 	// create fake companies
 	for i := 0; i < 5; i++ {
@@ -53,14 +56,21 @@ func (store *SQLStore) LoadTestData(ctx context.Context) {
 						idx := utils.RandomInt(0, int32(len(jobTitles)-1))
 						jobTitle := jobTitles[idx]
 						jobParams := CreateJobParams{
-							Title:        jobTitle,
-							Industry:     industry,
-							CompanyID:    company.ID,
-							Description:  jobTitle + " " + faker.Paragraph(),
-							Location:     location,
-							SalaryMin:    utils.RandomInt(0, 2000),
-							SalaryMax:    utils.RandomInt(2001, 5000),
-							Requirements: jobTitle + " " + faker.Paragraph(),
+							Title:          jobTitle,
+							Industry:       industry,
+							CompanyID:      company.ID,
+							Description:    jobTitle + " " + faker.Paragraph(),
+							Location:       location,
+							SalaryMin:      utils.RandomInt(0, 2000),
+							SalaryMax:      utils.RandomInt(2001, 5000),
+							SalaryCurrency: "USD",
+							SalaryPeriod:   SalaryPeriodMonthly,
+							Requirements:   jobTitle + " " + faker.Paragraph(),
+							Status:         JobStatusPublished,
+							EmploymentType: employmentTypes[utils.RandomInt(0, int32(len(employmentTypes)-1))],
+							WorkMode:       workModes[utils.RandomInt(0, int32(len(workModes)-1))],
+							// titles start with the seniority, e.g. "Junior Go Developer"
+							SeniorityLevel: SeniorityLevel(strings.ToLower(strings.Fields(jobTitle)[0])),
 						}
 						_, err := store.CreateJob(ctx, jobParams)
 						if err != nil {
@@ -9,6 +9,8 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type ApplicationStatus string
@@ -56,45 +58,451 @@ func (ns NullApplicationStatus) Value() (driver.Value, error) {
 	return string(ns.ApplicationStatus), nil
 }
 
+type EmployerRole string
+
+const (
+	EmployerRoleOwner     EmployerRole = "owner"
+	EmployerRoleRecruiter EmployerRole = "recruiter"
+	EmployerRoleViewer    EmployerRole = "viewer"
+)
+
+func (e *EmployerRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmployerRole(s)
+	case string:
+		*e = EmployerRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmployerRole: %T", src)
+	}
+	return nil
+}
+
+type NullEmployerRole struct {
+	EmployerRole EmployerRole `json:"employer_role"`
+	Valid        bool         `json:"valid"` // Valid is true if EmployerRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmployerRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmployerRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmployerRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmployerRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmployerRole), nil
+}
+
+type EmploymentType string
+
+const (
+	EmploymentTypeFullTime   EmploymentType = "full_time"
+	EmploymentTypePartTime   EmploymentType = "part_time"
+	EmploymentTypeContract   EmploymentType = "contract"
+	EmploymentTypeInternship EmploymentType = "internship"
+	EmploymentTypeTemporary  EmploymentType = "temporary"
+)
+
+func (e *EmploymentType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmploymentType(s)
+	case string:
+		*e = EmploymentType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmploymentType: %T", src)
+	}
+	return nil
+}
+
+type NullEmploymentType struct {
+	EmploymentType EmploymentType `json:"employment_type"`
+	Valid          bool           `json:"valid"` // Valid is true if EmploymentType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmploymentType) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmploymentType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmploymentType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmploymentType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmploymentType), nil
+}
+
+type JobStatus string
+
+const (
+	JobStatusDraft     JobStatus = "draft"
+	JobStatusPublished JobStatus = "published"
+	JobStatusClosed    JobStatus = "closed"
+	JobStatusExpired   JobStatus = "expired"
+)
+
+func (e *JobStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = JobStatus(s)
+	case string:
+		*e = JobStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for JobStatus: %T", src)
+	}
+	return nil
+}
+
+type NullJobStatus struct {
+	JobStatus JobStatus `json:"job_status"`
+	Valid     bool      `json:"valid"` // Valid is true if JobStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullJobStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.JobStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.JobStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullJobStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.JobStatus), nil
+}
+
+type JobViewSource string
+
+const (
+	JobViewSourceDetail JobViewSource = "detail"
+	JobViewSourceSearch JobViewSource = "search"
+)
+
+func (e *JobViewSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = JobViewSource(s)
+	case string:
+		*e = JobViewSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for JobViewSource: %T", src)
+	}
+	return nil
+}
+
+type NullJobViewSource struct {
+	JobViewSource JobViewSource `json:"job_view_source"`
+	Valid         bool          `json:"valid"` // Valid is true if JobViewSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullJobViewSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.JobViewSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.JobViewSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullJobViewSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.JobViewSource), nil
+}
+
+type SalaryPeriod string
+
+const (
+	SalaryPeriodHourly  SalaryPeriod = "hourly"
+	SalaryPeriodMonthly SalaryPeriod = "monthly"
+	SalaryPeriodYearly  SalaryPeriod = "yearly"
+)
+
+func (e *SalaryPeriod) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SalaryPeriod(s)
+	case string:
+		*e = SalaryPeriod(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SalaryPeriod: %T", src)
+	}
+	return nil
+}
+
+type NullSalaryPeriod struct {
+	SalaryPeriod SalaryPeriod `json:"salary_period"`
+	Valid        bool         `json:"valid"` // Valid is true if SalaryPeriod is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSalaryPeriod) Scan(value interface{}) error {
+	if value == nil {
+		ns.SalaryPeriod, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SalaryPeriod.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSalaryPeriod) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SalaryPeriod), nil
+}
+
+type SeniorityLevel string
+
+const (
+	SeniorityLevelIntern SeniorityLevel = "intern"
+	SeniorityLevelJunior SeniorityLevel = "junior"
+	SeniorityLevelMiddle SeniorityLevel = "middle"
+	SeniorityLevelSenior SeniorityLevel = "senior"
+	SeniorityLevelLead   SeniorityLevel = "lead"
+)
+
+func (e *SeniorityLevel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SeniorityLevel(s)
+	case string:
+		*e = SeniorityLevel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SeniorityLevel: %T", src)
+	}
+	return nil
+}
+
+type NullSeniorityLevel struct {
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	Valid          bool           `json:"valid"` // Valid is true if SeniorityLevel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSeniorityLevel) Scan(value interface{}) error {
+	if value == nil {
+		ns.SeniorityLevel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SeniorityLevel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSeniorityLevel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SeniorityLevel), nil
+}
+
+type WorkMode string
+
+const (
+	WorkModeOnSite WorkMode = "on_site"
+	WorkModeRemote WorkMode = "remote"
+	WorkModeHybrid WorkMode = "hybrid"
+)
+
+func (e *WorkMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WorkMode(s)
+	case string:
+		*e = WorkMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WorkMode: %T", src)
+	}
+	return nil
+}
+
+type NullWorkMode struct {
+	WorkMode WorkMode `json:"work_mode"`
+	Valid    bool     `json:"valid"` // Valid is true if WorkMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWorkMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.WorkMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WorkMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWorkMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WorkMode), nil
+}
+
+type Admin struct {
+	ID             int32     `json:"id"`
+	FullName       string    `json:"full_name"`
+	Email          string    `json:"email"`
+	HashedPassword string    `json:"hashed_password"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type AdminAuditLog struct {
+	ID         int64         `json:"id"`
+	AdminID    int32         `json:"admin_id"`
+	Action     string        `json:"action"`
+	TargetType string        `json:"target_type"`
+	TargetID   sql.NullInt64 `json:"target_id"`
+	Details    string        `json:"details"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
 type Company struct {
-	ID       int32  `json:"id"`
-	Name     string `json:"name"`
-	Industry string `json:"industry"`
-	Location string `json:"location"`
+	ID          int32        `json:"id"`
+	Name        string       `json:"name"`
+	Industry    string       `json:"industry"`
+	Location    string       `json:"location"`
+	SuspendedAt sql.NullTime `json:"suspended_at"`
+}
+
+type CompanyApiKey struct {
+	ID         int64        `json:"id"`
+	CompanyID  int32        `json:"company_id"`
+	EmployerID int32        `json:"employer_id"`
+	Name       string       `json:"name"`
+	KeyPrefix  string       `json:"key_prefix"`
+	HashedKey  string       `json:"hashed_key"`
+	Scopes     []string     `json:"scopes"`
+	LastUsedAt sql.NullTime `json:"last_used_at"`
+	RevokedAt  sql.NullTime `json:"revoked_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+type CompanyInvitation struct {
+	ID         int64        `json:"id"`
+	CompanyID  int32        `json:"company_id"`
+	InvitedBy  int32        `json:"invited_by"`
+	Email      string       `json:"email"`
+	Role       EmployerRole `json:"role"`
+	HashedCode string       `json:"hashed_code"`
+	IsAccepted bool         `json:"is_accepted"`
+	CreatedAt  time.Time    `json:"created_at"`
+	ExpiredAt  time.Time    `json:"expired_at"`
 }
 
 type Employer struct {
-	ID              int32     `json:"id"`
-	CompanyID       int32     `json:"company_id"`
-	FullName        string    `json:"full_name"`
-	Email           string    `json:"email"`
-	HashedPassword  string    `json:"hashed_password"`
-	CreatedAt       time.Time `json:"created_at"`
-	IsEmailVerified bool      `json:"is_email_verified"`
+	ID              int32        `json:"id"`
+	CompanyID       int32        `json:"company_id"`
+	FullName        string       `json:"full_name"`
+	Email           string       `json:"email"`
+	HashedPassword  string       `json:"hashed_password"`
+	CreatedAt       time.Time    `json:"created_at"`
+	IsEmailVerified bool         `json:"is_email_verified"`
+	Role            EmployerRole `json:"role"`
+	SuspendedAt     sql.NullTime `json:"suspended_at"`
 }
 
-type Job struct {
-	ID           int32     `json:"id"`
-	Title        string    `json:"title"`
-	Industry     string    `json:"industry"`
-	CompanyID    int32     `json:"company_id"`
-	Description  string    `json:"description"`
-	Location     string    `json:"location"`
-	SalaryMin    int32     `json:"salary_min"`
-	SalaryMax    int32     `json:"salary_max"`
-	Requirements string    `json:"requirements"`
+type EmployerRecoveryCode struct {
+	ID         int64     `json:"id"`
+	EmployerID int32     `json:"employer_id"`
+	HashedCode string    `json:"hashed_code"`
+	IsUsed     bool      `json:"is_used"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type EmployerTotp struct {
+	EmployerID   int32     `json:"employer_id"`
+	Secret       string    `json:"secret"`
+	IsEnabled    bool      `json:"is_enabled"`
+	LastUsedStep int64     `json:"last_used_step"`
 	CreatedAt    time.Time `json:"created_at"`
 }
 
+type ExchangeRate struct {
+	Currency  string    `json:"currency"`
+	RateToUsd string    `json:"rate_to_usd"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Job struct {
+	ID               int32          `json:"id"`
+	Title            string         `json:"title"`
+	Industry         string         `json:"industry"`
+	CompanyID        int32          `json:"company_id"`
+	Description      string         `json:"description"`
+	Location         string         `json:"location"`
+	SalaryMin        int32          `json:"salary_min"`
+	SalaryMax        int32          `json:"salary_max"`
+	Requirements     string         `json:"requirements"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UnpublishedAt    sql.NullTime   `json:"unpublished_at"`
+	Status           JobStatus      `json:"status"`
+	ExpiresAt        sql.NullTime   `json:"expires_at"`
+	DeletedAt        sql.NullTime   `json:"deleted_at"`
+	EmploymentType   EmploymentType `json:"employment_type"`
+	WorkMode         WorkMode       `json:"work_mode"`
+	SeniorityLevel   SeniorityLevel `json:"seniority_level"`
+	SalaryCurrency   string         `json:"salary_currency"`
+	SalaryPeriod     SalaryPeriod   `json:"salary_period"`
+	PublishAt        sql.NullTime   `json:"publish_at"`
+	ExpiryNotifiedAt sql.NullTime   `json:"expiry_notified_at"`
+}
+
 type JobApplication struct {
-	ID           int32             `json:"id"`
-	UserID       int32             `json:"user_id"`
-	JobID        int32             `json:"job_id"`
-	Message      sql.NullString    `json:"message"`
-	Cv           []byte            `json:"cv"`
-	Status       ApplicationStatus `json:"status"`
-	AppliedAt    time.Time         `json:"applied_at"`
-	Notification bool              `json:"notification"`
+	ID            int32             `json:"id"`
+	UserID        int32             `json:"user_id"`
+	JobID         int32             `json:"job_id"`
+	Message       sql.NullString    `json:"message"`
+	Cv            []byte            `json:"cv"`
+	Status        ApplicationStatus `json:"status"`
+	AppliedAt     time.Time         `json:"applied_at"`
+	Notification  bool              `json:"notification"`
+	JobRevisionID sql.NullInt32     `json:"job_revision_id"`
+}
+
+type JobRevision struct {
+	ID             int32          `json:"id"`
+	JobID          int32          `json:"job_id"`
+	Revision       int32          `json:"revision"`
+	AuthorID       sql.NullInt32  `json:"author_id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	Requirements   string         `json:"requirements"`
+	RequiredSkills []string       `json:"required_skills"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	CreatedAt      time.Time      `json:"created_at"`
 }
 
 type JobSkill struct {
@@ -103,21 +511,68 @@ type JobSkill struct {
 	Skill string `json:"skill"`
 }
 
+type JobTemplate struct {
+	ID             int32     `json:"id"`
+	CompanyID      int32     `json:"company_id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Requirements   string    `json:"requirements"`
+	RequiredSkills []string  `json:"required_skills"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type JobView struct {
+	ID        int64         `json:"id"`
+	JobID     int32         `json:"job_id"`
+	Viewer    string        `json:"viewer"`
+	Source    JobViewSource `json:"source"`
+	ViewedOn  time.Time     `json:"viewed_on"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type PasswordReset struct {
+	ID          int64     `json:"id"`
+	Email       string    `json:"email"`
+	AccountType string    `json:"account_type"`
+	AccountID   int32     `json:"account_id"`
+	HashedCode  string    `json:"hashed_code"`
+	IsUsed      bool      `json:"is_used"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
+
+type Session struct {
+	ID           uuid.UUID `json:"id"`
+	Email        string    `json:"email"`
+	Role         string    `json:"role"`
+	SubjectID    int32     `json:"subject_id"`
+	RefreshToken string    `json:"refresh_token"`
+	UserAgent    string    `json:"user_agent"`
+	ClientIp     string    `json:"client_ip"`
+	IsBlocked    bool      `json:"is_blocked"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
 type User struct {
-	ID               int32     `json:"id"`
-	FullName         string    `json:"full_name"`
-	Email            string    `json:"email"`
-	HashedPassword   string    `json:"hashed_password"`
-	Location         string    `json:"location"`
-	DesiredJobTitle  string    `json:"desired_job_title"`
-	DesiredIndustry  string    `json:"desired_industry"`
-	DesiredSalaryMin int32     `json:"desired_salary_min"`
-	DesiredSalaryMax int32     `json:"desired_salary_max"`
-	Skills           string    `json:"skills"`
-	Experience       string    `json:"experience"`
-	TelegramID       string    `json:"telegram_id"`
-	CreatedAt        time.Time `json:"created_at"`
-	IsEmailVerified  bool      `json:"is_email_verified"`
+	ID                    int32        `json:"id"`
+	FullName              string       `json:"full_name"`
+	Email                 string       `json:"email"`
+	HashedPassword        string       `json:"hashed_password"`
+	Location              string       `json:"location"`
+	DesiredJobTitle       string       `json:"desired_job_title"`
+	DesiredIndustry       string       `json:"desired_industry"`
+	DesiredSalaryMin      int32        `json:"desired_salary_min"`
+	DesiredSalaryMax      int32        `json:"desired_salary_max"`
+	Skills                string       `json:"skills"`
+	Experience            string       `json:"experience"`
+	TelegramID            string       `json:"telegram_id"`
+	CreatedAt             time.Time    `json:"created_at"`
+	IsEmailVerified       bool         `json:"is_email_verified"`
+	SuspendedAt           sql.NullTime `json:"suspended_at"`
+	DesiredSalaryCurrency string       `json:"desired_salary_currency"`
+	DesiredSalaryPeriod   SalaryPeriod `json:"desired_salary_period"`
 }
 
 type UserSkill struct {
@@ -6,35 +6,78 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AcceptCompanyInvitation(ctx context.Context, arg AcceptCompanyInvitationParams) (CompanyInvitation, error)
+	BlockSession(ctx context.Context, id uuid.UUID) error
+	BlockSessionsBySubject(ctx context.Context, arg BlockSessionsBySubjectParams) error
+	CountCompanyEmployers(ctx context.Context, companyID int32) (int64, error)
+	CountCompanyEmployersByRole(ctx context.Context, arg CountCompanyEmployersByRoleParams) (int64, error)
+	CountJobApplicationsForEmployer(ctx context.Context, arg CountJobApplicationsForEmployerParams) (int64, error)
+	CountJobApplicationsForUser(ctx context.Context, arg CountJobApplicationsForUserParams) (int64, error)
+	CountJobsByCompanyExactName(ctx context.Context, name string) (int64, error)
+	CountJobsByCompanyID(ctx context.Context, companyID int32) (int64, error)
+	CountJobsByCompanyName(ctx context.Context, name string) (int64, error)
+	CountJobsForEmployer(ctx context.Context, companyID int32) (int64, error)
+	CreateAdmin(ctx context.Context, arg CreateAdminParams) (Admin, error)
+	CreateAdminAuditLog(ctx context.Context, arg CreateAdminAuditLogParams) (AdminAuditLog, error)
 	CreateCompany(ctx context.Context, arg CreateCompanyParams) (Company, error)
+	CreateCompanyAPIKey(ctx context.Context, arg CreateCompanyAPIKeyParams) (CompanyApiKey, error)
+	CreateCompanyInvitation(ctx context.Context, arg CreateCompanyInvitationParams) (CompanyInvitation, error)
 	CreateEmployer(ctx context.Context, arg CreateEmployerParams) (Employer, error)
+	CreateEmployerRecoveryCode(ctx context.Context, arg CreateEmployerRecoveryCodeParams) (EmployerRecoveryCode, error)
 	CreateJob(ctx context.Context, arg CreateJobParams) (Job, error)
 	CreateJobApplication(ctx context.Context, arg CreateJobApplicationParams) (JobApplication, error)
+	CreateJobRevision(ctx context.Context, arg CreateJobRevisionParams) (JobRevision, error)
 	CreateJobSkill(ctx context.Context, arg CreateJobSkillParams) (JobSkill, error)
+	CreateJobTemplate(ctx context.Context, arg CreateJobTemplateParams) (JobTemplate, error)
+	CreatePasswordReset(ctx context.Context, arg CreatePasswordResetParams) (PasswordReset, error)
+	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateUserSkill(ctx context.Context, arg CreateUserSkillParams) (UserSkill, error)
+	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
 	DeleteAllUserSkills(ctx context.Context, userID int32) error
 	DeleteCompany(ctx context.Context, id int32) error
+	DeleteCompanyInvitation(ctx context.Context, id int64) error
 	DeleteEmployer(ctx context.Context, id int32) error
+	DeleteEmployerRecoveryCodes(ctx context.Context, employerID int32) error
+	DeleteEmployerTOTP(ctx context.Context, employerID int32) error
 	DeleteJob(ctx context.Context, id int32) error
 	DeleteJobApplication(ctx context.Context, id int32) error
 	DeleteJobSkill(ctx context.Context, id int32) error
 	DeleteJobSkillsByJobID(ctx context.Context, jobID int32) error
-	DeleteMultipleJobSkills(ctx context.Context, ids []int32) error
+	DeleteJobTemplate(ctx context.Context, id int32) error
+	DeleteMultipleJobSkills(ctx context.Context, arg DeleteMultipleJobSkillsParams) (int64, error)
 	DeleteMultipleUserSkills(ctx context.Context, ids []int32) error
+	DeletePasswordResets(ctx context.Context, arg DeletePasswordResetsParams) error
+	DeletePendingCompanyInvitations(ctx context.Context, arg DeletePendingCompanyInvitationsParams) error
 	DeleteUser(ctx context.Context, id int32) error
 	DeleteUserSkill(ctx context.Context, id int32) error
+	DeleteVerifyEmail(ctx context.Context, email string) error
+	EnableEmployerTOTP(ctx context.Context, arg EnableEmployerTOTPParams) (EmployerTotp, error)
+	ExpireJobs(ctx context.Context, now time.Time) ([]Job, error)
+	ExportJobApplicationsForEmployer(ctx context.Context, arg ExportJobApplicationsForEmployerParams) ([]ExportJobApplicationsForEmployerRow, error)
+	ExportJobsForEmployer(ctx context.Context, arg ExportJobsForEmployerParams) ([]ExportJobsForEmployerRow, error)
+	GetAdminByEmail(ctx context.Context, email string) (Admin, error)
+	GetAdminByID(ctx context.Context, id int32) (Admin, error)
+	GetCompanyAPIKey(ctx context.Context, id int64) (CompanyApiKey, error)
 	GetCompanyByID(ctx context.Context, id int32) (Company, error)
 	GetCompanyByName(ctx context.Context, name string) (Company, error)
 	GetCompanyIDOfJob(ctx context.Context, id int32) (int32, error)
+	GetCompanyInvitation(ctx context.Context, id int64) (CompanyInvitation, error)
 	GetCompanyNameByID(ctx context.Context, id int32) (string, error)
 	GetEmployerAndCompanyDetails(ctx context.Context, email string) (GetEmployerAndCompanyDetailsRow, error)
 	GetEmployerByEmail(ctx context.Context, email string) (Employer, error)
 	GetEmployerByID(ctx context.Context, id int32) (Employer, error)
+	GetEmployerTOTP(ctx context.Context, employerID int32) (EmployerTotp, error)
+	GetExchangeRate(ctx context.Context, currency string) (ExchangeRate, error)
 	GetJob(ctx context.Context, id int32) (Job, error)
+	// this function will be used by admins only
+	GetJobApplication(ctx context.Context, id int32) (JobApplication, error)
 	// this function will be used by employers
 	GetJobApplicationForEmployer(ctx context.Context, id int32) (GetJobApplicationForEmployerRow, error)
 	// this function will be used by users only
@@ -44,13 +87,29 @@ type Querier interface {
 	GetJobBasicInfo(ctx context.Context, id int32) (GetJobBasicInfoRow, error)
 	GetJobDetails(ctx context.Context, id int32) (GetJobDetailsRow, error)
 	GetJobIDOfJobApplication(ctx context.Context, id int32) (int32, error)
+	GetJobRevision(ctx context.Context, arg GetJobRevisionParams) (JobRevision, error)
+	GetJobRevisionByID(ctx context.Context, id int32) (JobRevision, error)
+	GetJobTemplate(ctx context.Context, id int32) (JobTemplate, error)
+	GetJobViewTotals(ctx context.Context, arg GetJobViewTotalsParams) (GetJobViewTotalsRow, error)
+	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id int32) (User, error)
+	ListActiveSessionsBySubject(ctx context.Context, arg ListActiveSessionsBySubjectParams) ([]Session, error)
+	ListAdminAuditLogs(ctx context.Context, arg ListAdminAuditLogsParams) ([]AdminAuditLog, error)
 	ListAllJobSkillsByJobID(ctx context.Context, jobID int32) ([]string, error)
 	ListAllJobsForES(ctx context.Context) ([]ListAllJobsForESRow, error)
+	ListCompanyAPIKeys(ctx context.Context, companyID int32) ([]CompanyApiKey, error)
+	ListCompanyEmployers(ctx context.Context, companyID int32) ([]Employer, error)
+	ListCompanyInvitations(ctx context.Context, companyID int32) ([]CompanyInvitation, error)
+	ListCompanyJobsForES(ctx context.Context, companyID int32) ([]Job, error)
+	ListExchangeRates(ctx context.Context) ([]ExchangeRate, error)
+	ListJobApplicationCountsByPeriod(ctx context.Context, arg ListJobApplicationCountsByPeriodParams) ([]ListJobApplicationCountsByPeriodRow, error)
 	ListJobApplicationsForEmployer(ctx context.Context, arg ListJobApplicationsForEmployerParams) ([]ListJobApplicationsForEmployerRow, error)
 	ListJobApplicationsForUser(ctx context.Context, arg ListJobApplicationsForUserParams) ([]ListJobApplicationsForUserRow, error)
+	ListJobRevisions(ctx context.Context, jobID int32) ([]JobRevision, error)
 	ListJobSkillsByJobID(ctx context.Context, arg ListJobSkillsByJobIDParams) ([]ListJobSkillsByJobIDRow, error)
+	ListJobTemplates(ctx context.Context, companyID int32) ([]JobTemplate, error)
+	ListJobViewsByPeriod(ctx context.Context, arg ListJobViewsByPeriodParams) ([]ListJobViewsByPeriodRow, error)
 	ListJobsByCompanyExactName(ctx context.Context, arg ListJobsByCompanyExactNameParams) ([]ListJobsByCompanyExactNameRow, error)
 	ListJobsByCompanyID(ctx context.Context, arg ListJobsByCompanyIDParams) ([]ListJobsByCompanyIDRow, error)
 	ListJobsByCompanyName(ctx context.Context, arg ListJobsByCompanyNameParams) ([]ListJobsByCompanyNameRow, error)
@@ -60,20 +119,51 @@ type Querier interface {
 	ListJobsBySkill(ctx context.Context, arg ListJobsBySkillParams) ([]int32, error)
 	ListJobsByTitle(ctx context.Context, arg ListJobsByTitleParams) ([]Job, error)
 	ListJobsForEmployer(ctx context.Context, arg ListJobsForEmployerParams) ([]ListJobsForEmployerRow, error)
+	// the match score is the sum of the scores of the job, up to 100 points:
+	// skills up to 50, desired title 20, industry 10, location 10 and salary range 10
 	ListJobsMatchingUserSkills(ctx context.Context, arg ListJobsMatchingUserSkillsParams) ([]ListJobsMatchingUserSkillsRow, error)
 	ListUserSkills(ctx context.Context, arg ListUserSkillsParams) ([]UserSkill, error)
 	ListUsersBySkill(ctx context.Context, arg ListUsersBySkillParams) ([]User, error)
+	// marks the published jobs expiring until notify_before, so the employers are notified only once
+	MarkJobsExpiryNotified(ctx context.Context, arg MarkJobsExpiryNotifiedParams) ([]Job, error)
+	// drafts of suspended companies and drafts unpublished by an admin wait until they are restored
+	PublishScheduledJobs(ctx context.Context, now time.Time) ([]Job, error)
+	RecordJobImpressions(ctx context.Context, arg RecordJobImpressionsParams) error
+	RecordJobView(ctx context.Context, arg RecordJobViewParams) error
+	RestoreCompany(ctx context.Context, id int32) (Company, error)
+	RestoreEmployer(ctx context.Context, id int32) (Employer, error)
+	RestoreUser(ctx context.Context, id int32) (User, error)
+	RevokeCompanyAPIKey(ctx context.Context, id int64) (CompanyApiKey, error)
+	SearchCompanies(ctx context.Context, arg SearchCompaniesParams) ([]Company, error)
+	SearchEmployers(ctx context.Context, arg SearchEmployersParams) ([]Employer, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	SuspendCompany(ctx context.Context, id int32) (Company, error)
+	SuspendEmployer(ctx context.Context, id int32) (Employer, error)
+	SuspendUser(ctx context.Context, id int32) (User, error)
+	UnpublishJob(ctx context.Context, id int32) (Job, error)
 	UpdateCompany(ctx context.Context, arg UpdateCompanyParams) (Company, error)
 	UpdateEmployer(ctx context.Context, arg UpdateEmployerParams) (Employer, error)
 	UpdateEmployerPassword(ctx context.Context, arg UpdateEmployerPasswordParams) error
+	UpdateEmployerRole(ctx context.Context, arg UpdateEmployerRoleParams) (Employer, error)
 	UpdateJob(ctx context.Context, arg UpdateJobParams) (Job, error)
 	UpdateJobApplication(ctx context.Context, arg UpdateJobApplicationParams) (JobApplication, error)
 	UpdateJobApplicationNote(ctx context.Context, arg UpdateJobApplicationNoteParams) error
 	UpdateJobApplicationStatus(ctx context.Context, arg UpdateJobApplicationStatusParams) error
 	UpdateJobSkill(ctx context.Context, arg UpdateJobSkillParams) (JobSkill, error)
+	UpdateJobStatus(ctx context.Context, arg UpdateJobStatusParams) (Job, error)
+	UpdateJobTemplate(ctx context.Context, arg UpdateJobTemplateParams) (JobTemplate, error)
 	UpdatePassword(ctx context.Context, arg UpdatePasswordParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateUserSkill(ctx context.Context, arg UpdateUserSkillParams) (UserSkill, error)
+	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertEmployerTOTP(ctx context.Context, arg UpsertEmployerTOTPParams) (EmployerTotp, error)
+	UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) (ExchangeRate, error)
+	UseCompanyAPIKey(ctx context.Context, hashedKey string) (UseCompanyAPIKeyRow, error)
+	UseEmployerRecoveryCode(ctx context.Context, arg UseEmployerRecoveryCodeParams) (EmployerRecoveryCode, error)
+	UseEmployerTOTPStep(ctx context.Context, arg UseEmployerTOTPStepParams) (EmployerTotp, error)
+	UsePasswordReset(ctx context.Context, arg UsePasswordResetParams) (PasswordReset, error)
+	VerifyEmployerEmail(ctx context.Context, email string) (Employer, error)
+	VerifyUserEmail(ctx context.Context, email string) (User, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"github.com/lib/pq"
 	"time"
 )
 
@@ -13,11 +14,24 @@ type Store interface {
 	CreateMultipleJobSkills(ctx context.Context, skills []string, jobID int32) error
 	DeleteJobPosting(ctx context.Context, jobID int32) error
 	GetUserDetailsByEmail(ctx context.Context, email string) (User, []UserSkill, error)
+	GetUserDetailsByID(ctx context.Context, id int32) (User, []UserSkill, error)
 	ListJobsByFilters(ctx context.Context, arg ListJobsByFiltersParams) ([]ListJobsByFiltersRow, error)
+	CountJobsByFilters(ctx context.Context, arg ListJobsByFiltersParams) (int64, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	CreateEmployerTx(ctx context.Context, arg CreateEmployerTxParams) (CreateEmployerTxResult, error)
+	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	CreatePasswordResetTx(ctx context.Context, arg CreatePasswordResetTxParams) (CreatePasswordResetTxResult, error)
+	ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error)
+	EnableEmployerTOTPTx(ctx context.Context, arg EnableEmployerTOTPTxParams) (EnableEmployerTOTPTxResult, error)
+	DisableEmployerTOTPTx(ctx context.Context, employerID int32) error
+	CreateCompanyInvitationTx(ctx context.Context, arg CreateCompanyInvitationTxParams) (CreateCompanyInvitationTxResult, error)
+	JoinCompanyTx(ctx context.Context, arg JoinCompanyTxParams) (JoinCompanyTxResult, error)
+	AdminActionTx(ctx context.Context, arg AdminActionTxParams) (AdminActionTxResult, error)
 	ExecTx(ctx context.Context, fn func(*Queries) error) error
 	CreateJobApplicationTx(ctx context.Context, arg CreateJobApplicationTxParams) (CreateJobApplicationTxResult, error)
+	ImportJobsTx(ctx context.Context, arg ImportJobsTxParams) (ImportJobsTxResult, error)
+	CreateJobTx(ctx context.Context, arg CreateJobTxParams) (CreateJobTxResult, error)
+	UpdateJobTx(ctx context.Context, arg UpdateJobTxParams) (UpdateJobTxResult, error)
 	LoadTestData(ctx context.Context)
 }
 
@@ -102,6 +116,21 @@ func (store *SQLStore) GetUserDetailsByEmail(ctx context.Context, email string)
 		return User{}, nil, err
 	}
 
+	return store.getUserDetails(ctx, user)
+}
+
+// GetUserDetailsByID gets user details (user, user skills) by the ID of the user
+func (store *SQLStore) GetUserDetailsByID(ctx context.Context, id int32) (User, []UserSkill, error) {
+	user, err := store.GetUserByID(ctx, id)
+	if err != nil {
+		return User{}, nil, err
+	}
+
+	return store.getUserDetails(ctx, user)
+}
+
+// getUserDetails gets the skills of the user
+func (store *SQLStore) getUserDetails(ctx context.Context, user User) (User, []UserSkill, error) {
 	params := ListUserSkillsParams{
 		UserID: user.ID,
 		Limit:  10,
@@ -115,54 +144,149 @@ func (store *SQLStore) GetUserDetailsByEmail(ctx context.Context, email string)
 	return user, userSkills, nil
 }
 
+// jobsByFiltersConditions are shared by ListJobsByFilters and CountJobsByFilters,
+// parameters $1 - $13 are the filters. Empty or nil arrays of locations,
+// industries and skills do not filter the jobs.
+const jobsByFiltersConditions = `($1::text IS NULL OR j.title ILIKE '%' || $1 || '%')
+  AND (coalesce(cardinality($2::text[]), 0) = 0 OR j.location = ANY ($2::text[]))
+  AND (coalesce(cardinality($3::text[]), 0) = 0 OR j.industry = ANY ($3::text[]))
+  AND ($4::int IS NULL OR normalize_salary(j.salary_min, j.salary_currency, j.salary_period) >=
+                           normalize_salary($4, $9::char(3), $10::salary_period))
+  AND ($5::int IS NULL OR normalize_salary(j.salary_max, j.salary_currency, j.salary_period) <=
+                           normalize_salary($5, $9::char(3), $10::salary_period))
+  AND ($6::employment_type IS NULL OR j.employment_type = $6)
+  AND ($7::work_mode IS NULL OR j.work_mode = $7)
+  AND ($8::seniority_level IS NULL OR j.seniority_level = $8)
+  -- the job requires any of the skills, or all of them if $12 is true
+  AND (coalesce(cardinality($11::text[]), 0) = 0
+    OR NOT $12::bool AND EXISTS (SELECT 1
+                                 FROM job_skills js
+                                 WHERE js.job_id = j.id
+                                   AND js.skill = ANY ($11::text[]))
+    OR $12::bool AND (SELECT count(DISTINCT js.skill)
+                      FROM job_skills js
+                      WHERE js.job_id = j.id
+                        AND js.skill = ANY ($11::text[])) = cardinality($11::text[]))
+  AND ($13::timestamptz IS NULL OR j.created_at >= $13)
+  AND j.status = 'published'
+  AND (j.expires_at IS NULL OR j.expires_at > now())
+  AND j.deleted_at IS NULL
+  AND j.unpublished_at IS NULL
+  AND c.suspended_at IS NULL`
+
 // This function could not be implemented using sqlc.
 // Because of that, it is implemented manually.
 const listJobsByFilters = `-- name: ListJobsByFilters :many
 SELECT j.id, j.title, j.industry, j.company_id, j.description, j.location, j.salary_min, j.salary_max, j.requirements, j.created_at,
+       j.employment_type, j.work_mode, j.seniority_level, j.salary_currency, j.salary_period,
        c.name AS company_name
 FROM jobs j
          JOIN companies c ON j.company_id = c.id
-WHERE ($3::text IS NULL OR j.title ILIKE '%' || $3 || '%')
-  AND ($4::text IS NULL OR j.location = $4)
-  AND ($5::text IS NULL OR j.industry = $5)
-  AND ($6::int IS NULL OR j.salary_min >= $6)
-  AND ($7::int IS NULL OR j.salary_max <= $7)
-LIMIT $1 OFFSET $2
+WHERE ` + jobsByFiltersConditions + `
+  -- keyset pagination, only jobs after the cursor in the requested order,
+  -- the cursor keeps the salary or the company name of its job as they were on the previous page
+  AND ($14::timestamptz IS NULL
+    OR $17::text = 'newest' AND (j.created_at, j.id) < ($14, $15::int)
+    OR $17::text = 'oldest' AND (j.created_at, j.id) > ($14, $15::int)
+    OR $17::text = 'salary-desc' AND (normalize_salary(j.salary_max, j.salary_currency, j.salary_period), j.id) <
+                                     (normalize_salary($18::int, $19::char(3), $20::salary_period), $15::int)
+    OR $17::text = 'salary-asc' AND (normalize_salary(j.salary_min, j.salary_currency, j.salary_period), j.id) >
+                                    (normalize_salary($18::int, $19::char(3), $20::salary_period), $15::int)
+    OR $17::text = 'company' AND (c.name, j.id) > ($21::text, $15::int))
+ORDER BY CASE WHEN $17::text = 'newest' THEN j.created_at END DESC,
+         CASE WHEN $17::text = 'oldest' THEN j.created_at END ASC,
+         CASE WHEN $17::text = 'salary-desc' THEN normalize_salary(j.salary_max, j.salary_currency, j.salary_period) END DESC,
+         CASE WHEN $17::text = 'salary-asc' THEN normalize_salary(j.salary_min, j.salary_currency, j.salary_period) END ASC,
+         CASE WHEN $17::text = 'company' THEN c.name END ASC,
+         -- the id makes the order stable across pages if the values of the sort are equal
+         CASE WHEN $17::text IN ('newest', 'salary-desc') THEN j.id END DESC,
+         CASE WHEN $17::text IN ('oldest', 'salary-asc', 'company') THEN j.id END ASC
+LIMIT $16
+`
+
+const countJobsByFilters = `-- name: CountJobsByFilters :one
+SELECT count(*)
+FROM jobs j
+         JOIN companies c ON j.company_id = c.id
+WHERE ` + jobsByFiltersConditions + `
 `
 
 type ListJobsByFiltersParams struct {
-	Limit       int32          `json:"limit"`
-	Offset      int32          `json:"offset"`
-	Title       sql.NullString `json:"title"`
-	JobLocation sql.NullString `json:"job_location"`
-	Industry    sql.NullString `json:"industry"`
-	SalaryMin   sql.NullInt32  `json:"salary_min"`
-	SalaryMax   sql.NullInt32  `json:"salary_max"`
+	Limit          int32              `json:"limit"`
+	Title          sql.NullString     `json:"title"`
+	Locations      []string           `json:"locations"`
+	Industries     []string           `json:"industries"`
+	SalaryMin      sql.NullInt32      `json:"salary_min"`
+	SalaryMax      sql.NullInt32      `json:"salary_max"`
+	EmploymentType NullEmploymentType `json:"employment_type"`
+	WorkMode       NullWorkMode       `json:"work_mode"`
+	SeniorityLevel NullSeniorityLevel `json:"seniority_level"`
+	// currency and period of SalaryMin and SalaryMax, salaries of jobs
+	// are converted with the exchange rates before they are compared
+	SalaryCurrency string       `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod `json:"salary_period"`
+	// skills must not contain duplicates, all of them are required
+	// if MatchAllSkills is true, otherwise any of them
+	Skills         []string     `json:"skills"`
+	MatchAllSkills bool         `json:"match_all_skills"`
+	CreatedAfter   sql.NullTime `json:"created_after"`
+	// created_at and id of the last job of the previous page,
+	// the first page is returned if CursorCreatedAt is not valid
+	CursorCreatedAt sql.NullTime `json:"cursor_created_at"`
+	CursorID        int32        `json:"cursor_id"`
+	// salary of the last job of the previous page with its currency and period,
+	// the minimum for salary-asc and the maximum for salary-desc
+	CursorSalary         sql.NullInt32    `json:"cursor_salary"`
+	CursorSalaryCurrency sql.NullString   `json:"cursor_salary_currency"`
+	CursorSalaryPeriod   NullSalaryPeriod `json:"cursor_salary_period"`
+	// company name of the last job of the previous page for the company sort
+	CursorCompanyName sql.NullString `json:"cursor_company_name"`
+	// one of newest, oldest, salary-desc, salary-asc or company
+	Sort string `json:"sort"`
 }
 
 type ListJobsByFiltersRow struct {
-	ID           int32     `json:"id"`
-	Title        string    `json:"title"`
-	Industry     string    `json:"industry"`
-	CompanyID    int32     `json:"company_id"`
-	Description  string    `json:"description"`
-	Location     string    `json:"location"`
-	SalaryMin    int32     `json:"salary_min"`
-	SalaryMax    int32     `json:"salary_max"`
-	Requirements string    `json:"requirements"`
-	CreatedAt    time.Time `json:"created_at"`
-	CompanyName  string    `json:"company_name"`
+	ID             int32          `json:"id"`
+	Title          string         `json:"title"`
+	Industry       string         `json:"industry"`
+	CompanyID      int32          `json:"company_id"`
+	Description    string         `json:"description"`
+	Location       string         `json:"location"`
+	SalaryMin      int32          `json:"salary_min"`
+	SalaryMax      int32          `json:"salary_max"`
+	Requirements   string         `json:"requirements"`
+	CreatedAt      time.Time      `json:"created_at"`
+	EmploymentType EmploymentType `json:"employment_type"`
+	WorkMode       WorkMode       `json:"work_mode"`
+	SeniorityLevel SeniorityLevel `json:"seniority_level"`
+	SalaryCurrency string         `json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod   `json:"salary_period"`
+	CompanyName    string         `json:"company_name"`
 }
 
 func (store *SQLStore) ListJobsByFilters(ctx context.Context, arg ListJobsByFiltersParams) ([]ListJobsByFiltersRow, error) {
 	rows, err := store.db.QueryContext(ctx, listJobsByFilters,
-		arg.Limit,
-		arg.Offset,
 		arg.Title,
-		arg.JobLocation,
-		arg.Industry,
+		pq.Array(arg.Locations),
+		pq.Array(arg.Industries),
 		arg.SalaryMin,
 		arg.SalaryMax,
+		arg.EmploymentType,
+		arg.WorkMode,
+		arg.SeniorityLevel,
+		arg.SalaryCurrency,
+		arg.SalaryPeriod,
+		pq.Array(arg.Skills),
+		arg.MatchAllSkills,
+		arg.CreatedAfter,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+		arg.Sort,
+		arg.CursorSalary,
+		arg.CursorSalaryCurrency,
+		arg.CursorSalaryPeriod,
+		arg.CursorCompanyName,
 	)
 	if err != nil {
 		return nil, err
@@ -182,6 +306,11 @@ func (store *SQLStore) ListJobsByFilters(ctx context.Context, arg ListJobsByFilt
 			&i.SalaryMax,
 			&i.Requirements,
 			&i.CreatedAt,
+			&i.EmploymentType,
+			&i.WorkMode,
+			&i.SeniorityLevel,
+			&i.SalaryCurrency,
+			&i.SalaryPeriod,
 			&i.CompanyName,
 		); err != nil {
 			return nil, err
@@ -197,6 +326,29 @@ func (store *SQLStore) ListJobsByFilters(ctx context.Context, arg ListJobsByFilt
 	return items, nil
 }
 
+// CountJobsByFilters counts all jobs matching the filters of arg,
+// the limit, the cursor and the sort are ignored
+func (store *SQLStore) CountJobsByFilters(ctx context.Context, arg ListJobsByFiltersParams) (int64, error) {
+	row := store.db.QueryRowContext(ctx, countJobsByFilters,
+		arg.Title,
+		pq.Array(arg.Locations),
+		pq.Array(arg.Industries),
+		arg.SalaryMin,
+		arg.SalaryMax,
+		arg.EmploymentType,
+		arg.WorkMode,
+		arg.SeniorityLevel,
+		arg.SalaryCurrency,
+		arg.SalaryPeriod,
+		pq.Array(arg.Skills),
+		arg.MatchAllSkills,
+		arg.CreatedAfter,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 // ExecTx executes a function within a database transaction
 func (store *SQLStore) ExecTx(ctx context.Context, fn func(*Queries) error) error {
 	tx, err := store.db.BeginTx(ctx, nil)
@@ -0,0 +1,34 @@
+package db
+
+import "context"
+
+type AdminActionTxParams struct {
+	CreateAdminAuditLogParams
+	// Action is executed in the same transaction as writing the audit log,
+	// so the action is never done without being logged
+	Action func(q Querier) error
+}
+
+type AdminActionTxResult struct {
+	AdminAuditLog AdminAuditLog
+}
+
+// AdminActionTx executes the action of an admin and writes it to the audit log,
+// if the action fails nothing is logged
+func (store *SQLStore) AdminActionTx(ctx context.Context, arg AdminActionTxParams) (AdminActionTxResult, error) {
+	var result AdminActionTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		err = arg.Action(q)
+		if err != nil {
+			return err
+		}
+
+		result.AdminAuditLog, err = q.CreateAdminAuditLog(ctx, arg.CreateAdminAuditLogParams)
+		return err
+	})
+
+	return result, err
+}
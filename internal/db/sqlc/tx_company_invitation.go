@@ -0,0 +1,87 @@
+package db
+
+import "context"
+
+type CreateCompanyInvitationTxParams struct {
+	CreateCompanyInvitationParams
+	AfterCreate func(invitation CompanyInvitation) error
+}
+
+type CreateCompanyInvitationTxResult struct {
+	CompanyInvitation CompanyInvitation
+}
+
+// CreateCompanyInvitationTx creates a new invitation to join the company,
+// pending invitations sent before to the same email cannot be used anymore
+func (store *SQLStore) CreateCompanyInvitationTx(ctx context.Context, arg CreateCompanyInvitationTxParams) (CreateCompanyInvitationTxResult, error) {
+	var result CreateCompanyInvitationTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		err = q.DeletePendingCompanyInvitations(ctx, DeletePendingCompanyInvitationsParams{
+			CompanyID: arg.CompanyID,
+			Email:     arg.Email,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.CompanyInvitation, err = q.CreateCompanyInvitation(ctx, arg.CreateCompanyInvitationParams)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterCreate(result.CompanyInvitation)
+	})
+
+	return result, err
+}
+
+type JoinCompanyTxParams struct {
+	HashedCode     string
+	Email          string
+	FullName       string
+	HashedPassword string
+}
+
+type JoinCompanyTxResult struct {
+	Employer          Employer
+	CompanyInvitation CompanyInvitation
+}
+
+// JoinCompanyTx accepts the invitation and creates an employer in the company
+// with the role from the invitation. The invitation was sent to the email
+// address, so it is marked as verified. sql.ErrNoRows is returned
+// if the invitation code is invalid, already used or expired.
+func (store *SQLStore) JoinCompanyTx(ctx context.Context, arg JoinCompanyTxParams) (JoinCompanyTxResult, error) {
+	var result JoinCompanyTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.CompanyInvitation, err = q.AcceptCompanyInvitation(ctx, AcceptCompanyInvitationParams{
+			HashedCode: arg.HashedCode,
+			Email:      arg.Email,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreateEmployer(ctx, CreateEmployerParams{
+			CompanyID:      result.CompanyInvitation.CompanyID,
+			FullName:       arg.FullName,
+			Email:          arg.Email,
+			HashedPassword: arg.HashedPassword,
+			Role:           result.CompanyInvitation.Role,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Employer, err = q.VerifyEmployerEmail(ctx, arg.Email)
+		return err
+	})
+
+	return result, err
+}
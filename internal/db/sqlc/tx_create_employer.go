@@ -4,13 +4,16 @@ import "context"
 
 type CreateEmployerTxParams struct {
 	CreateEmployerParams
-	AfterCreate func(employer Employer) error
+	AfterCreate func(employer Employer, verifyEmail VerifyEmail) error
 }
 
 type CreateEmployerTxResult struct {
-	Employer Employer
+	Employer    Employer
+	VerifyEmail VerifyEmail
 }
 
+// CreateEmployerTx creates an employer together with a verify email record
+// holding the secret code that has to be sent to the employer
 func (store *SQLStore) CreateEmployerTx(ctx context.Context, arg CreateEmployerTxParams) (CreateEmployerTxResult, error) {
 	var result CreateEmployerTxResult
 
@@ -22,7 +25,12 @@ func (store *SQLStore) CreateEmployerTx(ctx context.Context, arg CreateEmployerT
 			return err
 		}
 
-		return arg.AfterCreate(result.Employer)
+		result.VerifyEmail, err = newVerifyEmail(ctx, q, result.Employer.Email)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterCreate(result.Employer, result.VerifyEmail)
 	})
 
 	return result, err
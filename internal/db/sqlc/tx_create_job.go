@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type CreateJobTxParams struct {
+	CreateJobParams
+	RequiredSkills []string
+	// AuthorID is the employer who creates the job, the author of its first revision
+	AuthorID int32
+}
+
+type CreateJobTxResult struct {
+	Job Job
+}
+
+// CreateJobTx creates a job with its skills and its first revision,
+// nothing is created if any of them cannot be created
+func (store *SQLStore) CreateJobTx(ctx context.Context, arg CreateJobTxParams) (CreateJobTxResult, error) {
+	var result CreateJobTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Job, err = createJobWithRevision(ctx, q, arg.CreateJobParams, arg.RequiredSkills, arg.AuthorID)
+		return err
+	})
+
+	return result, err
+}
+
+// createJobWithRevision creates a job with its skills and its first revision with q of a transaction
+func createJobWithRevision(ctx context.Context, q *Queries, arg CreateJobParams, skills []string, authorID int32) (Job, error) {
+	job, err := q.CreateJob(ctx, arg)
+	if err != nil {
+		return Job{}, err
+	}
+
+	for _, skill := range skills {
+		_, err = q.CreateJobSkill(ctx, CreateJobSkillParams{
+			Skill: skill,
+			JobID: job.ID,
+		})
+		if err != nil {
+			return Job{}, err
+		}
+	}
+
+	_, err = q.CreateJobRevision(ctx, CreateJobRevisionParams{
+		AuthorID: sql.NullInt32{Int32: authorID, Valid: true},
+		JobID:    job.ID,
+	})
+	if err != nil {
+		return Job{}, err
+	}
+
+	return job, nil
+}
@@ -2,17 +2,21 @@ package db
 
 import (
 	"context"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
 )
 
 type CreateUserTxParams struct {
 	CreateUserParams
-	AfterCreate func(user User) error
+	AfterCreate func(user User, verifyEmail VerifyEmail) error
 }
 
 type CreateUserTxResult struct {
-	User User
+	User        User
+	VerifyEmail VerifyEmail
 }
 
+// CreateUserTx creates a user together with a verify email record
+// holding the secret code that has to be sent to the user
 func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error) {
 	var result CreateUserTxResult
 
@@ -23,7 +27,32 @@ func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 		if err != nil {
 			return err
 		}
-		return arg.AfterCreate(result.User)
+
+		result.VerifyEmail, err = newVerifyEmail(ctx, q, result.User.Email)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterCreate(result.User, result.VerifyEmail)
 	})
 	return result, err
 }
+
+// newVerifyEmail creates a new verify email record with a random secret code,
+// replacing a previous one (e.g. left after a deleted account) for the same email
+func newVerifyEmail(ctx context.Context, q *Queries, email string) (VerifyEmail, error) {
+	secretCode, err := utils.RandomSecret(32)
+	if err != nil {
+		return VerifyEmail{}, err
+	}
+
+	err = q.DeleteVerifyEmail(ctx, email)
+	if err != nil {
+		return VerifyEmail{}, err
+	}
+
+	return q.CreateVerifyEmail(ctx, CreateVerifyEmailParams{
+		Email:      email,
+		SecretCode: secretCode,
+	})
+}
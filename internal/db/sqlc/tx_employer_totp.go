@@ -0,0 +1,59 @@
+package db
+
+import "context"
+
+type EnableEmployerTOTPTxParams struct {
+	EnableEmployerTOTPParams
+	HashedRecoveryCodes []string
+}
+
+type EnableEmployerTOTPTxResult struct {
+	EmployerTotp EmployerTotp
+}
+
+// EnableEmployerTOTPTx enables two-factor authentication of the employer
+// and replaces the recovery codes with new ones
+func (store *SQLStore) EnableEmployerTOTPTx(ctx context.Context, arg EnableEmployerTOTPTxParams) (EnableEmployerTOTPTxResult, error) {
+	var result EnableEmployerTOTPTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.EmployerTotp, err = q.EnableEmployerTOTP(ctx, arg.EnableEmployerTOTPParams)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteEmployerRecoveryCodes(ctx, arg.EmployerID)
+		if err != nil {
+			return err
+		}
+
+		for _, hashedCode := range arg.HashedRecoveryCodes {
+			_, err = q.CreateEmployerRecoveryCode(ctx, CreateEmployerRecoveryCodeParams{
+				EmployerID: arg.EmployerID,
+				HashedCode: hashedCode,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// DisableEmployerTOTPTx disables two-factor authentication of the employer
+// and deletes the secret and the recovery codes
+func (store *SQLStore) DisableEmployerTOTPTx(ctx context.Context, employerID int32) error {
+	return store.ExecTx(ctx, func(q *Queries) error {
+		err := q.DeleteEmployerRecoveryCodes(ctx, employerID)
+		if err != nil {
+			return err
+		}
+
+		return q.DeleteEmployerTOTP(ctx, employerID)
+	})
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+)
+
+type ImportJobParams struct {
+	CreateJobParams
+	RequiredSkills []string
+}
+
+type ImportJobsTxParams struct {
+	Jobs []ImportJobParams
+	// AuthorID is the employer who imports the jobs, the author of their first revisions
+	AuthorID int32
+}
+
+type ImportJobsTxResult struct {
+	// Jobs are in the order of the imported jobs
+	Jobs []Job
+}
+
+// ImportJobsTx creates jobs with their skills and first revisions,
+// if any of them cannot be created, none of the jobs are created
+func (store *SQLStore) ImportJobsTx(ctx context.Context, arg ImportJobsTxParams) (ImportJobsTxResult, error) {
+	var result ImportJobsTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		for _, importedJob := range arg.Jobs {
+			job, err := createJobWithRevision(ctx, q, importedJob.CreateJobParams, importedJob.RequiredSkills, arg.AuthorID)
+			if err != nil {
+				return err
+			}
+
+			result.Jobs = append(result.Jobs, job)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// account types of password resets
+const (
+	AccountTypeUser     = "user"
+	AccountTypeEmployer = "employer"
+)
+
+type CreatePasswordResetTxParams struct {
+	CreatePasswordResetParams
+	AfterCreate func(passwordReset PasswordReset) error
+}
+
+type CreatePasswordResetTxResult struct {
+	PasswordReset PasswordReset
+}
+
+// CreatePasswordResetTx creates a new password reset, codes
+// requested before for the same account cannot be used anymore
+func (store *SQLStore) CreatePasswordResetTx(ctx context.Context, arg CreatePasswordResetTxParams) (CreatePasswordResetTxResult, error) {
+	var result CreatePasswordResetTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		err = q.DeletePasswordResets(ctx, DeletePasswordResetsParams{
+			Email:       arg.Email,
+			AccountType: arg.AccountType,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.PasswordReset, err = q.CreatePasswordReset(ctx, arg.CreatePasswordResetParams)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterCreate(result.PasswordReset)
+	})
+
+	return result, err
+}
+
+type ResetPasswordTxParams struct {
+	UsePasswordResetParams
+	HashedPassword string
+}
+
+type ResetPasswordTxResult struct {
+	PasswordReset PasswordReset
+}
+
+// ResetPasswordTx marks the reset code as used, sets the new password
+// and blocks all sessions of the account. sql.ErrNoRows is returned
+// if the code is invalid, already used or expired.
+func (store *SQLStore) ResetPasswordTx(ctx context.Context, arg ResetPasswordTxParams) (ResetPasswordTxResult, error) {
+	var result ResetPasswordTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.PasswordReset, err = q.UsePasswordReset(ctx, arg.UsePasswordResetParams)
+		if err != nil {
+			return err
+		}
+
+		switch result.PasswordReset.AccountType {
+		case AccountTypeUser:
+			err = q.UpdatePassword(ctx, UpdatePasswordParams{
+				ID:             result.PasswordReset.AccountID,
+				HashedPassword: arg.HashedPassword,
+			})
+		case AccountTypeEmployer:
+			err = q.UpdateEmployerPassword(ctx, UpdateEmployerPasswordParams{
+				ID:             result.PasswordReset.AccountID,
+				HashedPassword: arg.HashedPassword,
+			})
+		default:
+			err = fmt.Errorf("unknown account type %q", result.PasswordReset.AccountType)
+		}
+		if err != nil {
+			return err
+		}
+
+		// the account types are the roles of the tokens and the sessions
+		return q.BlockSessionsBySubject(ctx, BlockSessionsBySubjectParams{
+			Role:      result.PasswordReset.AccountType,
+			SubjectID: result.PasswordReset.AccountID,
+		})
+	})
+
+	return result, err
+}
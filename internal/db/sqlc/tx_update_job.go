@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type UpdateJobTxParams struct {
+	UpdateJobParams
+	// RequiredSkillIDsToRemove must not contain duplicates
+	RequiredSkillIDsToRemove []int32
+	RequiredSkillsToAdd      []string
+	// AuthorID is the employer who updates the job, the author of the new revision
+	AuthorID int32
+}
+
+type UpdateJobTxResult struct {
+	Job Job
+}
+
+// UpdateJobTx updates a job and its skills and stores them as a new revision,
+// nothing is changed if any of them cannot be changed. The job is updated first,
+// so concurrent updates of the same job wait for each other and get the next revision.
+// sql.ErrNoRows is returned if any of the skills to remove does not belong to the job
+func (store *SQLStore) UpdateJobTx(ctx context.Context, arg UpdateJobTxParams) (UpdateJobTxResult, error) {
+	var result UpdateJobTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Job, err = q.UpdateJob(ctx, arg.UpdateJobParams)
+		if err != nil {
+			return err
+		}
+
+		if len(arg.RequiredSkillIDsToRemove) > 0 {
+			deleted, err := q.DeleteMultipleJobSkills(ctx, DeleteMultipleJobSkillsParams{
+				IDs:   arg.RequiredSkillIDsToRemove,
+				JobID: arg.ID,
+			})
+			if err != nil {
+				return err
+			}
+
+			// skills of other jobs are not deleted, the revision must match the change
+			if deleted != int64(len(arg.RequiredSkillIDsToRemove)) {
+				return sql.ErrNoRows
+			}
+		}
+
+		for _, skill := range arg.RequiredSkillsToAdd {
+			_, err = q.CreateJobSkill(ctx, CreateJobSkillParams{
+				Skill: skill,
+				JobID: result.Job.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = q.CreateJobRevision(ctx, CreateJobRevisionParams{
+			AuthorID: sql.NullInt32{Int32: arg.AuthorID, Valid: true},
+			JobID:    result.Job.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
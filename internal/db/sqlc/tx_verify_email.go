@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type VerifyEmailTxParams struct {
+	EmailID    int64
+	SecretCode string
+}
+
+type VerifyEmailTxResult struct {
+	VerifyEmail VerifyEmail
+	// IsUser and IsEmployer tell which accounts with this email were verified
+	IsUser     bool
+	IsEmployer bool
+}
+
+// VerifyEmailTx marks the verify email record as used and verifies the email
+// of the user and/or employer with this email. sql.ErrNoRows is returned if
+// the code is invalid, already used or expired, or no account has this email.
+func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error) {
+	var result VerifyEmailTxResult
+
+	err := store.ExecTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.VerifyEmail, err = q.UpdateVerifyEmail(ctx, UpdateVerifyEmailParams{
+			ID:         arg.EmailID,
+			SecretCode: arg.SecretCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.VerifyUserEmail(ctx, result.VerifyEmail.Email)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		result.IsUser = err == nil
+
+		_, err = q.VerifyEmployerEmail(ctx, result.VerifyEmail.Email)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		result.IsEmployer = err == nil
+
+		if !result.IsUser && !result.IsEmployer {
+			return sql.ErrNoRows
+		}
+
+		return nil
+	})
+
+	return result, err
+}
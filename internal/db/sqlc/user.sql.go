@@ -11,23 +11,25 @@ import (
 
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min,
-                   desired_salary_max, skills, experience, telegram_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-RETURNING id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified
+                   desired_salary_max, skills, experience, telegram_id, desired_salary_currency, desired_salary_period)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified, suspended_at, desired_salary_currency, desired_salary_period
 `
 
 type CreateUserParams struct {
-	FullName         string `json:"full_name"`
-	Email            string `json:"email"`
-	HashedPassword   string `json:"hashed_password"`
-	Location         string `json:"location"`
-	DesiredJobTitle  string `json:"desired_job_title"`
-	DesiredIndustry  string `json:"desired_industry"`
-	DesiredSalaryMin int32  `json:"desired_salary_min"`
-	DesiredSalaryMax int32  `json:"desired_salary_max"`
-	Skills           string `json:"skills"`
-	Experience       string `json:"experience"`
-	TelegramID       string `json:"telegram_id"`
+	FullName              string       `json:"full_name"`
+	Email                 string       `json:"email"`
+	HashedPassword        string       `json:"hashed_password"`
+	Location              string       `json:"location"`
+	DesiredJobTitle       string       `json:"desired_job_title"`
+	DesiredIndustry       string       `json:"desired_industry"`
+	DesiredSalaryMin      int32        `json:"desired_salary_min"`
+	DesiredSalaryMax      int32        `json:"desired_salary_max"`
+	Skills                string       `json:"skills"`
+	Experience            string       `json:"experience"`
+	TelegramID            string       `json:"telegram_id"`
+	DesiredSalaryCurrency string       `json:"desired_salary_currency"`
+	DesiredSalaryPeriod   SalaryPeriod `json:"desired_salary_period"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
@@ -43,6 +45,8 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		arg.Skills,
 		arg.Experience,
 		arg.TelegramID,
+		arg.DesiredSalaryCurrency,
+		arg.DesiredSalaryPeriod,
 	)
 	var i User
 	err := row.Scan(
@@ -60,6 +64,9 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.TelegramID,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.SuspendedAt,
+		&i.DesiredSalaryCurrency,
+		&i.DesiredSalaryPeriod,
 	)
 	return i, err
 }
@@ -76,7 +83,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id int32) error {
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified
+SELECT id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified, suspended_at, desired_salary_currency, desired_salary_period
 FROM users
 WHERE email = $1
 `
@@ -99,12 +106,15 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.TelegramID,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.SuspendedAt,
+		&i.DesiredSalaryCurrency,
+		&i.DesiredSalaryPeriod,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified
+SELECT id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified, suspended_at, desired_salary_currency, desired_salary_period
 FROM users
 WHERE id = $1
 `
@@ -127,6 +137,129 @@ func (q *Queries) GetUserByID(ctx context.Context, id int32) (User, error) {
 		&i.TelegramID,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.SuspendedAt,
+		&i.DesiredSalaryCurrency,
+		&i.DesiredSalaryPeriod,
+	)
+	return i, err
+}
+
+const restoreUser = `-- name: RestoreUser :one
+UPDATE users
+SET suspended_at = NULL
+WHERE id = $1
+RETURNING id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified, suspended_at, desired_salary_currency, desired_salary_period
+`
+
+func (q *Queries) RestoreUser(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRowContext(ctx, restoreUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Email,
+		&i.HashedPassword,
+		&i.Location,
+		&i.DesiredJobTitle,
+		&i.DesiredIndustry,
+		&i.DesiredSalaryMin,
+		&i.DesiredSalaryMax,
+		&i.Skills,
+		&i.Experience,
+		&i.TelegramID,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.SuspendedAt,
+		&i.DesiredSalaryCurrency,
+		&i.DesiredSalaryPeriod,
+	)
+	return i, err
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified, suspended_at, desired_salary_currency, desired_salary_period
+FROM users
+WHERE email ILIKE '%' || $3::text || '%'
+   OR full_name ILIKE '%' || $3::text || '%'
+ORDER BY id
+LIMIT $1 OFFSET $2
+`
+
+type SearchUsersParams struct {
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+	Search string `json:"search"`
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, searchUsers, arg.Limit, arg.Offset, arg.Search)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Email,
+			&i.HashedPassword,
+			&i.Location,
+			&i.DesiredJobTitle,
+			&i.DesiredIndustry,
+			&i.DesiredSalaryMin,
+			&i.DesiredSalaryMax,
+			&i.Skills,
+			&i.Experience,
+			&i.TelegramID,
+			&i.CreatedAt,
+			&i.IsEmailVerified,
+			&i.SuspendedAt,
+			&i.DesiredSalaryCurrency,
+			&i.DesiredSalaryPeriod,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const suspendUser = `-- name: SuspendUser :one
+UPDATE users
+SET suspended_at = now()
+WHERE id = $1
+RETURNING id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified, suspended_at, desired_salary_currency, desired_salary_period
+`
+
+func (q *Queries) SuspendUser(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRowContext(ctx, suspendUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Email,
+		&i.HashedPassword,
+		&i.Location,
+		&i.DesiredJobTitle,
+		&i.DesiredIndustry,
+		&i.DesiredSalaryMin,
+		&i.DesiredSalaryMax,
+		&i.Skills,
+		&i.Experience,
+		&i.TelegramID,
+		&i.CreatedAt,
+		&i.IsEmailVerified,
+		&i.SuspendedAt,
+		&i.DesiredSalaryCurrency,
+		&i.DesiredSalaryPeriod,
 	)
 	return i, err
 }
@@ -158,22 +291,26 @@ SET full_name          = $2,
     desired_salary_max = $8,
     skills             = $9,
     experience         = $10,
-    telegram_id = $10
+    telegram_id = $10,
+    desired_salary_currency = $11,
+    desired_salary_period   = $12
 WHERE id = $1
-RETURNING id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified
+RETURNING id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified, suspended_at, desired_salary_currency, desired_salary_period
 `
 
 type UpdateUserParams struct {
-	ID               int32  `json:"id"`
-	FullName         string `json:"full_name"`
-	Email            string `json:"email"`
-	Location         string `json:"location"`
-	DesiredJobTitle  string `json:"desired_job_title"`
-	DesiredIndustry  string `json:"desired_industry"`
-	DesiredSalaryMin int32  `json:"desired_salary_min"`
-	DesiredSalaryMax int32  `json:"desired_salary_max"`
-	Skills           string `json:"skills"`
-	Experience       string `json:"experience"`
+	ID                    int32        `json:"id"`
+	FullName              string       `json:"full_name"`
+	Email                 string       `json:"email"`
+	Location              string       `json:"location"`
+	DesiredJobTitle       string       `json:"desired_job_title"`
+	DesiredIndustry       string       `json:"desired_industry"`
+	DesiredSalaryMin      int32        `json:"desired_salary_min"`
+	DesiredSalaryMax      int32        `json:"desired_salary_max"`
+	Skills                string       `json:"skills"`
+	Experience            string       `json:"experience"`
+	DesiredSalaryCurrency string       `json:"desired_salary_currency"`
+	DesiredSalaryPeriod   SalaryPeriod `json:"desired_salary_period"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
@@ -188,6 +325,8 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		arg.DesiredSalaryMax,
 		arg.Skills,
 		arg.Experience,
+		arg.DesiredSalaryCurrency,
+		arg.DesiredSalaryPeriod,
 	)
 	var i User
 	err := row.Scan(
@@ -205,6 +344,9 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.TelegramID,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.SuspendedAt,
+		&i.DesiredSalaryCurrency,
+		&i.DesiredSalaryPeriod,
 	)
 	return i, err
 }
@@ -213,7 +355,7 @@ const verifyUserEmail = `-- name: VerifyUserEmail :one
 UPDATE users
 SET is_email_verified = TRUE
 WHERE email = $1
-RETURNING id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified
+RETURNING id, full_name, email, hashed_password, location, desired_job_title, desired_industry, desired_salary_min, desired_salary_max, skills, experience, telegram_id, created_at, is_email_verified, suspended_at, desired_salary_currency, desired_salary_period
 `
 
 func (q *Queries) VerifyUserEmail(ctx context.Context, email string) (User, error) {
@@ -234,6 +376,9 @@ func (q *Queries) VerifyUserEmail(ctx context.Context, email string) (User, erro
 		&i.TelegramID,
 		&i.CreatedAt,
 		&i.IsEmailVerified,
+		&i.SuspendedAt,
+		&i.DesiredSalaryCurrency,
+		&i.DesiredSalaryPeriod,
 	)
 	return i, err
 }
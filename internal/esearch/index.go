@@ -3,47 +3,79 @@ package esearch
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"github.com/elastic/go-elasticsearch/v8/esutil"
 	"io"
 	"log"
+	"net/http"
 	"strconv"
+	"sync"
 )
 
 // IndexJobsAsDocuments index jobs as documents
 func (client ESClient) IndexJobsAsDocuments(ctx context.Context) error {
 	jobs := ctx.Value(JobKey).([]Job)
 
+	failed, err := client.BulkIndexJobs(ctx, jobs)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Jobs indexed on Elasticsearch: %d \n", len(jobs)-len(failed))
+	return nil
+}
+
+// BulkIndexJobs index jobs as documents with the bulk indexer,
+// returns the reasons why jobs could not be indexed by their IDs
+func (client ESClient) BulkIndexJobs(ctx context.Context, jobs []Job) (map[int32]error, error) {
 	bulkIndexer, err := esutil.NewBulkIndexer(esutil.BulkIndexerConfig{
 		Index:      "jobs",
 		Client:     client.client,
 		NumWorkers: 5,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var (
+		failed = make(map[int32]error)
+		mutex  = &sync.Mutex{}
+	)
+
 	for _, document := range jobs {
 		body, err := readerToReadSeeker(esutil.NewJSONReader(document))
 		if err != nil {
-			return err
+			return nil, err
 		}
+
+		jobID := document.ID
 		err = bulkIndexer.Add(
 			ctx,
 			esutil.BulkIndexerItem{
 				Action:     "index",
 				DocumentID: strconv.Itoa(int(document.ID)),
 				Body:       body,
+				// the callbacks are called by the workers concurrently
+				OnFailure: func(ctx context.Context, item esutil.BulkIndexerItem, res esutil.BulkIndexerResponseItem, err error) {
+					if err == nil {
+						err = fmt.Errorf("%s: %s", res.Error.Type, res.Error.Reason)
+					}
+					mutex.Lock()
+					failed[jobID] = err
+					mutex.Unlock()
+				},
 			},
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	bulkIndexer.Close(ctx)
-	biStats := bulkIndexer.Stats()
-	log.Printf("Jobs indexed on Elasticsearch: %d \n", biStats.NumIndexed)
-	return nil
+	if err := bulkIndexer.Close(ctx); err != nil {
+		return nil, err
+	}
+
+	return failed, nil
 }
 
 // IndexJobAsDocument index one job as document
@@ -68,12 +100,18 @@ func (client ESClient) UpdateJobDocument(documentID string, updatedJob Job) erro
 	return nil
 }
 
-// DeleteJobDocument delete document from the index
+// DeleteJobDocument delete document from the index,
+// a document that is not in the index is treated as deleted
 func (client ESClient) DeleteJobDocument(documentID string) error {
-	_, err := client.client.Delete("jobs", documentID)
+	response, err := client.client.Delete("jobs", documentID)
 	if err != nil {
 		return err
 	}
+	defer response.Body.Close()
+
+	if response.IsError() && response.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("cannot delete document %s: %s", documentID, response.Status())
+	}
 	return nil
 }
 
@@ -33,16 +33,25 @@ func LoadJobsFromDB(ctx context.Context, store db.Store) (context.Context, error
 				panic(err)
 			}
 			j := Job{
-				ID:           job.ID,
-				Title:        job.Title,
-				Industry:     job.Industry,
-				CompanyName:  job.CompanyName,
-				Description:  job.Description,
-				Location:     job.Location,
-				SalaryMin:    job.SalaryMin,
-				SalaryMax:    job.SalaryMax,
-				Requirements: job.Requirements,
-				JobSkills:    skills,
+				ID:             job.ID,
+				Title:          job.Title,
+				Industry:       job.Industry,
+				CompanyName:    job.CompanyName,
+				Description:    job.Description,
+				Location:       job.Location,
+				SalaryMin:      job.SalaryMin,
+				SalaryMax:      job.SalaryMax,
+				SalaryCurrency: job.SalaryCurrency,
+				SalaryPeriod:   string(job.SalaryPeriod),
+				Requirements:   job.Requirements,
+				JobSkills:      skills,
+				Status:         string(job.Status),
+				EmploymentType: string(job.EmploymentType),
+				WorkMode:       string(job.WorkMode),
+				SeniorityLevel: string(job.SeniorityLevel),
+			}
+			if job.ExpiresAt.Valid {
+				j.ExpiresAt = &job.ExpiresAt.Time
 			}
 			workQueue <- j
 		}
@@ -8,8 +8,8 @@ import (
 	context "context"
 	reflect "reflect"
 
-	esearch "github.com/grannnsacker/job-finder-back/internal/esearch"
 	gomock "github.com/golang/mock/gomock"
+	esearch "github.com/grannnsacker/job-finder-back/internal/esearch"
 )
 
 // MockESearchClient is a mock of ESearchClient interface.
@@ -35,6 +35,21 @@ func (m *MockESearchClient) EXPECT() *MockESearchClientMockRecorder {
 	return m.recorder
 }
 
+// BulkIndexJobs mocks base method.
+func (m *MockESearchClient) BulkIndexJobs(arg0 context.Context, arg1 []esearch.Job) (map[int32]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkIndexJobs", arg0, arg1)
+	ret0, _ := ret[0].(map[int32]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkIndexJobs indicates an expected call of BulkIndexJobs.
+func (mr *MockESearchClientMockRecorder) BulkIndexJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkIndexJobs", reflect.TypeOf((*MockESearchClient)(nil).BulkIndexJobs), arg0, arg1)
+}
+
 // DeleteJobDocument mocks base method.
 func (m *MockESearchClient) DeleteJobDocument(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -107,18 +122,18 @@ func (mr *MockESearchClientMockRecorder) QueryJobsByDocumentID(arg0 interface{})
 }
 
 // SearchJobs mocks base method.
-func (m *MockESearchClient) SearchJobs(arg0 context.Context, arg1 string, arg2, arg3 int32) ([]*esearch.Job, error) {
+func (m *MockESearchClient) SearchJobs(arg0 context.Context, arg1 string, arg2 esearch.JobFilters, arg3, arg4 int32) ([]*esearch.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchJobs", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "SearchJobs", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]*esearch.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchJobs indicates an expected call of SearchJobs.
-func (mr *MockESearchClientMockRecorder) SearchJobs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockESearchClientMockRecorder) SearchJobs(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobs", reflect.TypeOf((*MockESearchClient)(nil).SearchJobs), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobs", reflect.TypeOf((*MockESearchClient)(nil).SearchJobs), arg0, arg1, arg2, arg3, arg4)
 }
 
 // UpdateJobDocument mocks base method.
@@ -10,10 +10,11 @@ import (
 )
 
 type ESearchClient interface {
-	SearchJobs(ctx context.Context, query string, page, pageSize int32) ([]*Job, error)
+	SearchJobs(ctx context.Context, query string, filters JobFilters, page, pageSize int32) ([]*Job, error)
 	GetDocumentIDByJobID(jobID int) (string, error)
 	IndexJobAsDocument(documentID int, job Job) error
 	IndexJobsAsDocuments(ctx context.Context) error
+	BulkIndexJobs(ctx context.Context, jobs []Job) (map[int32]error, error)
 	UpdateJobDocument(documentID string, updatedJob Job) error
 	DeleteJobDocument(documentID string) error
 	QueryJobsByDocumentID(documentID int) *Job
@@ -30,15 +31,63 @@ func NewClient(client *elasticsearch.Client) ESearchClient {
 }
 
 // SearchJobs searches for jobs in the jobs index
-func (client ESClient) SearchJobs(ctx context.Context, query string, page, pageSize int32) ([]*Job, error) {
+func (client ESClient) SearchJobs(ctx context.Context, query string, filters JobFilters, page, pageSize int32) ([]*Job, error) {
 	var jobs []*Job
 
+	// only published jobs that have not expired yet
+	filter := []interface{}{
+		map[string]interface{}{
+			"term": map[string]interface{}{
+				"status": "published",
+			},
+		},
+		map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []interface{}{
+					map[string]interface{}{
+						"bool": map[string]interface{}{
+							"must_not": map[string]interface{}{
+								"exists": map[string]interface{}{
+									"field": "expires_at",
+								},
+							},
+						},
+					},
+					map[string]interface{}{
+						"range": map[string]interface{}{
+							"expires_at": map[string]interface{}{
+								"gt": "now",
+							},
+						},
+					},
+				},
+				"minimum_should_match": 1,
+			},
+		},
+	}
+	for field, value := range map[string]string{
+		"employment_type": filters.EmploymentType,
+		"work_mode":       filters.WorkMode,
+		"seniority_level": filters.SeniorityLevel,
+	} {
+		if value != "" {
+			filter = append(filter, map[string]interface{}{
+				"term": map[string]interface{}{
+					field: value,
+				},
+			})
+		}
+	}
+
 	var searchBuffer bytes.Buffer
 	search := map[string]interface{}{
 		"from": (page - 1) * pageSize,
 		"size": pageSize,
 		"query": map[string]interface{}{
 			"bool": map[string]interface{}{
+				"filter": filter,
+				// with a filter, at least one of the queries must match
+				"minimum_should_match": 1,
 				"should": []interface{}{
 					map[string]interface{}{
 						"match": map[string]interface{}{
@@ -1,18 +1,34 @@
 package esearch
 
+import "time"
+
 // === Types for the ES part of the Application ===
 
 type Job struct {
-	ID           int32    `json:"id"`
-	Title        string   `json:"title"`
-	Industry     string   `json:"industry"`
-	CompanyName  string   `json:"company_name"`
-	Description  string   `json:"description"`
-	Location     string   `json:"location"`
-	SalaryMin    int32    `json:"salary_min"`
-	SalaryMax    int32    `json:"salary_max"`
-	Requirements string   `json:"requirements"`
-	JobSkills    []string `json:"job_skills"`
+	ID             int32      `json:"id"`
+	Title          string     `json:"title"`
+	Industry       string     `json:"industry"`
+	CompanyName    string     `json:"company_name"`
+	Description    string     `json:"description"`
+	Location       string     `json:"location"`
+	SalaryMin      int32      `json:"salary_min"`
+	SalaryMax      int32      `json:"salary_max"`
+	SalaryCurrency string     `json:"salary_currency"`
+	SalaryPeriod   string     `json:"salary_period"`
+	Requirements   string     `json:"requirements"`
+	JobSkills      []string   `json:"job_skills"`
+	Status         string     `json:"status"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	EmploymentType string     `json:"employment_type"`
+	WorkMode       string     `json:"work_mode"`
+	SeniorityLevel string     `json:"seniority_level"`
+}
+
+// JobFilters narrows down the results of SearchJobs, empty filters are ignored
+type JobFilters struct {
+	EmploymentType string
+	WorkMode       string
+	SeniorityLevel string
 }
 
 // === for the Context ===
@@ -0,0 +1,93 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+// Policy describes how attempts are limited after failures
+type Policy struct {
+	// FreeAttempts is the number of failures without any delay
+	FreeAttempts int
+	// BaseDelay is the delay after the first failure over FreeAttempts,
+	// it doubles with every next failure, up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// after LockoutAttempts failures attempts are locked for LockoutDuration
+	LockoutAttempts int
+	LockoutDuration time.Duration
+	// Window is how long failures are remembered since the last one
+	Window time.Duration
+}
+
+// Delay returns how long to wait after the last failure, when there were given number of failures
+func (policy Policy) Delay(failures int) time.Duration {
+	if failures >= policy.LockoutAttempts && policy.LockoutAttempts > 0 {
+		return policy.LockoutDuration
+	}
+	if failures <= policy.FreeAttempts {
+		return 0
+	}
+
+	delay := policy.BaseDelay
+	for i := policy.FreeAttempts + 1; i < failures; i++ {
+		delay *= 2
+		if delay >= policy.MaxDelay {
+			return policy.MaxDelay
+		}
+	}
+
+	if delay > policy.MaxDelay {
+		return policy.MaxDelay
+	}
+	return delay
+}
+
+// Limiter limits attempts (e.g. logins) of keys (e.g. accounts or IP addresses)
+// with exponential backoff and temporary lockout after failures
+type Limiter struct {
+	store  Store
+	policy Policy
+}
+
+// NewLimiter creates a new Limiter
+func NewLimiter(store Store, policy Policy) *Limiter {
+	return &Limiter{
+		store:  store,
+		policy: policy,
+	}
+}
+
+// Check returns how long the key has to wait before the next attempt, 0 if it can try now
+func (limiter *Limiter) Check(ctx context.Context, key string) (time.Duration, error) {
+	count, last, err := limiter.store.Failures(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	wait := time.Until(last.Add(limiter.policy.Delay(count)))
+	if wait < 0 {
+		return 0, nil
+	}
+
+	return wait, nil
+}
+
+// Fail records a failed attempt of the key
+func (limiter *Limiter) Fail(ctx context.Context, key string) error {
+	window := limiter.policy.Window
+	if window < limiter.policy.LockoutDuration {
+		window = limiter.policy.LockoutDuration
+	}
+
+	_, err := limiter.store.RecordFailure(ctx, key, time.Now(), window)
+	return err
+}
+
+// Reset forgets failed attempts of the key, e.g. after a successful login
+func (limiter *Limiter) Reset(ctx context.Context, key string) error {
+	return limiter.store.Reset(ctx, key)
+}
@@ -0,0 +1,90 @@
+package lockout
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var testPolicy = Policy{
+	FreeAttempts:    3,
+	BaseDelay:       time.Second,
+	MaxDelay:        10 * time.Second,
+	LockoutAttempts: 10,
+	LockoutDuration: time.Hour,
+	Window:          time.Minute,
+}
+
+func TestPolicyDelay(t *testing.T) {
+	testCases := []struct {
+		failures int
+		delay    time.Duration
+	}{
+		{failures: 0, delay: 0},
+		{failures: 3, delay: 0},
+		{failures: 4, delay: time.Second},
+		{failures: 5, delay: 2 * time.Second},
+		{failures: 6, delay: 4 * time.Second},
+		{failures: 7, delay: 8 * time.Second},
+		{failures: 8, delay: 10 * time.Second},
+		{failures: 9, delay: 10 * time.Second},
+		{failures: 10, delay: time.Hour},
+		{failures: 50, delay: time.Hour},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.delay, testPolicy.Delay(tc.failures), "failures: %d", tc.failures)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), testPolicy)
+	ctx := context.Background()
+	key := "user:test@example.com"
+
+	// free attempts
+	for i := 0; i < testPolicy.FreeAttempts; i++ {
+		wait, err := limiter.Check(ctx, key)
+		require.NoError(t, err)
+		require.Zero(t, wait)
+
+		err = limiter.Fail(ctx, key)
+		require.NoError(t, err)
+	}
+
+	wait, err := limiter.Check(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, wait)
+
+	// backoff starts
+	err = limiter.Fail(ctx, key)
+	require.NoError(t, err)
+
+	wait, err = limiter.Check(ctx, key)
+	require.NoError(t, err)
+	require.True(t, wait > 0 && wait <= testPolicy.BaseDelay)
+
+	// other keys are not affected
+	wait, err = limiter.Check(ctx, "ip:127.0.0.1")
+	require.NoError(t, err)
+	require.Zero(t, wait)
+
+	// lockout
+	for i := testPolicy.FreeAttempts + 1; i < testPolicy.LockoutAttempts; i++ {
+		err = limiter.Fail(ctx, key)
+		require.NoError(t, err)
+	}
+
+	wait, err = limiter.Check(ctx, key)
+	require.NoError(t, err)
+	require.True(t, wait > testPolicy.MaxDelay && wait <= testPolicy.LockoutDuration)
+
+	// reset after a successful attempt
+	err = limiter.Reset(ctx, key)
+	require.NoError(t, err)
+
+	wait, err = limiter.Check(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, wait)
+}
@@ -0,0 +1,76 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore - in-memory store of failed attempts, for tests and single-node setups
+type MemoryStore struct {
+	mu       sync.Mutex
+	failures map[string]failures
+}
+
+type failures struct {
+	count     int
+	last      time.Time
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates a new MemoryStore
+func NewMemoryStore() Store {
+	return &MemoryStore{
+		failures: make(map[string]failures),
+	}
+}
+
+// RecordFailure records a failed attempt of the key
+func (store *MemoryStore) RecordFailure(_ context.Context, key string, at time.Time, window time.Duration) (int, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.removeExpired()
+
+	f := store.failures[key]
+	f.count++
+	f.last = at
+	f.expiresAt = at.Add(window)
+	store.failures[key] = f
+
+	return f.count, nil
+}
+
+// Failures returns the number of failures of the key and the time of the last one
+func (store *MemoryStore) Failures(_ context.Context, key string) (int, time.Time, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	f, ok := store.failures[key]
+	if !ok || !time.Now().Before(f.expiresAt) {
+		return 0, time.Time{}, nil
+	}
+
+	return f.count, f.last, nil
+}
+
+// Reset forgets all failures of the key
+func (store *MemoryStore) Reset(_ context.Context, key string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	delete(store.failures, key)
+
+	return nil
+}
+
+// removeExpired removes failures that are outside of their window,
+// so the map does not grow forever. Caller has to hold the lock.
+func (store *MemoryStore) removeExpired() {
+	now := time.Now()
+	for key, f := range store.failures {
+		if !now.Before(f.expiresAt) {
+			delete(store.failures, key)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package lockout
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	key := "user:test@example.com"
+
+	count, last, err := store.Failures(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.True(t, last.IsZero())
+
+	now := time.Now()
+	count, err = store.RecordFailure(ctx, key, now.Add(-time.Second), time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = store.RecordFailure(ctx, key, now, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	count, last, err = store.Failures(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Equal(t, now, last)
+
+	err = store.Reset(ctx, key)
+	require.NoError(t, err)
+
+	count, _, err = store.Failures(ctx, key)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestMemoryStoreExpiredFailures(t *testing.T) {
+	store := NewMemoryStore().(*MemoryStore)
+	ctx := context.Background()
+
+	_, err := store.RecordFailure(ctx, "expired", time.Now().Add(-time.Hour), time.Minute)
+	require.NoError(t, err)
+
+	count, _, err := store.Failures(ctx, "expired")
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// expired entries are removed on the next failure
+	_, err = store.RecordFailure(ctx, "other", time.Now(), time.Minute)
+	require.NoError(t, err)
+	require.Len(t, store.failures, 1)
+}
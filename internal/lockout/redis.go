@@ -0,0 +1,75 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+const redisKeyPrefix = "failed_attempts:"
+
+// RedisStore - store of failed attempts backed by Redis, shared by all instances of the app.
+// Keys expire after the window, so Redis cleans them up by itself.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore creates a new RedisStore connecting to Redis at the given address
+func NewRedisStore(address string) Store {
+	return &RedisStore{
+		client: redis.NewClient(&redis.Options{
+			Addr: address,
+		}),
+	}
+}
+
+// RecordFailure records a failed attempt of the key
+func (store *RedisStore) RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error) {
+	var count *redis.IntCmd
+	_, err := store.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		count = pipe.HIncrBy(ctx, redisKeyPrefix+key, "count", 1)
+		pipe.HSet(ctx, redisKeyPrefix+key, "last", at.UnixNano())
+		pipe.Expire(ctx, redisKeyPrefix+key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count.Val()), nil
+}
+
+// Failures returns the number of failures of the key and the time of the last one
+func (store *RedisStore) Failures(ctx context.Context, key string) (int, time.Time, error) {
+	values, err := store.client.HMGet(ctx, redisKeyPrefix+key, "count", "last").Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, time.Time{}, nil
+		}
+		return 0, time.Time{}, err
+	}
+
+	countValue, ok := values[0].(string)
+	if !ok {
+		return 0, time.Time{}, nil
+	}
+	lastValue, _ := values[1].(string)
+
+	count, err := strconv.Atoi(countValue)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	last, err := strconv.ParseInt(lastValue, 10, 64)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return count, time.Unix(0, last), nil
+}
+
+// Reset forgets all failures of the key
+func (store *RedisStore) Reset(ctx context.Context, key string) error {
+	return store.client.Del(ctx, redisKeyPrefix+key).Err()
+}
@@ -0,0 +1,17 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+// Store - interface for keeping track of failed attempts, e.g. failed logins
+type Store interface {
+	// RecordFailure records a failed attempt of the key at the given time and returns
+	// the number of failures since the first one. Failures are forgotten after window.
+	RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error)
+	// Failures returns the number of failures of the key and the time of the last one
+	Failures(ctx context.Context, key string) (int, time.Time, error)
+	// Reset forgets all failures of the key
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"fmt"
+	zerolog "github.com/rs/zerolog/log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// LocalSender - email sender for development and tests. Emails are not delivered,
+// they are written to the log and, if a directory is set, saved there as .eml files.
+type LocalSender struct {
+	mu          sync.Mutex
+	fromAddress string
+	dir         string
+	sent        int
+}
+
+// NewLocalSender creates a new LocalSender, emails are saved to dir if it is not empty
+func NewLocalSender(fromAddress string, dir string) (EmailSender, error) {
+	if dir != "" {
+		err := os.MkdirAll(dir, 0o755)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create emails directory: %w", err)
+		}
+	}
+
+	return &LocalSender{
+		fromAddress: fromAddress,
+		dir:         dir,
+	}, nil
+}
+
+// SendEmail logs the email and saves it to the directory
+func (sender *LocalSender) SendEmail(subject string, content string, to []string) error {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	zerolog.Info().
+		Str("from", sender.fromAddress).
+		Strs("to", to).
+		Str("subject", subject).
+		Str("content", content).
+		Msg("email sent")
+
+	if sender.dir == "" {
+		return nil
+	}
+
+	sender.sent++
+	name := fmt.Sprintf("%d_%d.eml", time.Now().UnixNano(), sender.sent)
+	return os.WriteFile(filepath.Join(sender.dir, name), buildMessage(sender.fromAddress, subject, content, to), 0o644)
+}
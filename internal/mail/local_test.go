@@ -0,0 +1,55 @@
+package mail
+
+import (
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalSender(t *testing.T) {
+	dir := t.TempDir()
+	sender, err := NewLocalSender("sender@example.com", dir)
+	require.NoError(t, err)
+
+	subject := utils.RandomString(8)
+	content := "<p>" + utils.RandomString(16) + "</p>"
+	to := []string{utils.RandomEmail()}
+
+	err = sender.SendEmail(subject, content, to)
+	require.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.True(t, strings.HasSuffix(files[0].Name(), ".eml"))
+
+	data, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	message := string(data)
+	require.Contains(t, message, "From: sender@example.com")
+	require.Contains(t, message, "To: "+to[0])
+	require.Contains(t, message, "Subject: "+subject)
+	require.Contains(t, message, content)
+}
+
+func TestLocalSenderWithoutDir(t *testing.T) {
+	sender, err := NewLocalSender("sender@example.com", "")
+	require.NoError(t, err)
+
+	err = sender.SendEmail(utils.RandomString(8), utils.RandomString(16), []string{utils.RandomEmail()})
+	require.NoError(t, err)
+}
+
+func TestNewSMTPSender(t *testing.T) {
+	_, err := NewSMTPSender("smtp.example.com:587", "sender@example.com", "password")
+	require.NoError(t, err)
+
+	_, err = NewSMTPSender("smtp.example.com", "sender@example.com", "password")
+	require.Error(t, err)
+
+	_, err = NewSMTPSender("smtp.example.com:587", "", "password")
+	require.Error(t, err)
+}
@@ -0,0 +1,7 @@
+package mail
+
+// EmailSender - interface for sending emails to users and employers
+type EmailSender interface {
+	// SendEmail sends an email with given subject and HTML content to all recipients
+	SendEmail(subject string, content string, to []string) error
+}
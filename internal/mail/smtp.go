@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPSender - email sender that delivers emails through an SMTP server
+type SMTPSender struct {
+	address     string
+	fromAddress string
+	auth        smtp.Auth
+}
+
+// NewSMTPSender creates a new SMTPSender. address is the address of the SMTP server
+// (host:port), fromAddress and password are credentials of the sender's account.
+func NewSMTPSender(address string, fromAddress string, password string) (EmailSender, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP address: %w", err)
+	}
+	if fromAddress == "" {
+		return nil, fmt.Errorf("sender address is required")
+	}
+
+	return &SMTPSender{
+		address:     address,
+		fromAddress: fromAddress,
+		auth:        smtp.PlainAuth("", fromAddress, password, host),
+	}, nil
+}
+
+// SendEmail sends an email through the SMTP server
+func (sender *SMTPSender) SendEmail(subject string, content string, to []string) error {
+	return smtp.SendMail(sender.address, sender.auth, sender.fromAddress, to, buildMessage(sender.fromAddress, subject, content, to))
+}
+
+// buildMessage builds an HTML email message with headers
+func buildMessage(from string, subject string, content string, to []string) []byte {
+	var msg strings.Builder
+	msg.WriteString("From: " + from + "\r\n")
+	msg.WriteString("To: " + strings.Join(to, ", ") + "\r\n")
+	msg.WriteString("Subject: " + subject + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/html; charset=\"UTF-8\"\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(content)
+
+	return []byte(msg.String())
+}
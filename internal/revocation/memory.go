@@ -0,0 +1,95 @@
+package revocation
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+// MemoryStore - in-memory revocation store, for tests and single-node setups
+type MemoryStore struct {
+	mu       sync.Mutex
+	revoked  map[uuid.UUID]time.Time
+	subjects map[string]revokedSubject
+}
+
+type revokedSubject struct {
+	issuedBefore time.Time
+	expiresAt    time.Time
+}
+
+// NewMemoryStore creates a new MemoryStore
+func NewMemoryStore() Store {
+	return &MemoryStore{
+		revoked:  make(map[uuid.UUID]time.Time),
+		subjects: make(map[string]revokedSubject),
+	}
+}
+
+// Revoke marks the token as revoked until expiresAt
+func (store *MemoryStore) Revoke(_ context.Context, tokenID uuid.UUID, expiresAt time.Time) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.removeExpired()
+	store.revoked[tokenID] = expiresAt
+
+	return nil
+}
+
+// IsRevoked checks if the token was revoked and has not expired yet
+func (store *MemoryStore) IsRevoked(_ context.Context, tokenID uuid.UUID) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	expiresAt, ok := store.revoked[tokenID]
+	if !ok {
+		return false, nil
+	}
+
+	return time.Now().Before(expiresAt), nil
+}
+
+// RevokeSubject revokes all tokens of the subject issued before issuedBefore
+func (store *MemoryStore) RevokeSubject(_ context.Context, subject string, issuedBefore time.Time, ttl time.Duration) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.removeExpired()
+	store.subjects[subject] = revokedSubject{
+		issuedBefore: issuedBefore,
+		expiresAt:    time.Now().Add(ttl),
+	}
+
+	return nil
+}
+
+// IsSubjectRevoked checks if tokens of the subject issued at issuedAt were revoked
+func (store *MemoryStore) IsSubjectRevoked(_ context.Context, subject string, issuedAt time.Time) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	revoked, ok := store.subjects[subject]
+	if !ok || !time.Now().Before(revoked.expiresAt) {
+		return false, nil
+	}
+
+	return issuedAt.Before(revoked.issuedBefore), nil
+}
+
+// removeExpired removes tokens and subjects that have already expired,
+// so the maps do not grow forever. Caller has to hold the lock.
+func (store *MemoryStore) removeExpired() {
+	now := time.Now()
+	for id, expiresAt := range store.revoked {
+		if !now.Before(expiresAt) {
+			delete(store.revoked, id)
+		}
+	}
+	for subject, revoked := range store.subjects {
+		if !now.Before(revoked.expiresAt) {
+			delete(store.subjects, subject)
+		}
+	}
+}
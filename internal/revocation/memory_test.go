@@ -0,0 +1,95 @@
+package revocation
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	tokenID := uuid.New()
+
+	revoked, err := store.IsRevoked(ctx, tokenID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	err = store.Revoke(ctx, tokenID, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	revoked, err = store.IsRevoked(ctx, tokenID)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// other tokens are not affected
+	revoked, err = store.IsRevoked(ctx, uuid.New())
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func TestMemoryStoreExpiredToken(t *testing.T) {
+	store := NewMemoryStore().(*MemoryStore)
+	ctx := context.Background()
+
+	expiredID := uuid.New()
+	err := store.Revoke(ctx, expiredID, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	revoked, err := store.IsRevoked(ctx, expiredID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// expired entries are removed on the next revoke
+	err = store.Revoke(ctx, uuid.New(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, store.revoked, 1)
+}
+
+func TestMemoryStoreRevokeSubject(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	subject := "user:1"
+	issuedBefore := time.Now()
+
+	revoked, err := store.IsSubjectRevoked(ctx, subject, issuedBefore.Add(-time.Second))
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	err = store.RevokeSubject(ctx, subject, issuedBefore, time.Minute)
+	require.NoError(t, err)
+
+	// tokens issued before are revoked
+	revoked, err = store.IsSubjectRevoked(ctx, subject, issuedBefore.Add(-time.Second))
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// tokens issued after are not
+	revoked, err = store.IsSubjectRevoked(ctx, subject, issuedBefore.Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	// other subjects are not affected
+	revoked, err = store.IsSubjectRevoked(ctx, "employer:1", issuedBefore.Add(-time.Second))
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func TestMemoryStoreExpiredSubject(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	subject := "user:1"
+	issuedBefore := time.Now()
+
+	err := store.RevokeSubject(ctx, subject, issuedBefore, -time.Minute)
+	require.NoError(t, err)
+
+	revoked, err := store.IsSubjectRevoked(ctx, subject, issuedBefore.Add(-time.Second))
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
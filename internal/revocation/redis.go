@@ -0,0 +1,77 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+const (
+	redisKeyPrefix        = "revoked_token:"
+	redisSubjectKeyPrefix = "revoked_subject:"
+)
+
+// RedisStore - revocation store backed by Redis, shared by all instances of the app.
+// Keys expire together with the tokens, so Redis cleans them up by itself.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore creates a new RedisStore connecting to Redis at the given address
+func NewRedisStore(address string) Store {
+	return &RedisStore{
+		client: redis.NewClient(&redis.Options{
+			Addr: address,
+		}),
+	}
+}
+
+// Revoke marks the token as revoked until expiresAt
+func (store *RedisStore) Revoke(ctx context.Context, tokenID uuid.UUID, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		// the token has already expired, there is nothing to revoke
+		return nil
+	}
+
+	return store.client.Set(ctx, redisKeyPrefix+tokenID.String(), 1, ttl).Err()
+}
+
+// IsRevoked checks if the token was revoked
+func (store *RedisStore) IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	err := store.client.Get(ctx, redisKeyPrefix+tokenID.String()).Err()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RevokeSubject revokes all tokens of the subject issued before issuedBefore
+func (store *RedisStore) RevokeSubject(ctx context.Context, subject string, issuedBefore time.Time, ttl time.Duration) error {
+	return store.client.Set(ctx, redisSubjectKeyPrefix+subject, issuedBefore.UnixNano(), ttl).Err()
+}
+
+// IsSubjectRevoked checks if tokens of the subject issued at issuedAt were revoked
+func (store *RedisStore) IsSubjectRevoked(ctx context.Context, subject string, issuedAt time.Time) (bool, error) {
+	value, err := store.client.Get(ctx, redisSubjectKeyPrefix+subject).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	issuedBefore, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false, err
+	}
+
+	return issuedAt.UnixNano() < issuedBefore, nil
+}
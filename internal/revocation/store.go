@@ -0,0 +1,23 @@
+package revocation
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Store - interface for keeping track of tokens that were revoked
+// before they expired (e.g. on logout)
+type Store interface {
+	// Revoke marks the token with given ID as revoked until expiresAt,
+	// after that the token is rejected anyway because it has expired
+	Revoke(ctx context.Context, tokenID uuid.UUID, expiresAt time.Time) error
+	// IsRevoked checks if the token with given ID was revoked
+	IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error)
+	// RevokeSubject revokes all tokens of the subject (e.g. "user:1") issued
+	// before issuedBefore (e.g. on password reset). It is remembered for ttl,
+	// which should be the lifetime of the tokens.
+	RevokeSubject(ctx context.Context, subject string, issuedBefore time.Time, ttl time.Duration) error
+	// IsSubjectRevoked checks if tokens of the subject issued at issuedAt were revoked
+	IsSubjectRevoked(ctx context.Context, subject string, issuedAt time.Time) (bool, error)
+}
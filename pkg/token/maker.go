@@ -4,6 +4,7 @@ import "time"
 
 // Maker - interface for managing tokens
 type Maker interface {
-	CreateToken(email string, duration time.Duration) (string, error)
+	CreateToken(email string, role string, subjectID int32, duration time.Duration) (string, *Payload, error)
+	CreateRefreshToken(email string, role string, subjectID int32, duration time.Duration) (string, *Payload, error)
 	VerifyToken(token string) (*Payload, error)
 }
@@ -27,15 +27,24 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	return maker, nil
 }
 
-// CreateToken creates a new token for a specific username and duration
-func (maker *PasetoMaker) CreateToken(email string, duration time.Duration) (string, error) {
-	payload, err := NewPayload(email, duration)
+// CreateToken creates a new access token for a specific email, role, subject ID and duration
+func (maker *PasetoMaker) CreateToken(email string, role string, subjectID int32, duration time.Duration) (string, *Payload, error) {
+	return maker.createToken(email, role, subjectID, TokenTypeAccess, duration)
+}
+
+// CreateRefreshToken creates a new refresh token for a specific email, role, subject ID and duration
+func (maker *PasetoMaker) CreateRefreshToken(email string, role string, subjectID int32, duration time.Duration) (string, *Payload, error) {
+	return maker.createToken(email, role, subjectID, TokenTypeRefresh, duration)
+}
+
+func (maker *PasetoMaker) createToken(email string, role string, subjectID int32, tokenType string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(email, role, subjectID, tokenType, duration)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	token, err := maker.paseto.Encrypt(maker.symmetricKey, payload, nil)
-	return token, err
+	return token, payload, err
 }
 
 // VerifyToken checks if the token is valid
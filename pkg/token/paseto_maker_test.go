@@ -12,35 +12,53 @@ func TestPasetoMaker(t *testing.T) {
 	require.NoError(t, err)
 
 	email := utils.RandomEmail()
+	subjectID := utils.RandomInt(1, 1000)
 	duration := time.Minute
 
 	issuedAt := time.Now()
 	expiredAt := issuedAt.Add(duration)
 
-	token, err := maker.CreateToken(email, duration)
+	token, payload, err := maker.CreateToken(email, RoleUser, subjectID, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
 
-	payload, err := maker.VerifyToken(token)
+	payload, err = maker.VerifyToken(token)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
 	require.NotZero(t, payload.ID)
 	require.Equal(t, email, payload.Email)
+	require.Equal(t, RoleUser, payload.Role)
+	require.Equal(t, subjectID, payload.SubjectID)
+	require.Equal(t, TokenTypeAccess, payload.TokenType)
 	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
 	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
 }
 
+func TestPasetoMakerRefreshToken(t *testing.T) {
+	maker, err := NewPasetoMaker(utils.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateRefreshToken(utils.RandomEmail(), RoleUser, utils.RandomInt(1, 1000), time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, TokenTypeRefresh, payload.TokenType)
+}
+
 func TestExpiredPasetoToken(t *testing.T) {
 	maker, err := NewPasetoMaker(utils.RandomString(32))
 	require.NoError(t, err)
 
 	// negative duration -> always expired
-	token, err := maker.CreateToken(utils.RandomEmail(), -time.Minute)
+	token, payload, err := maker.CreateToken(utils.RandomEmail(), RoleUser, utils.RandomInt(1, 1000), -time.Minute)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
 
-	payload, err := maker.VerifyToken(token)
+	payload, err = maker.VerifyToken(token)
 	require.Error(t, err)
 	require.EqualError(t, err, ErrExpiredToken.Error())
 	require.Nil(t, payload)
@@ -0,0 +1,174 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"github.com/o1egl/paseto"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PasetoPublicMaker - a PASETO v2.public token maker. Tokens are signed
+// with an Ed25519 private key and can be verified with the public key only.
+// ID of the signing key is stored in the token footer, so old keys
+// can still be used to verify tokens while keys are being rotated.
+type PasetoPublicMaker struct {
+	paseto     *paseto.V2
+	keyID      string
+	privateKey ed25519.PrivateKey
+	publicKeys map[string]ed25519.PublicKey
+}
+
+// PublicKey - a public key that can be used to verify tokens
+type PublicKey struct {
+	KeyID string
+	Key   ed25519.PublicKey
+}
+
+// PublicKeyProvider is implemented by makers whose tokens
+// can be verified by other services with public keys
+type PublicKeyProvider interface {
+	PublicKeys() []PublicKey
+}
+
+type pasetoFooter struct {
+	KeyID string `json:"kid"`
+}
+
+// NewPasetoPublicMaker creates a new PasetoPublicMaker that signs tokens with privateKey.
+// verificationKeys are additional (e.g. previous) public keys accepted when verifying tokens,
+// public key of the privateKey is always accepted.
+func NewPasetoPublicMaker(keyID string, privateKey ed25519.PrivateKey, verificationKeys map[string]ed25519.PublicKey) (Maker, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("key ID is required")
+	}
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid key size")
+	}
+
+	publicKeys := make(map[string]ed25519.PublicKey, len(verificationKeys)+1)
+	for id, key := range verificationKeys {
+		if len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid size of verification key %s", id)
+		}
+		publicKeys[id] = key
+	}
+	publicKeys[keyID] = privateKey.Public().(ed25519.PublicKey)
+
+	maker := &PasetoPublicMaker{
+		paseto:     paseto.NewV2(),
+		keyID:      keyID,
+		privateKey: privateKey,
+		publicKeys: publicKeys,
+	}
+
+	return maker, nil
+}
+
+// CreateToken creates a new signed access token for a specific email, role, subject ID and duration
+func (maker *PasetoPublicMaker) CreateToken(email string, role string, subjectID int32, duration time.Duration) (string, *Payload, error) {
+	return maker.createToken(email, role, subjectID, TokenTypeAccess, duration)
+}
+
+// CreateRefreshToken creates a new signed refresh token for a specific email, role, subject ID and duration
+func (maker *PasetoPublicMaker) CreateRefreshToken(email string, role string, subjectID int32, duration time.Duration) (string, *Payload, error) {
+	return maker.createToken(email, role, subjectID, TokenTypeRefresh, duration)
+}
+
+func (maker *PasetoPublicMaker) createToken(email string, role string, subjectID int32, tokenType string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(email, role, subjectID, tokenType, duration)
+	if err != nil {
+		return "", nil, err
+	}
+
+	token, err := maker.paseto.Sign(maker.privateKey, payload, pasetoFooter{KeyID: maker.keyID})
+	return token, payload, err
+}
+
+// VerifyToken checks if the token was signed with one of the known keys and is valid
+func (maker *PasetoPublicMaker) VerifyToken(token string) (*Payload, error) {
+	var footer pasetoFooter
+	err := paseto.ParseFooter(token, &footer)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	publicKey, ok := maker.publicKeys[footer.KeyID]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	payload := &Payload{}
+	err = maker.paseto.Verify(token, publicKey, payload, nil)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	err = payload.Valid()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// PublicKeys returns all public keys that are accepted when verifying tokens
+func (maker *PasetoPublicMaker) PublicKeys() []PublicKey {
+	keys := make([]PublicKey, 0, len(maker.publicKeys))
+	for id, key := range maker.publicKeys {
+		keys = append(keys, PublicKey{KeyID: id, Key: key})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].KeyID < keys[j].KeyID
+	})
+
+	return keys
+}
+
+// ParsePrivateKey parses a hex encoded Ed25519 private key.
+// Both the 32 bytes seed and the full 64 bytes key are accepted.
+func ParsePrivateKey(hexKey string) (ed25519.PrivateKey, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode private key: %w", err)
+	}
+
+	switch len(key) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(key), nil
+	case ed25519.PrivateKeySize:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("invalid key size")
+	}
+}
+
+// ParseVerificationKeys parses a comma separated list of
+// hex encoded Ed25519 public keys with their IDs, e.g. "key1:ab12...,key2:cd34..."
+func ParseVerificationKeys(keys string) (map[string]ed25519.PublicKey, error) {
+	publicKeys := make(map[string]ed25519.PublicKey)
+	if strings.TrimSpace(keys) == "" {
+		return publicKeys, nil
+	}
+
+	for _, entry := range strings.Split(keys, ",") {
+		id, hexKey, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid verification key %q, expected format is key_id:hex_key", entry)
+		}
+
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode verification key %s: %w", id, err)
+		}
+		if len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid size of verification key %s", id)
+		}
+
+		publicKeys[id] = key
+	}
+
+	return publicKeys, nil
+}
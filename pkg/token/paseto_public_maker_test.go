@@ -0,0 +1,158 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/grannnsacker/job-finder-back/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func newTestKey(t *testing.T) ed25519.PrivateKey {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return privateKey
+}
+
+func TestPasetoPublicMaker(t *testing.T) {
+	maker, err := NewPasetoPublicMaker("key-1", newTestKey(t), nil)
+	require.NoError(t, err)
+
+	email := utils.RandomEmail()
+	subjectID := utils.RandomInt(1, 1000)
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(email, RoleEmployer, subjectID, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	require.NotZero(t, payload.ID)
+	require.Equal(t, email, payload.Email)
+	require.Equal(t, RoleEmployer, payload.Role)
+	require.Equal(t, subjectID, payload.SubjectID)
+	require.Equal(t, TokenTypeAccess, payload.TokenType)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+}
+
+func TestPasetoPublicMakerRefreshToken(t *testing.T) {
+	maker, err := NewPasetoPublicMaker("key-1", newTestKey(t), nil)
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateRefreshToken(utils.RandomEmail(), RoleEmployer, utils.RandomInt(1, 1000), time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, TokenTypeRefresh, payload.TokenType)
+}
+
+func TestExpiredPasetoPublicToken(t *testing.T) {
+	maker, err := NewPasetoPublicMaker("key-1", newTestKey(t), nil)
+	require.NoError(t, err)
+
+	token, payload, err := maker.CreateToken(utils.RandomEmail(), RoleUser, utils.RandomInt(1, 1000), -time.Minute)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestPasetoPublicMakerKeyRotation(t *testing.T) {
+	oldKey := newTestKey(t)
+	oldMaker, err := NewPasetoPublicMaker("key-1", oldKey, nil)
+	require.NoError(t, err)
+
+	oldToken, _, err := oldMaker.CreateToken(utils.RandomEmail(), RoleUser, utils.RandomInt(1, 1000), time.Minute)
+	require.NoError(t, err)
+
+	// new maker signs with a new key, but still accepts the old one
+	newMaker, err := NewPasetoPublicMaker("key-2", newTestKey(t), map[string]ed25519.PublicKey{
+		"key-1": oldKey.Public().(ed25519.PublicKey),
+	})
+	require.NoError(t, err)
+
+	payload, err := newMaker.VerifyToken(oldToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	newToken, _, err := newMaker.CreateToken(utils.RandomEmail(), RoleUser, utils.RandomInt(1, 1000), time.Minute)
+	require.NoError(t, err)
+
+	// old maker does not know the new key
+	payload, err = oldMaker.VerifyToken(newToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+
+	keys := newMaker.(PublicKeyProvider).PublicKeys()
+	require.Len(t, keys, 2)
+	require.Equal(t, "key-1", keys[0].KeyID)
+	require.Equal(t, "key-2", keys[1].KeyID)
+}
+
+func TestPasetoPublicMakerInvalidToken(t *testing.T) {
+	maker, err := NewPasetoPublicMaker("key-1", newTestKey(t), nil)
+	require.NoError(t, err)
+
+	// token signed with another key, but with the same key ID
+	otherMaker, err := NewPasetoPublicMaker("key-1", newTestKey(t), nil)
+	require.NoError(t, err)
+	token, _, err := otherMaker.CreateToken(utils.RandomEmail(), RoleUser, utils.RandomInt(1, 1000), time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+
+	// v2.local token
+	localMaker, err := NewPasetoMaker(utils.RandomString(32))
+	require.NoError(t, err)
+	token, _, err = localMaker.CreateToken(utils.RandomEmail(), RoleUser, utils.RandomInt(1, 1000), time.Minute)
+	require.NoError(t, err)
+
+	payload, err = maker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestParseKeys(t *testing.T) {
+	privateKey := newTestKey(t)
+
+	parsed, err := ParsePrivateKey(hex.EncodeToString(privateKey.Seed()))
+	require.NoError(t, err)
+	require.Equal(t, privateKey, parsed)
+
+	parsed, err = ParsePrivateKey(hex.EncodeToString(privateKey))
+	require.NoError(t, err)
+	require.Equal(t, privateKey, parsed)
+
+	_, err = ParsePrivateKey("invalid")
+	require.Error(t, err)
+
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	keys, err := ParseVerificationKeys(fmt.Sprintf("key-1:%s", hex.EncodeToString(publicKey)))
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, publicKey, keys["key-1"])
+
+	keys, err = ParseVerificationKeys("")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	_, err = ParseVerificationKeys("key-1")
+	require.Error(t, err)
+}
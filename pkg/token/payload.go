@@ -9,16 +9,43 @@ import (
 var ErrExpiredToken = errors.New("token has expired")
 var ErrInvalidToken = errors.New("token is invalid")
 
+// roles of the accounts that tokens can be issued for
+const (
+	RoleUser     = "user"
+	RoleEmployer = "employer"
+	RoleAdmin    = "admin"
+)
+
+// RoleEmployerTwoFactor is the role of short-lived challenge tokens issued after
+// the password of an employer with two-factor authentication was verified.
+// They can only be used to complete the login with the second factor.
+const RoleEmployerTwoFactor = "employer_2fa"
+
+// types of the tokens issued at login, only access tokens authorize requests
+// and only refresh tokens can be used to renew the access token
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
 // Payload - payload data of the token
 type Payload struct {
 	ID        uuid.UUID `json:"id"`
 	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	SubjectID int32     `json:"subject_id"`
+	TokenType string    `json:"token_type"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
+	// APIKeyID and Scopes are only set when the request was authenticated
+	// with a company API key instead of a token issued at login
+	APIKeyID int64    `json:"api_key_id,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
 }
 
-// NewPayload creates a new token payload with a specific email and duration
-func NewPayload(email string, duration time.Duration) (*Payload, error) {
+// NewPayload creates a new token payload with a specific email, role,
+// ID of the account (user or employer), token type and duration
+func NewPayload(email string, role string, subjectID int32, tokenType string, duration time.Duration) (*Payload, error) {
 	tokenID, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -27,6 +54,9 @@ func NewPayload(email string, duration time.Duration) (*Payload, error) {
 	payload := &Payload{
 		ID:        tokenID,
 		Email:     email,
+		Role:      role,
+		SubjectID: subjectID,
+		TokenType: tokenType,
 		IssuedAt:  time.Now(),
 		ExpiredAt: time.Now().Add(duration),
 	}
@@ -41,3 +71,24 @@ func (payload *Payload) Valid() error {
 	}
 	return nil
 }
+
+// IsAPIKey checks if the payload belongs to a company API key
+func (payload *Payload) IsAPIKey() bool {
+	return payload.APIKeyID != 0
+}
+
+// HasScope checks if the payload grants the given scope.
+// Tokens issued at login are not limited by scopes.
+func (payload *Payload) HasScope(scope string) bool {
+	if !payload.IsAPIKey() {
+		return true
+	}
+
+	for _, s := range payload.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
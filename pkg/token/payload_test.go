@@ -10,14 +10,18 @@ import (
 
 func TestNewPayload(t *testing.T) {
 	email := utils.RandomEmail()
+	subjectID := utils.RandomInt(1, 1000)
 	duration := time.Hour
 
-	payload, err := NewPayload(email, duration)
+	payload, err := NewPayload(email, RoleEmployer, subjectID, TokenTypeAccess, duration)
 	require.NoError(t, err)
 
 	// Check that the payload fields are set correctly
 	require.NotEqual(t, uuid.Nil, payload.ID, "ID should not be nil")
 	require.Equal(t, email, payload.Email)
+	require.Equal(t, RoleEmployer, payload.Role)
+	require.Equal(t, subjectID, payload.SubjectID)
+	require.Equal(t, TokenTypeAccess, payload.TokenType)
 	require.WithinDuration(t, time.Now(), payload.IssuedAt, 5*time.Second, "IssuedAt should be close to the current time")
 	require.WithinDuration(t, time.Now().Add(duration), payload.ExpiredAt, 5*time.Second, "ExpiredAt should be close to current time + duration")
 }
@@ -47,3 +51,25 @@ func TestPayload_Valid(t *testing.T) {
 	err = expiredPayload.Valid()
 	require.EqualError(t, err, ErrExpiredToken.Error())
 }
+
+func TestPayload_HasScope(t *testing.T) {
+	// Tokens issued at login are not limited by scopes
+	tokenPayload := &Payload{
+		ID:    uuid.New(),
+		Email: utils.RandomEmail(),
+		Role:  RoleEmployer,
+	}
+	require.False(t, tokenPayload.IsAPIKey())
+	require.True(t, tokenPayload.HasScope("jobs:write"))
+
+	apiKeyPayload := &Payload{
+		ID:       uuid.New(),
+		Email:    utils.RandomEmail(),
+		Role:     RoleEmployer,
+		APIKeyID: 1,
+		Scopes:   []string{"jobs:write"},
+	}
+	require.True(t, apiKeyPayload.IsAPIKey())
+	require.True(t, apiKeyPayload.HasScope("jobs:write"))
+	require.False(t, apiKeyPayload.HasScope("applications:read"))
+}
@@ -0,0 +1,70 @@
+package totp
+
+import (
+	"encoding/base32"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateCodeRFC6238(t *testing.T) {
+	// test vectors from RFC 6238 appendix B (SHA1), last 6 of the 8 digits
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	testCases := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+
+	for _, tc := range testCases {
+		code, err := GenerateCode(secret, Step(time.Unix(tc.unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, tc.code, code, "time: %d", tc.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := GenerateCode(secret, Step(now))
+	require.NoError(t, err)
+
+	step, ok := Validate(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, Step(now), step)
+
+	// previous and next periods are accepted because of the clock skew
+	_, ok = Validate(secret, code, now.Add(Period))
+	require.True(t, ok)
+	_, ok = Validate(secret, code, now.Add(-Period))
+	require.True(t, ok)
+
+	// but not older ones
+	_, ok = Validate(secret, code, now.Add(3*Period))
+	require.False(t, ok)
+
+	_, ok = Validate(secret, "12345", now)
+	require.False(t, ok)
+
+	other, err := GenerateSecret()
+	require.NoError(t, err)
+	_, ok = Validate(other, code, now.Add(5*Period))
+	require.False(t, ok)
+}
+
+func TestKeyURI(t *testing.T) {
+	uri := KeyURI("Job Finder", "employer@example.com", "ABCDEF")
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/Job%20Finder:employer@example.com?"))
+	require.Contains(t, uri, "secret=ABCDEF")
+	require.Contains(t, uri, "issuer=Job+Finder")
+}
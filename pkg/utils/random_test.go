@@ -87,3 +87,26 @@ func isStringInAlphabet(str string) bool {
 	}
 	return true
 }
+
+func TestRandomSecret(t *testing.T) {
+	secret, err := RandomSecret(32)
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+	for _, c := range secret {
+		require.True(t, strings.ContainsRune(alphabet, c))
+	}
+
+	other, err := RandomSecret(32)
+	require.NoError(t, err)
+	require.NotEqual(t, secret, other)
+}
+
+func TestHashSecret(t *testing.T) {
+	secret := RandomString(32)
+
+	hash := HashSecret(secret)
+	require.Len(t, hash, 64)
+	require.NotEqual(t, secret, hash)
+	require.Equal(t, hash, HashSecret(secret))
+	require.NotEqual(t, hash, HashSecret(RandomString(32)))
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strings"
+)
+
+// RandomSecret returns a cryptographically secure random string of length n,
+// to be used for secret codes sent to users (e.g. email verification)
+func RandomSecret(n int) (string, error) {
+	var sb strings.Builder
+	k := big.NewInt(int64(len(alphabet)))
+
+	for i := 0; i < n; i++ {
+		idx, err := rand.Int(rand.Reader, k)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(alphabet[idx.Int64()])
+	}
+
+	return sb.String(), nil
+}
+
+// HashSecret returns the SHA-256 hash (hex encoded) of the secret, so secret codes
+// can be stored and looked up without keeping them in plain text
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -31,3 +31,14 @@ func ValidateEmail(value string) error {
 
 	return nil
 }
+
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ValidateCurrency check if the value is an ISO 4217 currency code, e.g. USD.
+func ValidateCurrency(value string) error {
+	if !currencyRegex.MatchString(value) {
+		return fmt.Errorf("currency is invalid, must be an ISO 4217 code")
+	}
+
+	return nil
+}
@@ -81,3 +81,37 @@ func TestValidateEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:    "Valid Currency",
+			value:   "EUR",
+			wantErr: false,
+		},
+		{
+			name:    "Lowercase",
+			value:   "eur",
+			wantErr: true,
+		},
+		{
+			name:    "Too Long",
+			value:   "EURO",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateCurrency(tc.value)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Package xlsx writes simple spreadsheets in the Office Open XML format
+// (.xlsx) with one worksheet of text cells. Rows are streamed to the
+// underlying writer as they are written, so large sheets are not kept in memory.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the MIME type of an .xlsx file
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// maxSheetNameLength is the limit of the length of a sheet name in Excel
+const maxSheetNameLength = 31
+
+var (
+	ErrInvalidSheetName = errors.New("sheet name must have 1 to 31 characters and must not contain any of : \\ / ? * [ ]")
+	ErrClosed           = errors.New("xlsx writer is closed")
+)
+
+const contentTypesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const relsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookRelsXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`</Relationships>`
+
+const workbookXMLStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="`
+
+const workbookXMLEnd = `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+const sheetXMLStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const sheetXMLEnd = `</sheetData></worksheet>`
+
+// Writer writes the rows of one worksheet, Close must be called
+// to finish the file
+type Writer struct {
+	zip    *zip.Writer
+	sheet  *bufio.Writer
+	rows   int
+	closed bool
+}
+
+// NewWriter writes the parts of the workbook that do not depend on the rows
+// to w and returns a Writer of the rows of the sheet with the given name
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	if sheetName == "" || len([]rune(sheetName)) > maxSheetNameLength || strings.ContainsAny(sheetName, `:\/?*[]`) {
+		return nil, ErrInvalidSheetName
+	}
+
+	var workbook strings.Builder
+	workbook.WriteString(workbookXMLStart)
+	if err := xml.EscapeText(&workbook, []byte(sheetName)); err != nil {
+		return nil, err
+	}
+	workbook.WriteString(workbookXMLEnd)
+
+	archive := zip.NewWriter(w)
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: contentTypesXML},
+		{name: "_rels/.rels", content: relsXML},
+		{name: "xl/workbook.xml", content: workbook.String()},
+		{name: "xl/_rels/workbook.xml.rels", content: workbookRelsXML},
+	}
+	for _, part := range parts {
+		f, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = io.WriteString(f, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	// the sheet is the last part, so its rows can be written until Close
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &Writer{
+		zip:   archive,
+		sheet: bufio.NewWriter(sheet),
+	}
+	if _, err = writer.sheet.WriteString(sheetXMLStart); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// Write writes one row, every value is a text cell, the signature
+// is the same as the one of csv.Writer
+func (w *Writer) Write(record []string) error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	w.rows++
+	row := strconv.Itoa(w.rows)
+
+	w.sheet.WriteString(`<row r="` + row + `">`)
+	for i, value := range record {
+		w.sheet.WriteString(`<c r="` + ColumnName(i) + row + `" t="inlineStr"><is><t xml:space="preserve">`)
+		// characters not allowed in XML are replaced with U+FFFD
+		if err := xml.EscapeText(w.sheet, []byte(value)); err != nil {
+			return err
+		}
+		w.sheet.WriteString(`</t></is></c>`)
+	}
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+// Flush writes the buffered rows to the underlying writer
+func (w *Writer) Flush() error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zip.Flush()
+}
+
+// Close finishes the sheet and the file, it does not close the underlying writer
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+
+	if _, err := w.sheet.WriteString(sheetXMLEnd); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zip.Close()
+}
+
+// ColumnName returns the name of the column with the zero based index,
+// e.g. A for 0, Z for 25 and AA for 26
+func ColumnName(index int) string {
+	var name []byte
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = append([]byte{byte('A' + (index-1)%26)}, name...)
+	}
+	return string(name)
+}
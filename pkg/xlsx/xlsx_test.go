@@ -0,0 +1,94 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"github.com/stretchr/testify/require"
+	"io"
+	"testing"
+)
+
+type sheetXML struct {
+	Rows []struct {
+		R     string `xml:"r,attr"`
+		Cells []struct {
+			R     string `xml:"r,attr"`
+			T     string `xml:"t,attr"`
+			Value string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func readFile(t *testing.T, archive *zip.Reader, name string) []byte {
+	f, err := archive.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return content
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "Jobs & applications")
+	require.NoError(t, err)
+
+	rows := [][]string{
+		{"id", "title", "skills"},
+		{"1", "Go <developer>", "go;sql"},
+		{"2", "  spaces  ", ""},
+		{"3", "control \x01 character"},
+	}
+	for _, row := range rows {
+		require.NoError(t, w.Write(row))
+	}
+	require.NoError(t, w.Close())
+	require.ErrorIs(t, w.Write([]string{"closed"}), ErrClosed)
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels"} {
+		content := readFile(t, archive, name)
+		require.NoError(t, xml.Unmarshal(content, new(struct{})), name)
+	}
+	require.Contains(t, string(readFile(t, archive, "xl/workbook.xml")), `name="Jobs &amp; applications"`)
+
+	var sheet sheetXML
+	require.NoError(t, xml.Unmarshal(readFile(t, archive, "xl/worksheets/sheet1.xml"), &sheet))
+	require.Len(t, sheet.Rows, len(rows))
+
+	require.Equal(t, "2", sheet.Rows[1].R)
+	require.Equal(t, "C2", sheet.Rows[1].Cells[2].R)
+	require.Equal(t, "inlineStr", sheet.Rows[1].Cells[2].T)
+	require.Equal(t, "Go <developer>", sheet.Rows[1].Cells[1].Value)
+	require.Equal(t, "  spaces  ", sheet.Rows[2].Cells[1].Value)
+	require.Equal(t, "", sheet.Rows[2].Cells[2].Value)
+	require.Equal(t, "control � character", sheet.Rows[3].Cells[1].Value)
+}
+
+func TestNewWriterInvalidSheetName(t *testing.T) {
+	for _, name := range []string{"", "jobs/applications", "[jobs]", "a name that is longer than 31 characters"} {
+		_, err := NewWriter(io.Discard, name)
+		require.ErrorIs(t, err, ErrInvalidSheetName, name)
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	testCases := map[int]string{
+		0:     "A",
+		25:    "Z",
+		26:    "AA",
+		51:    "AZ",
+		52:    "BA",
+		701:   "ZZ",
+		702:   "AAA",
+		16383: "XFD",
+	}
+
+	for index, name := range testCases {
+		require.Equal(t, name, ColumnName(index), "index: %d", index)
+	}
+}